@@ -0,0 +1,99 @@
+// Package applog collects the warnings and events of a session in memory,
+// so the TUI can show them in its log pane. Printing them would write over
+// the alternate screen Bubble Tea draws on.
+//
+// The log keeps the latest entries only; Count tells how many were logged
+// in total, so a reader can tell which entries it has not seen yet.
+package applog
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Level is how serious an entry is.
+type Level int
+
+const (
+	Info Level = iota
+	Warn
+	Error
+)
+
+// String returns the level as shown in the log pane.
+func (l Level) String() string {
+	switch l {
+	case Warn:
+		return "WARN"
+	case Error:
+		return "ERROR"
+	default:
+		return "INFO"
+	}
+}
+
+// Entry is one logged message.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Message string
+}
+
+// capacity is how many entries the log keeps; older ones are dropped.
+const capacity = 200
+
+var (
+	mu      sync.Mutex
+	entries []Entry
+	total   int // Entries logged since start, including dropped ones
+)
+
+// Infof logs an event, such as a reloaded live assignment.
+func Infof(format string, args ...any) {
+	add(Info, format, args...)
+}
+
+// Warnf logs a problem the session works around, such as an unreadable
+// saved session.
+func Warnf(format string, args ...any) {
+	add(Warn, format, args...)
+}
+
+// Errorf logs a failed operation, such as an assignment that did not parse.
+func Errorf(format string, args ...any) {
+	add(Error, format, args...)
+}
+
+func add(level Level, format string, args ...any) {
+	mu.Lock()
+	defer mu.Unlock()
+	entries = append(entries, Entry{Time: time.Now(), Level: level, Message: fmt.Sprintf(format, args...)})
+	if len(entries) > capacity {
+		entries = entries[len(entries)-capacity:]
+	}
+	total++
+}
+
+// Entries returns the kept entries, oldest first.
+func Entries() []Entry {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]Entry(nil), entries...)
+}
+
+// Count returns how many entries were logged since start.
+func Count() int {
+	mu.Lock()
+	defer mu.Unlock()
+	return total
+}
+
+// Since returns the kept entries logged after the first n, for the entries
+// a reader that saw n of them has not seen yet.
+func Since(n int) []Entry {
+	mu.Lock()
+	defer mu.Unlock()
+	first := len(entries) - (total - n)
+	return append([]Entry(nil), entries[max(0, min(first, len(entries))):]...)
+}
@@ -0,0 +1,173 @@
+// Package assignment loads the actual replica assignment of a live cluster
+// from the output of `kafka-topics.sh --describe`, so an imported topology
+// can be shown with the replicas where they really are instead of a
+// simulated placement, and compared with an approved plan to detect drift.
+package assignment
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Partition is the assignment of one partition. Partition uses Kafka's
+// 0-based numbering; Replicas is in preferred order.
+type Partition struct {
+	Topic     string
+	Partition int
+	Leader    int // -1 when the partition has no leader
+	Replicas  []int
+	Isr       []int
+	Observers []int // Confluent Server only
+	Adding    []int // Replicas an in-flight reassignment is adding
+	Removing  []int // Replicas an in-flight reassignment is removing
+}
+
+// Reassigning reports whether a reassignment of the partition is in flight.
+func (p Partition) Reassigning() bool {
+	return len(p.Adding) > 0 || len(p.Removing) > 0
+}
+
+// Data holds the assignment of every described partition.
+type Data struct {
+	Partitions []Partition
+}
+
+// LoadFile reads saved `kafka-topics.sh --describe` output.
+func LoadFile(path string) (*Data, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading assignment file: %w", err)
+	}
+	return parseDescribe(content)
+}
+
+// parseDescribe parses the per-partition lines of kafka-topics.sh
+// --describe, which are tab-separated "Key: value" pairs. Topic summary
+// lines (without a Partition key) are skipped. During a reassignment the
+// lines also carry "Adding Replicas" and "Removing Replicas".
+func parseDescribe(content []byte) (*Data, error) {
+	data := &Data{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		fields := make(map[string]string)
+		for _, pair := range strings.Split(scanner.Text(), "\t") {
+			if key, value, ok := strings.Cut(strings.TrimSpace(pair), ":"); ok {
+				fields[key] = strings.TrimSpace(value)
+			}
+		}
+		if _, ok := fields["Partition"]; !ok {
+			continue
+		}
+		p := Partition{Topic: fields["Topic"]}
+		var err error
+		if p.Partition, err = strconv.Atoi(fields["Partition"]); err != nil {
+			return nil, fmt.Errorf("assignment line %d: invalid partition %q", line, fields["Partition"])
+		}
+		if p.Leader, err = strconv.Atoi(fields["Leader"]); err != nil {
+			p.Leader = -1 // "none" when offline
+		}
+		ids := map[string]*[]int{
+			"Replicas": &p.Replicas, "Isr": &p.Isr, "Observers": &p.Observers,
+			"Adding Replicas": &p.Adding, "Removing Replicas": &p.Removing,
+		}
+		for key, ids := range ids {
+			if *ids, err = parseIDs(fields[key]); err != nil {
+				return nil, fmt.Errorf("assignment line %d: invalid %s %q", line, key, fields[key])
+			}
+		}
+		if len(p.Replicas) == 0 {
+			return nil, fmt.Errorf("assignment line %d: partition %d has no replicas", line, p.Partition)
+		}
+		data.Partitions = append(data.Partitions, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(data.Partitions) == 0 {
+		return nil, fmt.Errorf("no partition lines found (expected kafka-topics --describe output)")
+	}
+	return data, nil
+}
+
+func parseIDs(s string) ([]int, error) {
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ForTopic returns the partitions of a topic sorted by partition. If topic
+// is empty and the data covers exactly one topic, that topic is used.
+func (d *Data) ForTopic(topic string) []Partition {
+	if topic == "" {
+		seen := make(map[string]bool)
+		for _, p := range d.Partitions {
+			seen[p.Topic] = true
+		}
+		if len(seen) == 1 {
+			topic = d.Partitions[0].Topic
+		}
+	}
+	var result []Partition
+	for _, p := range d.Partitions {
+		if p.Topic == topic {
+			result = append(result, p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Partition < result[j].Partition })
+	return result
+}
+
+// Apply places the replicas of a topic on the brokers of dcs, which must be
+// empty: the current leader gets the leader role, observers the observer
+// role and every other replica the follower role. Partition IDs become
+// 1-based as used throughout the visualizer.
+func (d *Data) Apply(dcs map[int]*config.DCInfo, topic string) error {
+	partitions := d.ForTopic(topic)
+	if len(partitions) == 0 {
+		return fmt.Errorf("the assignment has no partitions of topic %q", topic)
+	}
+	brokers := make(map[int]*config.BrokerInfo)
+	for _, dc := range dcs {
+		for id, broker := range dc.Brokers {
+			brokers[id] = broker
+		}
+	}
+	for _, p := range partitions {
+		observers := make(map[int]bool)
+		for _, id := range p.Observers {
+			observers[id] = true
+		}
+		for _, id := range p.Replicas {
+			broker, ok := brokers[id]
+			if !ok {
+				return fmt.Errorf("partition %d of %s has a replica on broker %d, which is not in the imported cluster", p.Partition, p.Topic, id)
+			}
+			role := config.Follower
+			switch {
+			case id == p.Leader:
+				role = config.Leader
+			case observers[id]:
+				role = config.Observer
+			}
+			broker.Replicas = append(broker.Replicas, config.ReplicaInfo{PartitionID: p.Partition + 1, Role: role})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package assignment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// reassignmentFile is the JSON of kafka-reassign-partitions.sh
+// --reassignment-json-file, as written by the reassignment export.
+type reassignmentFile struct {
+	Partitions []struct {
+		Topic     string `json:"topic"`
+		Partition int    `json:"partition"`
+		Replicas  []int  `json:"replicas"`
+		Observers []int  `json:"observers"`
+	} `json:"partitions"`
+}
+
+// LoadReassignmentFile reads an approved plan in the reassignment JSON
+// format. The first replica of each partition is its preferred leader,
+// which becomes Leader.
+func LoadReassignmentFile(path string) (*Data, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading approved placement: %w", err)
+	}
+	var f reassignmentFile
+	if err := json.Unmarshal(content, &f); err != nil {
+		return nil, fmt.Errorf("parsing approved placement %s: %w", path, err)
+	}
+	data := &Data{}
+	for _, p := range f.Partitions {
+		if len(p.Replicas) == 0 {
+			return nil, fmt.Errorf("approved placement %s: partition %d of %s has no replicas", path, p.Partition, p.Topic)
+		}
+		data.Partitions = append(data.Partitions, Partition{
+			Topic:     p.Topic,
+			Partition: p.Partition,
+			Leader:    p.Replicas[0],
+			Replicas:  p.Replicas,
+			Observers: p.Observers,
+		})
+	}
+	if len(data.Partitions) == 0 {
+		return nil, fmt.Errorf("approved placement %s contains no partitions", path)
+	}
+	return data, nil
+}
+
+// PartitionDrift is a partition whose live assignment differs from the
+// approved one. Partition uses Kafka's 0-based numbering.
+type PartitionDrift struct {
+	Partition int
+	Reason    string // e.g. "replicas 1,2,3 -> 1,2,4"
+}
+
+// Drift compares the live assignment of a topic with the approved plan: a
+// partition drifted when its replicas are on other brokers, its preferred
+// leader (first replica) moved, its observers differ, or it is missing on
+// either side. The result is sorted by partition.
+func Drift(approved, live *Data, topic string) []PartitionDrift {
+	return compare(approved, live, topic, "missing from the live cluster", "not in the approved placement")
+}
+
+// compare lists the partitions of topic whose assignment differs from
+// before to after. missing and extra are the reasons of the partitions only
+// before and only after.
+func compare(before, after *Data, topic, missing, extra string) []PartitionDrift {
+	want := make(map[int]Partition)
+	for _, p := range before.ForTopic(topic) {
+		want[p.Partition] = p
+	}
+	got := make(map[int]Partition)
+	for _, p := range after.ForTopic(topic) {
+		got[p.Partition] = p
+	}
+
+	var drifts []PartitionDrift
+	for id, a := range want {
+		l, ok := got[id]
+		if !ok {
+			drifts = append(drifts, PartitionDrift{Partition: id, Reason: missing})
+			continue
+		}
+		var reasons []string
+		if !sameSet(a.Replicas, l.Replicas) {
+			reasons = append(reasons, fmt.Sprintf("replicas %s -> %s", joinIDs(a.Replicas), joinIDs(l.Replicas)))
+		} else if a.Replicas[0] != l.Replicas[0] {
+			reasons = append(reasons, fmt.Sprintf("preferred leader %d -> %d", a.Replicas[0], l.Replicas[0]))
+		}
+		if !sameSet(a.Observers, l.Observers) {
+			reasons = append(reasons, fmt.Sprintf("observers %s -> %s", joinIDs(a.Observers), joinIDs(l.Observers)))
+		}
+		if len(reasons) > 0 {
+			drifts = append(drifts, PartitionDrift{Partition: id, Reason: strings.Join(reasons, ", ")})
+		}
+	}
+	for id := range got {
+		if _, ok := want[id]; !ok {
+			drifts = append(drifts, PartitionDrift{Partition: id, Reason: extra})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Partition < drifts[j].Partition })
+	return drifts
+}
+
+// sameSet reports whether a and b hold the same broker IDs, in any order.
+func sameSet(a, b []int) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	sort.Ints(a)
+	sort.Ints(b)
+	return slices.Equal(a, b)
+}
+
+func joinIDs(ids []int) string {
+	if len(ids) == 0 {
+		return "none"
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,118 @@
+package assignment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// snapshotTimeLayout is the time stamp in snapshot file names, in UTC so
+// the names sort in time order.
+const snapshotTimeLayout = "20060102T150405Z"
+
+// Snapshot is a saved live assignment of one topic. The file is reassignment
+// JSON, so it can be passed to --approved or given back to
+// kafka-reassign-partitions.sh to roll the topic back to it.
+type Snapshot struct {
+	Path    string
+	Topic   string
+	TakenAt time.Time
+}
+
+// snapshotFile is the reassignment JSON of a snapshot.
+type snapshotFile struct {
+	Version    int                 `json:"version"`
+	Partitions []snapshotPartition `json:"partitions"`
+}
+
+type snapshotPartition struct {
+	Topic     string `json:"topic"`
+	Partition int    `json:"partition"`
+	Replicas  []int  `json:"replicas"`
+	Observers []int  `json:"observers,omitempty"`
+}
+
+// DefaultSnapshotDir returns the snapshot directory of a cluster in the
+// user's config directory.
+func DefaultSnapshotDir(cluster string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating config directory: %w", err)
+	}
+	return filepath.Join(dir, "kafka-viz", "snapshots", cluster), nil
+}
+
+// SaveSnapshot writes the assignment of topic in data to dir, creating it,
+// as <topic>-<UTC time>.json. Only the replicas are recorded: the current
+// leader and ISR are not part of a reassignment, so a loaded snapshot has
+// the preferred leaders.
+func SaveSnapshot(dir string, data *Data, topic string, at time.Time) (Snapshot, error) {
+	partitions := data.ForTopic(topic)
+	if len(partitions) == 0 {
+		return Snapshot{}, fmt.Errorf("saving snapshot: no partitions of topic %q", topic)
+	}
+	f := snapshotFile{Version: 1}
+	for _, p := range partitions {
+		f.Partitions = append(f.Partitions, snapshotPartition{Topic: topic, Partition: p.Partition, Replicas: p.Replicas, Observers: p.Observers})
+	}
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("encoding snapshot: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return Snapshot{}, fmt.Errorf("saving snapshot: %w", err)
+	}
+	at = at.UTC().Truncate(time.Second)
+	s := Snapshot{
+		Path:    filepath.Join(dir, topic+"-"+at.Format(snapshotTimeLayout)+".json"),
+		Topic:   topic,
+		TakenAt: at,
+	}
+	if err := os.WriteFile(s.Path, append(content, '\n'), 0o644); err != nil {
+		return Snapshot{}, fmt.Errorf("saving snapshot: %w", err)
+	}
+	return s, nil
+}
+
+// Snapshots lists the snapshots of topic in dir, oldest first. A missing
+// directory has none; files of other topics or not named by SaveSnapshot
+// are skipped.
+func Snapshots(dir, topic string) ([]Snapshot, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing snapshots: %w", err)
+	}
+	var snapshots []Snapshot
+	for _, e := range entries {
+		stamp, ok := strings.CutPrefix(e.Name(), topic+"-")
+		if !ok || e.IsDir() {
+			continue
+		}
+		at, err := time.Parse(snapshotTimeLayout, strings.TrimSuffix(stamp, ".json"))
+		if err != nil {
+			continue // Another topic whose name starts with this one's
+		}
+		snapshots = append(snapshots, Snapshot{Path: filepath.Join(dir, e.Name()), Topic: topic, TakenAt: at})
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].TakenAt.Before(snapshots[j].TakenAt) })
+	return snapshots, nil
+}
+
+// Load reads the assignment saved in the snapshot.
+func (s Snapshot) Load() (*Data, error) {
+	return LoadReassignmentFile(s.Path)
+}
+
+// Changes lists the partitions of topic whose replicas, preferred leader or
+// observers differ from before to after, e.g. from a snapshot to the live
+// assignment. The result is sorted by partition.
+func Changes(before, after *Data, topic string) []PartitionDrift {
+	return compare(before, after, topic, "deleted since", "created since")
+}
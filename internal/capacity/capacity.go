@@ -0,0 +1,215 @@
+// Package capacity estimates how close each broker runs to its network and
+// disk throughput limits, given where the leaders and followers are placed
+// and the produce/consume rates of the partitions.
+package capacity
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// NearSaturation is the utilization from which a broker is considered close
+// to its limit, leaving too little headroom for a failover or a traffic
+// spike.
+const NearSaturation = 0.8
+
+// Capacity is a broker's throughput limit in bytes/sec. 0 means unknown.
+type Capacity struct {
+	Network float64 // Per direction, as NICs are full duplex
+	Disk    float64 // Write throughput of the log dirs
+}
+
+// Or fills the unknown limits of c from fallback.
+func (c Capacity) Or(fallback Capacity) Capacity {
+	if c.Network <= 0 {
+		c.Network = fallback.Network
+	}
+	if c.Disk <= 0 {
+		c.Disk = fallback.Disk
+	}
+	return c
+}
+
+// Usage is a broker's estimated throughput against its capacity.
+type Usage struct {
+	BrokerID  int
+	Load      placement.NetworkLoad
+	DiskWrite float64 // Every replica writes what is produced to it
+	Capacity  Capacity
+}
+
+// Network returns the utilization of the busier network direction, 0 when
+// the network capacity is unknown.
+func (u Usage) Network() float64 {
+	if u.Capacity.Network <= 0 {
+		return 0
+	}
+	return max(u.Load.BytesIn, u.Load.BytesOut) / u.Capacity.Network
+}
+
+// Disk returns the disk write utilization, 0 when the disk capacity is
+// unknown.
+func (u Usage) Disk() float64 {
+	if u.Capacity.Disk <= 0 {
+		return 0
+	}
+	return u.DiskWrite / u.Capacity.Disk
+}
+
+// Max returns the utilization of the broker's busiest resource.
+func (u Usage) Max() float64 {
+	return max(u.Network(), u.Disk())
+}
+
+// Bottleneck names the broker's busiest resource.
+func (u Usage) Bottleneck() string {
+	switch {
+	case u.Disk() > u.Network():
+		return "disk"
+	case u.Load.BytesOut > u.Load.BytesIn:
+		return "network out"
+	}
+	return "network in"
+}
+
+// Headroom returns the throughput the busiest resource has left in
+// bytes/sec, negative when it is over capacity.
+func (u Usage) Headroom() float64 {
+	if u.Disk() > u.Network() {
+		return u.Capacity.Disk - u.DiskWrite
+	}
+	return u.Capacity.Network - max(u.Load.BytesIn, u.Load.BytesOut)
+}
+
+// Estimate returns the usage of every broker of the placement. Leaders take
+// the produce traffic, serve the consumers and feed the followers, so
+// network out follows the leader placement; every replica receives and
+// writes the produced bytes. perBroker overrides defaults for single
+// brokers.
+func Estimate(dcs map[int]*config.DCInfo, loads map[int]config.PartitionLoad, defaults Capacity, perBroker map[int]Capacity) map[int]Usage {
+	network := placement.BrokerNetworkLoad(dcs, loads)
+	result := make(map[int]Usage, len(network))
+	for id, load := range network {
+		result[id] = Usage{
+			BrokerID:  id,
+			Load:      load,
+			DiskWrite: load.BytesIn,
+			Capacity:  perBroker[id].Or(defaults),
+		}
+	}
+	return result
+}
+
+// ParseRate parses a throughput such as 125MB/s, 1.5GiB, 10Gbit or 10Gbps
+// to bytes/sec. Byte units use powers of 1000, or 1024 with an "i"; bit
+// units (bit, bps) are divided by 8. A bare number is bytes/sec.
+func ParseRate(s string) (float64, error) {
+	text := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(s), "/s"))
+	i := strings.IndexFunc(text, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(text)
+	}
+	value, err := strconv.ParseFloat(text[:i], 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid throughput %q", s)
+	}
+	unit := strings.TrimSpace(text[i:])
+	bits := strings.HasSuffix(unit, "bit") || strings.HasSuffix(unit, "bps")
+	unit = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSuffix(unit, "bit"), "bps"), "b")
+	base := 1000.0
+	if strings.HasSuffix(unit, "i") {
+		base = 1024
+		unit = strings.TrimSuffix(unit, "i")
+	}
+	exp := 0
+	if unit != "" {
+		if exp = strings.Index("kmgt", unit); len(unit) != 1 || exp < 0 {
+			return 0, fmt.Errorf("invalid throughput unit in %q", s)
+		}
+		exp++
+	}
+	for ; exp > 0; exp-- {
+		value *= base
+	}
+	if bits {
+		value /= 8
+	}
+	return value, nil
+}
+
+// LoadFile reads per-broker capacities from a CSV file with one broker per
+// line: its ID, network and disk throughput, e.g.
+//
+//	broker_id,network,disk
+//	0,10Gbit,500MB/s
+//	3,25Gbit,
+//
+// An empty column keeps the default capacity. A header row is allowed.
+func LoadFile(path string) (map[int]Capacity, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading capacity file: %w", err)
+	}
+	return parseCSV(bytes.TrimSpace(content))
+}
+
+func parseCSV(content []byte) (map[int]Capacity, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1 // The disk column is optional
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	result := make(map[int]Capacity)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing capacity CSV: %w", err)
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(record[0]))
+		if err != nil {
+			if line == 1 {
+				continue // Header row
+			}
+			return nil, fmt.Errorf("capacity CSV line %d: invalid broker ID %q", line, record[0])
+		}
+		if id < 0 {
+			return nil, fmt.Errorf("capacity CSV line %d: broker ID cannot be negative", line)
+		}
+		if len(record) > 3 {
+			return nil, fmt.Errorf("capacity CSV line %d: expected broker_id,network,disk", line)
+		}
+		var c Capacity
+		for i, field := range record[1:] {
+			if strings.TrimSpace(field) == "" {
+				continue
+			}
+			rate, err := ParseRate(field)
+			if err != nil {
+				return nil, fmt.Errorf("capacity CSV line %d: %w", line, err)
+			}
+			if i == 0 {
+				c.Network = rate
+			} else {
+				c.Disk = rate
+			}
+		}
+		result[id] = c
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("capacity file lists no brokers")
+	}
+	return result, nil
+}
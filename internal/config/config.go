@@ -1,5 +1,12 @@
 package config
 
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // Package config holds the core data structures and type definitions
 // used across the application, particularly for representing Kafka
 // cluster configuration and placement results.
@@ -12,6 +19,116 @@ const (
 	MRC
 )
 
+// Strategy selects the algorithm used to assign replicas to brokers.
+type Strategy int
+
+const (
+	StrategyRandom         Strategy = iota // Round-robin leaders, shuffled followers
+	StrategySizeAware                      // Balance partition bytes rather than replica counts
+	StrategyGoals                          // Optimize Cruise Control style goals in priority order
+	StrategyClientAffinity                 // Lead partitions from the DCs producing the most
+	StrategyKafkaDefault                   // Kafka's own assignment for new topics, ignoring racks
+	StrategyRackAware                      // Kafka's own rack-aware assignment, DCs as racks in an MRC
+)
+
+// strategyNames maps strategies to the names used in flags and the UI.
+var strategyNames = map[Strategy]string{
+	StrategyRandom:         "random",
+	StrategySizeAware:      "size-aware",
+	StrategyGoals:          "goals",
+	StrategyClientAffinity: "client-affinity",
+	StrategyKafkaDefault:   "kafka-default",
+	StrategyRackAware:      "rack-aware",
+}
+
+// String returns the flag/UI name of the strategy.
+func (s Strategy) String() string {
+	if name, ok := strategyNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+// Strategies returns all strategies in declaration order.
+func Strategies() []Strategy {
+	return []Strategy{StrategyRandom, StrategySizeAware, StrategyGoals, StrategyClientAffinity, StrategyKafkaDefault, StrategyRackAware}
+}
+
+// ParseStrategy returns the strategy with the given name.
+func ParseStrategy(name string) (Strategy, error) {
+	for s, n := range strategyNames {
+		if n == name {
+			return s, nil
+		}
+	}
+	return StrategyRandom, fmt.Errorf("unknown strategy %q", name)
+}
+
+// MarshalText encodes the strategy by name, e.g. in saved sessions.
+func (s Strategy) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a strategy name.
+func (s *Strategy) UnmarshalText(text []byte) error {
+	parsed, err := ParseStrategy(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// ControllerMode is how the KRaft controller quorum is deployed.
+type ControllerMode int
+
+const (
+	ControllersCombined  ControllerMode = iota // Voters run inside broker processes (process.roles=broker,controller)
+	ControllersDedicated                       // Voters run on their own nodes, apart from the brokers
+	ControllersZooKeeper                       // A ZooKeeper ensemble on its own nodes, which the controller needs
+)
+
+// controllerModeNames maps controller modes to the names used in flags and
+// the UI.
+var controllerModeNames = map[ControllerMode]string{
+	ControllersCombined:  "combined",
+	ControllersDedicated: "dedicated",
+	ControllersZooKeeper: "zookeeper",
+}
+
+// String returns the flag/UI name of the controller mode.
+func (c ControllerMode) String() string {
+	if name, ok := controllerModeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("ControllerMode(%d)", int(c))
+}
+
+// ParseControllerMode returns the controller mode with the given name.
+func ParseControllerMode(name string) (ControllerMode, error) {
+	for c, n := range controllerModeNames {
+		if n == name {
+			return c, nil
+		}
+	}
+	return ControllersCombined, fmt.Errorf("unknown controller mode %q (use combined, dedicated or zookeeper)", name)
+}
+
+// MarshalText encodes the controller mode by name, e.g. in saved sessions.
+func (c ControllerMode) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a controller mode name.
+func (c *ControllerMode) UnmarshalText(text []byte) error {
+	parsed, err := ParseControllerMode(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
 // ReplicaRole defines the role of a partition replica on a broker.
 type ReplicaRole string
 
@@ -36,9 +153,34 @@ type BrokerInfo struct {
 // DCInfo stores information about a Data Center and the brokers within it.
 type DCInfo struct {
 	ID      int
+	Name    string              // Optional display name (e.g. an availability zone)
 	Brokers map[int]*BrokerInfo // Map BrokerID -> BrokerInfo
 }
 
+// Rack returns the broker.rack value of the DC's brokers: its name, or
+// dc<ID> when it has none.
+func (d *DCInfo) Rack() string {
+	if d.Name != "" {
+		return d.Name
+	}
+	return fmt.Sprintf("dc%d", d.ID)
+}
+
+// BrokerSpec describes a single broker of an explicit, externally supplied
+// topology (for example one imported from Kubernetes).
+type BrokerSpec struct {
+	ID   int
+	DCID int // 1-based DC the broker lives in
+}
+
+// PartitionLoad holds the estimated size and traffic of a single partition,
+// used by load-aware strategies.
+type PartitionLoad struct {
+	SizeBytes      int64
+	BytesInPerSec  float64
+	BytesOutPerSec float64
+}
+
 // PlacementConfig holds all the user-defined parameters needed for calculation.
 // This can be passed from the TUI to the placement logic.
 type PlacementConfig struct {
@@ -48,4 +190,330 @@ type PlacementConfig struct {
 	MinInSyncReplicas int
 	NumBrokers        int // Total for single, per DC for MRC
 	NumDCs            int
+
+	// TopicName is the (optional) name of the topic being placed.
+	TopicName string
+	// Tenant optionally names the team or tenant owning the topic, to
+	// aggregate the footprint of topics by owner.
+	Tenant string
+	// Brokers optionally lists the exact brokers to place on. When set it
+	// takes precedence over NumBrokers/NumDCs for building the topology.
+	Brokers []BrokerSpec
+	// DCNames optionally maps DC IDs to display names.
+	DCNames map[int]string
+	// BrokerRacks optionally sets the broker.rack of each broker of a
+	// single cluster, keyed by broker ID. The brokers are then grouped by
+	// rack and each partition's replicas spread over distinct racks, as
+	// Kafka's rack-aware assignment does.
+	BrokerRacks map[int]string
+
+	// Strategy selects the assignment algorithm (random by default).
+	Strategy Strategy
+	// PartitionLoads optionally holds per-partition size/traffic, keyed by
+	// the 1-based partition ID used throughout the visualizer.
+	PartitionLoads map[int]PartitionLoad
+	// Goals lists the registered scorers StrategyGoals optimizes, highest
+	// priority first. Empty selects all scorers in registration order.
+	Goals []string
+	// MaxMoves caps the replica moves/leadership swaps StrategyGoals makes
+	// (0 means one per replica).
+	MaxMoves int
+	// LooseRacks lets the random, size-aware and client-affinity strategies
+	// place MRC replicas without first spreading them over every DC.
+	LooseRacks bool
+	// NoLeaderSpread keeps the leaders the size-aware strategy placed
+	// instead of rebalancing leader traffic afterwards.
+	NoLeaderSpread bool
+	// Seed makes the randomized parts of the placement reproducible; 0
+	// picks a different seed every time.
+	Seed int64
+	// Controllers is how the KRaft controller quorum is deployed. It does
+	// not change the replica placement, only whether a failure that takes
+	// out brokers also takes out controller voters.
+	Controllers ControllerMode
+	// ControllerCount is the number of quorum voters; 0 picks 3, capped at
+	// the broker count in combined mode.
+	ControllerCount int
+	// ControllerNodes optionally lists the exact voters and their DCs, e.g.
+	// of an imported cluster. When set it takes precedence over
+	// ControllerCount. A voter may be in a DC without brokers, such as a
+	// tiebreaker site.
+	ControllerNodes []BrokerSpec
+	// BrokerTags optionally holds free-form key/value tags per broker ID,
+	// e.g. instance=m5.xlarge, disk=gp3 or lifecycle=spot.
+	BrokerTags map[int]map[string]string
+	// AvoidLeaders keeps leadership off brokers matching any of the
+	// selectors whenever a partition has another in-sync replica to lead.
+	AvoidLeaders []TagSelector
+	// ClientTraffic optionally holds the producer and consumer traffic of
+	// the topic's clients per DC, which StrategyClientAffinity leads from.
+	ClientTraffic ClientTraffic
+	// ZeroBasedPartitions shows and exports partitions with Kafka's 0-based
+	// numbers instead of the 1-based partition IDs, which stay 1-based
+	// internally.
+	ZeroBasedPartitions bool
+}
+
+// DCTraffic is the traffic of the clients in one DC, in bytes/sec.
+type DCTraffic struct {
+	ProduceBytesPerSec float64
+	ConsumeBytesPerSec float64
+}
+
+// ClientTraffic maps DCs, by ID or rack name as given on the command line,
+// to the traffic of the clients running there.
+type ClientTraffic map[string]DCTraffic
+
+// PerDC resolves the traffic against the DCs of a placement and returns it
+// per DC ID, with the names that match no DC.
+func (c ClientTraffic) PerDC(dcs map[int]*DCInfo) (perDC map[int]DCTraffic, unknown []string) {
+	perDC = make(map[int]DCTraffic)
+	for name, t := range c {
+		found := false
+		for id, dc := range dcs {
+			if name == strconv.Itoa(id) || name == dc.Rack() {
+				sum := perDC[id]
+				sum.ProduceBytesPerSec += t.ProduceBytesPerSec
+				sum.ConsumeBytesPerSec += t.ConsumeBytesPerSec
+				perDC[id] = sum
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return perDC, unknown
+}
+
+// RackAware reports whether the brokers of a single cluster are assigned
+// to racks (BrokerRacks).
+func (c PlacementConfig) RackAware() bool {
+	return c.ClusterType == SingleCluster && len(c.BrokerRacks) > 0
+}
+
+// BrokerIDs returns the IDs of the brokers the config places on, sorted.
+func (c PlacementConfig) BrokerIDs() []int {
+	if len(c.Brokers) > 0 {
+		ids := make([]int, 0, len(c.Brokers))
+		for _, b := range c.Brokers {
+			ids = append(ids, b.ID)
+		}
+		sort.Ints(ids)
+		return ids
+	}
+	ids := make([]int, c.TotalBrokers())
+	for i := range ids {
+		ids[i] = i // Generated topologies number brokers from 0
+	}
+	return ids
+}
+
+// ParseBrokerRacks parses the racks of a single cluster's brokers: either a
+// number of racks to spread the brokers over (e.g. 3), or broker=rack pairs
+// (e.g. 0=az1,1=az2,2=az3).
+func ParseBrokerRacks(s string) (count int, racks map[int]string, err error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 2 {
+			return 0, nil, fmt.Errorf("number of racks must be at least 2, got %d", n)
+		}
+		return n, nil, nil
+	}
+	racks = make(map[int]string)
+	for _, pair := range strings.Split(s, ",") {
+		id, rack, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		brokerID, err := strconv.Atoi(strings.TrimSpace(id))
+		rack = strings.TrimSpace(rack)
+		if !ok || err != nil || rack == "" {
+			return 0, nil, fmt.Errorf("invalid broker rack %q (use a number of racks or broker=rack pairs)", pair)
+		}
+		racks[brokerID] = rack
+	}
+	return 0, racks, nil
+}
+
+// PartitionNumber returns the number a 1-based partition ID is shown and
+// exported as: the ID itself, or Kafka's partition number with
+// ZeroBasedPartitions.
+func (c PlacementConfig) PartitionNumber(id int) int {
+	if c.ZeroBasedPartitions {
+		return id - 1
+	}
+	return id
+}
+
+// PartitionID returns the 1-based partition ID of a partition number as
+// shown and exported, the inverse of PartitionNumber.
+func (c PlacementConfig) PartitionID(number int) int {
+	if c.ZeroBasedPartitions {
+		return number + 1
+	}
+	return number
+}
+
+// LeaderAvoided reports whether the broker matches one of the
+// AvoidLeaders selectors.
+func (c PlacementConfig) LeaderAvoided(brokerID int) bool {
+	for _, sel := range c.AvoidLeaders {
+		if sel.Matches(c.BrokerTags[brokerID]) {
+			return true
+		}
+	}
+	return false
+}
+
+// TagSelector matches the brokers whose tag Key is set to Value, written
+// key=value (e.g. lifecycle=spot).
+type TagSelector struct {
+	Key   string
+	Value string
+}
+
+// ParseTagSelector parses a key=value selector.
+func ParseTagSelector(s string) (TagSelector, error) {
+	key, value, ok := strings.Cut(strings.TrimSpace(s), "=")
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+	if !ok || key == "" {
+		return TagSelector{}, fmt.Errorf("invalid tag selector %q (use key=value)", s)
+	}
+	return TagSelector{Key: key, Value: value}, nil
+}
+
+// String returns the selector as key=value.
+func (t TagSelector) String() string {
+	return t.Key + "=" + t.Value
+}
+
+// Matches reports whether the tags set the selector's key to its value.
+func (t TagSelector) Matches(tags map[string]string) bool {
+	value, ok := tags[t.Key]
+	return ok && value == t.Value
+}
+
+// MarshalText encodes the selector as key=value, e.g. in saved sessions.
+func (t TagSelector) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes a key=value selector.
+func (t *TagSelector) UnmarshalText(text []byte) error {
+	parsed, err := ParseTagSelector(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// TopicSpec describes one topic of a multi-topic placement.
+type TopicSpec struct {
+	Name              string
+	NumPartitions     int
+	ReplicationFactor int
+	MinInSyncReplicas int
+	SizeBytes         int64  // Optional estimated total size, spread evenly over the partitions
+	Tenant            string // Optional team or tenant owning the topic
+}
+
+// Validate checks a topic against the number of brokers it is placed on.
+func (t TopicSpec) Validate(totalBrokers int) error {
+	if t.NumPartitions <= 0 {
+		return fmt.Errorf("partitions must be positive")
+	}
+	if t.ReplicationFactor <= 0 || t.MinInSyncReplicas <= 0 {
+		return fmt.Errorf("replication factor and min ISR must be positive")
+	}
+	if t.ReplicationFactor > totalBrokers {
+		return fmt.Errorf("replication factor (%d) cannot exceed total brokers (%d)", t.ReplicationFactor, totalBrokers)
+	}
+	if t.MinInSyncReplicas > t.ReplicationFactor {
+		return fmt.Errorf("min ISR (%d) cannot exceed replication factor (%d)", t.MinInSyncReplicas, t.ReplicationFactor)
+	}
+	return nil
+}
+
+// TopicSpec returns the topic part of a placement config.
+func (c PlacementConfig) TopicSpec() TopicSpec {
+	spec := TopicSpec{
+		Name:              c.TopicName,
+		NumPartitions:     c.NumPartitions,
+		ReplicationFactor: c.ReplicationFactor,
+		MinInSyncReplicas: c.MinInSyncReplicas,
+		Tenant:            c.Tenant,
+	}
+	for _, load := range c.PartitionLoads {
+		spec.SizeBytes += load.SizeBytes
+	}
+	return spec
+}
+
+// TotalBrokers returns the number of brokers the config places on.
+func (c PlacementConfig) TotalBrokers() int {
+	if len(c.Brokers) > 0 {
+		return len(c.Brokers)
+	}
+	if c.ClusterType == MRC {
+		return c.NumBrokers * c.NumDCs
+	}
+	return c.NumBrokers
+}
+
+// RackSizes returns the number of brokers in each DC, ordered by DC ID.
+func (c PlacementConfig) RackSizes() []int {
+	if len(c.Brokers) == 0 {
+		if c.ClusterType == MRC {
+			sizes := make([]int, c.NumDCs)
+			for i := range sizes {
+				sizes[i] = c.NumBrokers
+			}
+			return sizes
+		}
+		return []int{c.NumBrokers}
+	}
+	counts := make(map[int]int)
+	for _, b := range c.Brokers {
+		counts[b.DCID]++
+	}
+	ids := make([]int, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	sizes := make([]int, len(ids))
+	for i, id := range ids {
+		sizes[i] = counts[id]
+	}
+	return sizes
+}
+
+// UnevenRacks reports whether the DCs have different numbers of brokers.
+func (c PlacementConfig) UnevenRacks() bool {
+	sizes := c.RackSizes()
+	for _, n := range sizes {
+		if n != sizes[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// WithTopic returns a copy of the config, keeping the cluster topology and
+// options, for another topic.
+func (c PlacementConfig) WithTopic(t TopicSpec) PlacementConfig {
+	c.TopicName = t.Name
+	c.Tenant = t.Tenant
+	c.NumPartitions = t.NumPartitions
+	c.ReplicationFactor = t.ReplicationFactor
+	c.MinInSyncReplicas = t.MinInSyncReplicas
+	c.PartitionLoads = nil // Loads are per topic
+	if t.SizeBytes > 0 && t.NumPartitions > 0 {
+		c.PartitionLoads = make(map[int]PartitionLoad, t.NumPartitions)
+		for id := 1; id <= t.NumPartitions; id++ {
+			c.PartitionLoads[id] = PartitionLoad{SizeBytes: t.SizeBytes / int64(t.NumPartitions)}
+		}
+	}
+	return c
 }
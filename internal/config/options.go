@@ -0,0 +1,201 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Option sets an optional part of a PlacementConfig built by
+// NewPlacementConfig.
+type Option func(*PlacementConfig)
+
+// NewPlacementConfig builds a placement config for a topic with the given
+// partitions, replication factor and min ISR, applies the options in order
+// and validates the result. The topology comes from WithBrokers,
+// WithRacks or WithBrokerList; one of them is required.
+func NewPlacementConfig(partitions, replicationFactor, minInSyncReplicas int, opts ...Option) (PlacementConfig, error) {
+	cfg := PlacementConfig{
+		ClusterType:       SingleCluster,
+		NumPartitions:     partitions,
+		ReplicationFactor: replicationFactor,
+		MinInSyncReplicas: minInSyncReplicas,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		return PlacementConfig{}, err
+	}
+	return cfg, nil
+}
+
+// WithBrokers places on a single cluster of n brokers.
+func WithBrokers(n int) Option {
+	return func(c *PlacementConfig) {
+		c.ClusterType = SingleCluster
+		c.NumBrokers = n
+		c.NumDCs = 1
+	}
+}
+
+// WithRacks places on an MRC of numDCs data centers (racks) with
+// brokersPerDC brokers each.
+func WithRacks(numDCs, brokersPerDC int) Option {
+	return func(c *PlacementConfig) {
+		c.ClusterType = MRC
+		c.NumDCs = numDCs
+		c.NumBrokers = brokersPerDC
+	}
+}
+
+// WithRackSizes places on an MRC whose data centers (racks) have different
+// numbers of brokers, sizes[i] in DC i+1. Broker IDs are numbered from 0
+// across the DCs in order, as with WithRacks.
+func WithRackSizes(sizes ...int) Option {
+	return func(c *PlacementConfig) {
+		var brokers []BrokerSpec
+		for i, n := range sizes {
+			for range n {
+				brokers = append(brokers, BrokerSpec{ID: len(brokers), DCID: i + 1})
+			}
+		}
+		WithBrokerList(brokers, nil)(c)
+	}
+}
+
+// WithBrokerList places on exactly the given brokers, e.g. of an imported
+// cluster; brokers in more than one DC make it an MRC.
+func WithBrokerList(brokers []BrokerSpec, dcNames map[int]string) Option {
+	return func(c *PlacementConfig) {
+		c.Brokers = brokers
+		c.DCNames = dcNames
+		dcs := make(map[int]bool)
+		for _, b := range brokers {
+			dcs[b.DCID] = true
+		}
+		c.NumDCs = len(dcs)
+		c.ClusterType = SingleCluster
+		c.NumBrokers = len(brokers)
+		if len(dcs) > 1 {
+			c.ClusterType = MRC
+			c.NumBrokers = len(brokers) / len(dcs) // Approximate, the broker list is authoritative
+		}
+	}
+}
+
+// WithBrokerRacks sets the broker.rack of each broker of a single cluster,
+// keyed by broker ID; every broker needs one.
+func WithBrokerRacks(racks map[int]string) Option {
+	return func(c *PlacementConfig) { c.BrokerRacks = racks }
+}
+
+// WithAutoRacks spreads the brokers of a single cluster over n racks named
+// rack1..rackN, in broker ID order like brokers across availability zones.
+// It must follow the option setting the brokers.
+func WithAutoRacks(n int) Option {
+	return func(c *PlacementConfig) {
+		if n <= 0 {
+			return
+		}
+		racks := make(map[int]string)
+		for i, id := range c.BrokerIDs() {
+			racks[id] = fmt.Sprintf("rack%d", i%n+1)
+		}
+		c.BrokerRacks = racks
+	}
+}
+
+// WithTopicName names the placed topic.
+func WithTopicName(name string) Option {
+	return func(c *PlacementConfig) { c.TopicName = name }
+}
+
+// WithStrategy selects the placement strategy.
+func WithStrategy(s Strategy) Option {
+	return func(c *PlacementConfig) { c.Strategy = s }
+}
+
+// WithGoals sets the goal priorities and move budget of StrategyGoals.
+func WithGoals(goals []string, maxMoves int) Option {
+	return func(c *PlacementConfig) {
+		c.Goals = goals
+		c.MaxMoves = maxMoves
+	}
+}
+
+// WithLoads sets the per-partition sizes/traffic, keyed by 1-based
+// partition ID.
+func WithLoads(loads map[int]PartitionLoad) Option {
+	return func(c *PlacementConfig) { c.PartitionLoads = loads }
+}
+
+// WithSeed makes the randomized parts of the placement reproducible.
+func WithSeed(seed int64) Option {
+	return func(c *PlacementConfig) { c.Seed = seed }
+}
+
+// WithControllers sets how the KRaft controller quorum is deployed and its
+// number of voters (0 for the default).
+func WithControllers(mode ControllerMode, count int) Option {
+	return func(c *PlacementConfig) {
+		c.Controllers = mode
+		c.ControllerCount = count
+	}
+}
+
+// WithBrokerTags sets the key/value tags of each broker, keyed by broker ID.
+func WithBrokerTags(tags map[int]map[string]string) Option {
+	return func(c *PlacementConfig) { c.BrokerTags = tags }
+}
+
+// WithAvoidLeaders keeps leadership off the brokers matching any of the
+// selectors where the placement allows it.
+func WithAvoidLeaders(selectors ...TagSelector) Option {
+	return func(c *PlacementConfig) { c.AvoidLeaders = selectors }
+}
+
+// Validate checks that the config describes a placement that can be
+// calculated. All problems are reported together, joined by errors.Join.
+func (c PlacementConfig) Validate() error {
+	var problems []error
+	total := c.TotalBrokers()
+	if total <= 0 {
+		problems = append(problems, fmt.Errorf("total number of brokers must be positive"))
+	}
+	if c.ClusterType == MRC && c.NumDCs <= 1 {
+		problems = append(problems, fmt.Errorf("MRC requires at least 2 Data Centers"))
+	}
+	if c.NumPartitions <= 0 {
+		problems = append(problems, fmt.Errorf("partitions must be positive"))
+	}
+	if c.ReplicationFactor <= 0 || c.MinInSyncReplicas <= 0 {
+		problems = append(problems, fmt.Errorf("replication factor and min ISR must be positive"))
+	}
+	if total > 0 && c.ReplicationFactor > total {
+		problems = append(problems, fmt.Errorf("replication factor (%d) cannot exceed total brokers (%d)", c.ReplicationFactor, total))
+	}
+	if c.MinInSyncReplicas > c.ReplicationFactor && c.ReplicationFactor > 0 {
+		problems = append(problems, fmt.Errorf("min ISR (%d) cannot exceed replication factor (%d)", c.MinInSyncReplicas, c.ReplicationFactor))
+	}
+	if c.MaxMoves < 0 {
+		problems = append(problems, fmt.Errorf("max moves cannot be negative"))
+	}
+	if len(c.BrokerRacks) > 0 {
+		if c.ClusterType == MRC {
+			problems = append(problems, fmt.Errorf("broker racks apply to a single cluster; an MRC's racks are its DCs"))
+		}
+		var missing []int
+		for _, id := range c.BrokerIDs() {
+			if c.BrokerRacks[id] == "" {
+				missing = append(missing, id)
+			}
+		}
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Errorf("brokers %v have no rack; set one for every broker or none", missing))
+		}
+	}
+	if c.ControllerCount < 0 {
+		problems = append(problems, fmt.Errorf("controller count cannot be negative"))
+	}
+	return errors.Join(problems...)
+}
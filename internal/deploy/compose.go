@@ -0,0 +1,198 @@
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// Mode selects how the generated cluster manages its metadata.
+type Mode string
+
+const (
+	ModeKRaft     Mode = "kraft"
+	ModeZooKeeper Mode = "zookeeper"
+)
+
+// ParseMode returns the mode with the given name.
+func ParseMode(name string) (Mode, error) {
+	switch Mode(strings.ToLower(name)) {
+	case ModeKRaft:
+		return ModeKRaft, nil
+	case ModeZooKeeper, "zk":
+		return ModeZooKeeper, nil
+	}
+	return "", fmt.Errorf("unknown metadata mode %q (use kraft or zookeeper)", name)
+}
+
+const (
+	kafkaImage     = "confluentinc/cp-kafka:7.6.1"
+	zookeeperImage = "confluentinc/cp-zookeeper:7.6.1"
+	clusterID      = "a2Fma2Etdml6LWNsdXN0ZXI" // Fixed so every node formats the same cluster
+	maxControllers = 3                         // KRaft voters, spread over the DCs; they double as brokers
+	firstHostPort  = 9092
+)
+
+// WriteComposeFile writes a docker-compose.yml for the modeled cluster.
+func WriteComposeFile(path string, mode Mode, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var buf bytes.Buffer
+	if err := WriteCompose(&buf, mode, cfg, dcs); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing compose file: %w", err)
+	}
+	return nil
+}
+
+// WriteCompose renders a docker-compose.yml that starts one container per
+// modeled broker, with its broker.rack, and creates the topic with the
+// modeled replica assignment. Broker N's container is kafka-N and it is
+// reachable from the host on localhost:9092 plus its index.
+func WriteCompose(w io.Writer, mode Mode, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	brokers := Brokers(dcs)
+	if len(brokers) == 0 {
+		return fmt.Errorf("the placement has no brokers")
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Test cluster for the topology modeled in kafka-viz: %d brokers, %d data centers, %s mode.\n", len(brokers), len(dcs), mode)
+	b.WriteString("# Start it with: docker compose up -d\n")
+	b.WriteString("services:\n")
+
+	controllers := pickControllers(brokers)
+	isController := make(map[int]bool)
+	for _, c := range controllers {
+		isController[c.ID] = true
+	}
+	if mode == ModeZooKeeper {
+		b.WriteString("  zookeeper:\n")
+		fmt.Fprintf(&b, "    image: %s\n", zookeeperImage)
+		b.WriteString("    environment:\n")
+		b.WriteString("      ZOOKEEPER_CLIENT_PORT: 2181\n")
+		b.WriteString("      ZOOKEEPER_TICK_TIME: 2000\n")
+	}
+
+	var voters []string
+	for _, c := range controllers {
+		voters = append(voters, fmt.Sprintf("%d@%s:29093", c.ID, serviceName(c)))
+	}
+	for i, br := range brokers {
+		name := serviceName(br)
+		hostPort := firstHostPort + i
+		fmt.Fprintf(&b, "  %s:\n", name)
+		fmt.Fprintf(&b, "    image: %s\n", kafkaImage)
+		fmt.Fprintf(&b, "    hostname: %s\n", name)
+		fmt.Fprintf(&b, "    ports:\n      - \"%d:%d\"\n", hostPort, hostPort)
+		if mode == ModeZooKeeper {
+			b.WriteString("    depends_on:\n      - zookeeper\n")
+		}
+		b.WriteString("    environment:\n")
+		env := func(key, value string) { fmt.Fprintf(&b, "      %s: %q\n", key, value) }
+		listeners := fmt.Sprintf("PLAINTEXT://%s:29092,PLAINTEXT_HOST://0.0.0.0:%d", name, hostPort)
+		if mode == ModeKRaft {
+			roles := "broker"
+			if isController[br.ID] {
+				roles = "broker,controller"
+				listeners += fmt.Sprintf(",CONTROLLER://%s:29093", name)
+			}
+			env("CLUSTER_ID", clusterID)
+			env("KAFKA_NODE_ID", strconv.Itoa(br.ID))
+			env("KAFKA_PROCESS_ROLES", roles)
+			env("KAFKA_CONTROLLER_QUORUM_VOTERS", strings.Join(voters, ","))
+			env("KAFKA_CONTROLLER_LISTENER_NAMES", "CONTROLLER")
+		} else {
+			env("KAFKA_BROKER_ID", strconv.Itoa(br.ID))
+			env("KAFKA_ZOOKEEPER_CONNECT", "zookeeper:2181")
+		}
+		env("KAFKA_LISTENERS", listeners)
+		env("KAFKA_ADVERTISED_LISTENERS", fmt.Sprintf("PLAINTEXT://%s:29092,PLAINTEXT_HOST://localhost:%d", name, hostPort))
+		env("KAFKA_LISTENER_SECURITY_PROTOCOL_MAP", "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT,PLAINTEXT_HOST:PLAINTEXT")
+		env("KAFKA_INTER_BROKER_LISTENER_NAME", "PLAINTEXT")
+		env("KAFKA_BROKER_RACK", br.Rack)
+		env("KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR", strconv.Itoa(min(len(brokers), 3)))
+		env("KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR", strconv.Itoa(min(len(brokers), 3)))
+		env("KAFKA_TRANSACTION_STATE_LOG_MIN_ISR", strconv.Itoa(min(len(brokers), 2)))
+		if cfg.ClusterType == config.MRC {
+			env("KAFKA_REPLICA_SELECTOR_CLASS", "org.apache.kafka.common.replica.RackAwareReplicaSelector")
+		}
+	}
+
+	writeTopicInit(&b, cfg, dcs, brokers)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeTopicInit adds a one-shot service that creates the topic with the
+// modeled replica assignment (leader first). Without Confluent Server
+// placement constraints observers become regular followers.
+func writeTopicInit(b *strings.Builder, cfg config.PlacementConfig, dcs map[int]*config.DCInfo, brokers []Broker) {
+	var assignment []string
+	for _, p := range placement.Partitions(dcs) {
+		var ids []string
+		for _, role := range []config.ReplicaRole{config.Leader, config.Follower, config.Observer} {
+			for _, id := range p.Brokers[role] {
+				ids = append(ids, strconv.Itoa(id))
+			}
+		}
+		assignment = append(assignment, strings.Join(ids, ":"))
+	}
+	if len(assignment) == 0 {
+		return
+	}
+	topic := cfg.TopicName
+	if topic == "" {
+		topic = "topic" // The wizard does not ask for a name
+	}
+	bootstrap := serviceName(brokers[0]) + ":29092"
+	script := fmt.Sprintf("until kafka-topics --bootstrap-server %s --list; do sleep 2; done; "+
+		"kafka-topics --bootstrap-server %s --create --if-not-exists --topic %s --replica-assignment %s --config min.insync.replicas=%d",
+		bootstrap, bootstrap, topic, strings.Join(assignment, ","), cfg.MinInSyncReplicas)
+
+	b.WriteString("  topic-init:\n")
+	fmt.Fprintf(b, "    image: %s\n", kafkaImage)
+	b.WriteString("    depends_on:\n")
+	for _, br := range brokers {
+		fmt.Fprintf(b, "      - %s\n", serviceName(br))
+	}
+	b.WriteString("    restart: \"no\"\n")
+	fmt.Fprintf(b, "    command: [\"bash\", \"-c\", %q]\n", script)
+	if cfg.ClusterType == config.MRC {
+		b.WriteString("    # Observers are created as followers: plain Kafka has no observer role.\n")
+	}
+}
+
+// pickControllers picks up to maxControllers brokers as KRaft voters, taking
+// the lowest broker IDs of each DC in turn so that the quorum spans DCs.
+func pickControllers(brokers []Broker) []Broker {
+	var dcIDs []int
+	byDC := make(map[int][]Broker)
+	for _, b := range brokers {
+		if _, ok := byDC[b.DC.ID]; !ok {
+			dcIDs = append(dcIDs, b.DC.ID)
+		}
+		byDC[b.DC.ID] = append(byDC[b.DC.ID], b)
+	}
+	sort.Ints(dcIDs)
+	var picked []Broker
+	for round := 0; len(picked) < min(len(brokers), maxControllers); round++ {
+		for _, id := range dcIDs {
+			if round < len(byDC[id]) && len(picked) < maxControllers {
+				picked = append(picked, byDC[id][round])
+			}
+		}
+	}
+	sort.Slice(picked, func(i, j int) bool { return picked[i].ID < picked[j].ID })
+	return picked
+}
+
+func serviceName(b Broker) string {
+	return fmt.Sprintf("kafka-%d", b.ID)
+}
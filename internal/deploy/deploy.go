@@ -0,0 +1,66 @@
+// Package deploy generates configuration that recreates the modeled
+// topology on real brokers, so that the data center/rack layout shown in the
+// visualizer is the one the cluster runs with.
+package deploy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Broker is one broker of the modeled topology.
+type Broker struct {
+	ID   int
+	DC   *config.DCInfo
+	Rack string
+}
+
+// Brokers lists the brokers of a placement by ID.
+func Brokers(dcs map[int]*config.DCInfo) []Broker {
+	var brokers []Broker
+	for _, dc := range dcs {
+		for id := range dc.Brokers {
+			brokers = append(brokers, Broker{ID: id, DC: dc, Rack: dc.Rack()})
+		}
+	}
+	sort.Slice(brokers, func(i, j int) bool { return brokers[i].ID < brokers[j].ID })
+	return brokers
+}
+
+// BrokerProperties renders the server.properties snippet of one broker.
+// Both broker.id (ZooKeeper) and node.id (KRaft) are set; in MRC setups
+// consumers are also allowed to fetch from the closest replica.
+func BrokerProperties(b Broker, clusterType config.ClusterType) string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "# Broker %d in data center %d, generated by kafka-viz\n", b.ID, b.DC.ID)
+	fmt.Fprintf(&s, "broker.id=%d\n", b.ID)
+	fmt.Fprintf(&s, "node.id=%d\n", b.ID)
+	fmt.Fprintf(&s, "broker.rack=%s\n", b.Rack)
+	if clusterType == config.MRC {
+		s.WriteString("replica.selector.class=org.apache.kafka.common.replica.RackAwareReplicaSelector\n")
+	}
+	return s.String()
+}
+
+// WriteProperties writes broker-<id>.properties for every broker to dir,
+// creating it if needed, and returns the paths written.
+func WriteProperties(dir string, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating properties directory: %w", err)
+	}
+	var paths []string
+	for _, b := range Brokers(dcs) {
+		path := filepath.Join(dir, fmt.Sprintf("broker-%d.properties", b.ID))
+		if err := os.WriteFile(path, []byte(BrokerProperties(b, cfg.ClusterType)), 0o644); err != nil {
+			return paths, fmt.Errorf("writing broker properties: %w", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
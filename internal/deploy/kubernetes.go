@@ -0,0 +1,64 @@
+package deploy
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// ZoneLabel is the well-known node label Strimzi's rack awareness and the
+// spread constraints use to tell data centers (zones) apart.
+const ZoneLabel = "topology.kubernetes.io/zone"
+
+// KubernetesSpread renders the node labels and the Strimzi Kafka resource
+// settings (rack awareness, pod topology spread and anti-affinity) that keep
+// the brokers spread over zones the way the placement models them: each
+// data center is a zone whose name is the DC's rack.
+func KubernetesSpread(dcs map[int]*config.DCInfo) string {
+	counts := make(map[string]int)
+	var racks []string
+	for _, b := range Brokers(dcs) {
+		if _, ok := counts[b.Rack]; !ok {
+			racks = append(racks, b.Rack)
+		}
+		counts[b.Rack]++
+	}
+	total, fewest, most := 0, -1, 0
+	for _, n := range counts {
+		total += n
+		most = max(most, n)
+		if fewest < 0 || n < fewest {
+			fewest = n
+		}
+	}
+	skew := max(1, most-fewest)
+
+	var s strings.Builder
+	s.WriteString("# Label the nodes of each data center with its zone:\n")
+	for _, rack := range racks {
+		fmt.Fprintf(&s, "#   kubectl label node <node-in-%s> %s=%s  (%d brokers)\n", rack, ZoneLabel, rack, counts[rack])
+	}
+	if skew > 1 {
+		fmt.Fprintf(&s, "# Brokers are spread unevenly (%d to %d per zone), so maxSkew is %d.\n", fewest, most, skew)
+	}
+	s.WriteString("apiVersion: kafka.strimzi.io/v1beta2\n")
+	s.WriteString("kind: Kafka\n")
+	s.WriteString("metadata:\n  name: <cluster-name>\n")
+	s.WriteString("spec:\n  kafka:\n")
+	fmt.Fprintf(&s, "    replicas: %d\n", total)
+	fmt.Fprintf(&s, "    rack:\n      topologyKey: %s\n", ZoneLabel)
+	s.WriteString("    template:\n      pod:\n")
+	s.WriteString("        topologySpreadConstraints:\n")
+	fmt.Fprintf(&s, "          - maxSkew: %d\n", skew)
+	fmt.Fprintf(&s, "            topologyKey: %s\n", ZoneLabel)
+	s.WriteString("            whenUnsatisfiable: DoNotSchedule\n")
+	s.WriteString("            labelSelector:\n              matchLabels:\n                strimzi.io/name: <cluster-name>-kafka\n")
+	s.WriteString("        affinity:\n          podAntiAffinity:\n")
+	s.WriteString("            preferredDuringSchedulingIgnoredDuringExecution:\n")
+	s.WriteString("              - weight: 100\n                podAffinityTerm:\n")
+	s.WriteString("                  topologyKey: kubernetes.io/hostname\n")
+	s.WriteString("                  labelSelector:\n                    matchLabels:\n                      strimzi.io/name: <cluster-name>-kafka\n")
+	return s.String()
+}
@@ -0,0 +1,161 @@
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// DefaultRetention is the topic retention assumed when none is modeled,
+// Kafka's retention.ms default.
+const DefaultRetention = 7 * 24 * time.Hour
+
+const (
+	minSegmentBytes      = 100 << 20          // Smaller segments mean more open files for little gain
+	maxSegmentBytes      = 1 << 30            // Kafka's segment.bytes default
+	maxSegmentMs         = 7 * 24 * time.Hour // Kafka's segment.ms default
+	minSegmentMs         = time.Hour
+	segmentsPerRetention = 10 // Expired data lingers up to one segment past retention.ms
+)
+
+// TopicSetting is one recommended topic config and why.
+type TopicSetting struct {
+	Key    string
+	Value  string
+	Reason string
+}
+
+// RecommendTopicConfig returns the topic configs recommended for the modeled
+// placement, keeping data for retention: min ISR from the in-sync replicas
+// the placement gives each partition, no unclean leader election, and
+// segments sized so that expired data is deleted close to the retention.
+func RecommendTopicConfig(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, retention time.Duration) []TopicSetting {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	var settings []TopicSetting
+
+	// Leaders and followers are in sync; observers are not
+	inSync := cfg.ReplicationFactor
+	for _, p := range placement.Partitions(dcs) {
+		inSync = min(inSync, len(p.Brokers[config.Leader])+len(p.Brokers[config.Follower]))
+	}
+	minISR := max(1, min(inSync-1, inSync/2+1))
+	reason := fmt.Sprintf("acks=all writes survive the loss of %d of the %s", inSync-minISR, i18n.Plural(inSync, "in-sync replica"))
+	if inSync-minISR == 0 {
+		reason = "some partitions have a single in-sync replica (observers are not in sync), so a higher value rejects every acks=all write"
+	}
+	if minISR != cfg.MinInSyncReplicas {
+		reason += fmt.Sprintf(" (modeled with %d)", cfg.MinInSyncReplicas)
+	}
+	settings = append(settings, TopicSetting{"min.insync.replicas", strconv.Itoa(minISR), reason})
+
+	reason = "an out-of-sync replica taking over silently drops acknowledged writes"
+	if cfg.ClusterType == config.MRC {
+		reason += "; after a DC loss, promote observers by hand instead (see --runbook)"
+	}
+	settings = append(settings, TopicSetting{"unclean.leader.election.enable", "false", reason})
+
+	settings = append(settings, TopicSetting{"retention.ms", strconv.FormatInt(retention.Milliseconds(), 10),
+		fmt.Sprintf("the modeled retention of %s", formatDuration(retention))})
+
+	segmentMs := min(max(retention/segmentsPerRetention, minSegmentMs), maxSegmentMs)
+	settings = append(settings, TopicSetting{"segment.ms", strconv.FormatInt(segmentMs.Milliseconds(), 10),
+		fmt.Sprintf("segments are deleted whole, so data outlives retention.ms by up to one segment (%s)", formatDuration(segmentMs))})
+
+	segmentBytes, reason := int64(maxSegmentBytes), "no partition write rate is modeled: Kafka's default"
+	if rate := peakWriteRate(cfg, retention); rate > 0 {
+		segmentBytes = min(max(int64(rate*segmentMs.Seconds()), minSegmentBytes), maxSegmentBytes)
+		segmentBytes = (segmentBytes + 1<<20 - 1) &^ (1<<20 - 1) // Whole MiB
+		reason = fmt.Sprintf("the busiest partition writes about %d MiB per segment.ms, so segments roll by time rather than size", int64(rate*segmentMs.Seconds())>>20)
+		if segmentBytes == maxSegmentBytes {
+			reason = "the busiest partition fills Kafka's default segment size before segment.ms"
+		}
+	}
+	settings = append(settings, TopicSetting{"segment.bytes", strconv.FormatInt(segmentBytes, 10), reason})
+	return settings
+}
+
+// peakWriteRate returns the highest write rate of a partition in bytes per
+// second: the modeled bytes in, or else the partition size spread over the
+// retention. 0 when no loads are modeled.
+func peakWriteRate(cfg config.PlacementConfig, retention time.Duration) float64 {
+	peak := 0.0
+	for _, load := range cfg.PartitionLoads {
+		rate := load.BytesInPerSec
+		if rate == 0 {
+			rate = float64(load.SizeBytes) / retention.Seconds()
+		}
+		peak = max(peak, rate)
+	}
+	return peak
+}
+
+// WriteTopicConfigFile writes the topic config script to path.
+func WriteTopicConfigFile(path string, cfg config.PlacementConfig, dcs map[int]*config.DCInfo, retention time.Duration) error {
+	var buf bytes.Buffer
+	if err := WriteTopicConfig(&buf, cfg, dcs, retention); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o755); err != nil {
+		return fmt.Errorf("writing topic config: %w", err)
+	}
+	return nil
+}
+
+// WriteTopicConfig renders the recommended topic configs as a shell script
+// of copy-pastable commands: creating the topic with the modeled partitions
+// and replication factor, then kafka-configs setting every recommended
+// config, each explained in a comment.
+func WriteTopicConfig(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo, retention time.Duration) error {
+	topic := cfg.TopicName
+	if topic == "" {
+		topic = "topic" // The wizard does not ask for a name
+	}
+	settings := RecommendTopicConfig(cfg, dcs, retention)
+
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&b, "# Recommended configuration of topic %s for the placement modeled in kafka-viz:\n", topic)
+	fmt.Fprintf(&b, "# %s, replication factor %d, %s in %s.\n", i18n.Plural(cfg.NumPartitions, "partition"), cfg.ReplicationFactor,
+		i18n.Plural(len(Brokers(dcs)), "broker"), i18n.Plural(len(dcs), "data center"))
+	b.WriteString("BOOTSTRAP=${BOOTSTRAP:-localhost:9092}\n\n")
+
+	b.WriteString("# Create the topic if it does not exist yet. The replication factor of an\n")
+	b.WriteString("# existing topic only changes with a reassignment (--export-format reassignment).\n")
+	if cfg.ClusterType == config.MRC {
+		b.WriteString("# The replication factor counts observers; with Confluent Server, create the\n")
+		b.WriteString("# topic with --replica-placement instead to keep them observers.\n")
+	}
+	fmt.Fprintf(&b, "kafka-topics --bootstrap-server \"$BOOTSTRAP\" --create --if-not-exists --topic %s --partitions %d --replication-factor %d\n\n",
+		topic, cfg.NumPartitions, cfg.ReplicationFactor)
+
+	pairs := make([]string, len(settings))
+	for i, s := range settings {
+		fmt.Fprintf(&b, "# %s=%s: %s.\n", s.Key, s.Value, s.Reason)
+		pairs[i] = s.Key + "=" + s.Value
+	}
+	fmt.Fprintf(&b, "kafka-configs --bootstrap-server \"$BOOTSTRAP\" --alter --entity-type topics --entity-name %s \\\n  --add-config %s\n",
+		topic, strings.Join(pairs, ","))
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatDuration renders a duration in the largest whole unit, e.g. 7d or
+// 16h48m.
+func formatDuration(d time.Duration) string {
+	day := 24 * time.Hour
+	if d >= day && d%day == 0 {
+		return fmt.Sprintf("%dd", d/day)
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(d.Round(time.Minute).String(), "0s"), "0m")
+}
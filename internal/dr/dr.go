@@ -0,0 +1,190 @@
+// Package dr compares two disaster recovery designs for the same topic
+// requirements: one multi-region cluster (MRC) stretched across the data
+// centers, as placed, and two independent clusters in two of the data
+// centers kept in sync by MirrorMaker 2 (active/passive). For each it
+// estimates the RPO and RTO of losing a data center, what it costs in
+// brokers, storage and cross-DC traffic, and what it takes to run.
+package dr
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// Params holds the assumptions of the comparison.
+type Params struct {
+	Timing failover.Timing // MRC failover; its PromotionTime is also the operator's cutover to the DR cluster
+	// MirrorLag is how far MM2 trails the source cluster: the writes lost
+	// with the primary DC.
+	MirrorLag time.Duration
+	// CheckpointInterval is how often MM2 translates consumer offsets
+	// (emit.checkpoints.interval.seconds): consumers failing over reprocess
+	// up to this much.
+	CheckpointInterval time.Duration
+}
+
+// DefaultParams returns the default failover timing, a few seconds of MM2
+// lag and MM2's default checkpoint interval.
+func DefaultParams() Params {
+	return Params{
+		Timing:             failover.DefaultTiming(),
+		MirrorLag:          10 * time.Second,
+		CheckpointInterval: time.Minute,
+	}
+}
+
+// Design is one DR design's estimates.
+type Design struct {
+	Name    string
+	Brokers int
+	Sizing  string // How the brokers are split, e.g. "3 per cluster"
+	// StorageBytes is the disk the replicas take, 0 when the partition
+	// sizes are unknown.
+	StorageBytes int64
+	// CrossDCBytesPerSec is the write traffic copied between DCs, 0 when the
+	// partitions' bytes in are unknown.
+	CrossDCBytesPerSec float64
+	RPO                time.Duration // Worst case over the DC losses
+	RPONote            string
+	RTO                time.Duration // Worst case over the DC losses; 0 means no automatic recovery
+	RTONote            string
+	Notes              []string // What running the design takes
+}
+
+// Compare estimates the placed MRC design and the two-cluster MM2
+// alternative for the same partitions, replication factor and min ISR.
+func Compare(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, q failover.Quorum, p Params) (mrc, mm2 Design) {
+	return compareMRC(cfg, dcs, q, p), compareMM2(cfg, dcs, p)
+}
+
+// compareMRC estimates the placement as it is.
+func compareMRC(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, q failover.Quorum, p Params) Design {
+	d := Design{Name: i18n.T("dr.mrc"), Sizing: i18n.T("dr.mrc.sizing", len(dcs))}
+
+	// Where each partition's leader is, for the replicas copying across DCs
+	leaderDC := make(map[int]int)
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			for _, r := range b.Replicas {
+				if r.Role == config.Leader {
+					leaderDC[r.PartitionID] = dc.ID
+				}
+			}
+		}
+	}
+	var crossFollowers, observers bool
+	for _, dc := range dcs {
+		d.Brokers += len(dc.Brokers)
+		for _, b := range dc.Brokers {
+			for _, r := range b.Replicas {
+				load := cfg.PartitionLoads[r.PartitionID]
+				d.StorageBytes += load.SizeBytes
+				if r.Role == config.Leader || dc.ID == leaderDC[r.PartitionID] {
+					continue
+				}
+				d.CrossDCBytesPerSec += load.BytesInPerSec
+				switch r.Role {
+				case config.Follower:
+					crossFollowers = true
+				case config.Observer:
+					observers = true
+				}
+			}
+		}
+	}
+
+	// The worst DC loss of the design as placed
+	var lost, promoted int
+	var quorumLost []string
+	for _, e := range failover.SimulateDCLoss(dcs, q, p.Timing, failover.AsyncObservers) {
+		d.RPO = max(d.RPO, e.RPO)
+		d.RTO = max(d.RTO, e.RTO)
+		lost += len(e.Lost)
+		promoted += e.Promoted
+		if e.QuorumLost {
+			quorumLost = append(quorumLost, dcs[e.DCID].Rack())
+		}
+	}
+	switch {
+	case lost > 0:
+		d.RPONote = i18n.T("dr.mrc.rpoLost", lost)
+	case d.RPO > 0:
+		d.RPONote = i18n.T("dr.mrc.rpoObservers")
+	default:
+		d.RPONote = i18n.T("dr.mrc.rpoInSync")
+	}
+	switch {
+	case len(quorumLost) > 0:
+		d.RTO = 0
+		d.RTONote = i18n.T("dr.mrc.rtoQuorum")
+	case promoted > 0:
+		d.RTONote = i18n.T("dr.mrc.rtoPromoted")
+	default:
+		d.RTONote = i18n.T("dr.mrc.rtoElection")
+	}
+
+	d.Notes = append(d.Notes, i18n.T("dr.mrc.oneCluster"))
+	if crossFollowers {
+		d.Notes = append(d.Notes, i18n.T("dr.mrc.crossFollowers"))
+	}
+	if observers {
+		d.Notes = append(d.Notes, i18n.T("dr.mrc.observers"))
+	}
+	if len(quorumLost) > 0 {
+		sort.Strings(quorumLost)
+		d.Notes = append(d.Notes, i18n.T("dr.mrc.quorumLost", strings.Join(quorumLost, i18n.T("dr.mrc.or"))))
+	}
+	d.Notes = append(d.Notes, i18n.T("dr.mrc.links"))
+	return d
+}
+
+// compareMM2 estimates two clusters in the first two DCs, each holding
+// every partition with the full replication factor, the first one primary.
+func compareMM2(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, p Params) Design {
+	d := Design{Name: i18n.T("dr.mm2")}
+	ids := make([]int, 0, len(dcs))
+	for id := range dcs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	sizes := make([]int, 0, 2)
+	for _, id := range ids[:min(2, len(ids))] {
+		// Each cluster needs at least RF brokers of its own
+		n := max(len(dcs[id].Brokers), cfg.ReplicationFactor)
+		sizes = append(sizes, n)
+		d.Brokers += n
+	}
+	if len(sizes) == 2 && sizes[0] == sizes[1] {
+		d.Sizing = i18n.T("dr.mm2.sizing", sizes[0])
+	} else {
+		d.Sizing = i18n.T("dr.mm2.sizingUneven", sizes)
+	}
+	for id := 1; id <= cfg.NumPartitions; id++ {
+		load := cfg.PartitionLoads[id]
+		d.StorageBytes += 2 * int64(cfg.ReplicationFactor) * load.SizeBytes
+		d.CrossDCBytesPerSec += load.BytesInPerSec // MM2 copies every write once
+	}
+
+	d.RPO = p.MirrorLag
+	d.RPONote = i18n.T("dr.mm2.rpo")
+	d.RTO = p.Timing.PromotionTime + p.Timing.MetadataDelay
+	d.RTONote = i18n.T("dr.mm2.rto")
+
+	d.Notes = append(d.Notes,
+		i18n.T("dr.mm2.local"),
+		i18n.T("dr.mm2.offsets", p.CheckpointInterval),
+		i18n.T("dr.mm2.renames"),
+		i18n.T("dr.mm2.operate"),
+		i18n.T("dr.mm2.failback"),
+	)
+	if len(ids) > 2 {
+		d.Notes = append(d.Notes, i18n.T("dr.mm2.unused", dcs[ids[0]].Rack(), dcs[ids[1]].Rack()))
+	}
+	return d
+}
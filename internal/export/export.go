@@ -0,0 +1,328 @@
+// Package export writes a placement to files in formats meant for other
+// tools and for people: an assignment document with goal scores (JSON or
+// YAML), the input of kafka-reassign-partitions.sh, CSV, a plain text
+// table, a monochrome rendering for printing, Graphviz and Mermaid graphs
+// and an SVG picture. Each format is an Exporter in a registry (see
+// Register), so a new one is added in its own file.
+//
+// Every format lists DCs, brokers, partitions and replicas in a fixed order
+// (by ID, replicas by partition), so exporting the same placement twice
+// gives identical files and version-controlled plans diff cleanly.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/health"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// Format names an export format.
+type Format string
+
+const (
+	FormatJSON         Format = "json"         // Assignment and goal scores
+	FormatYAML         Format = "yaml"         // The JSON document as YAML, for GitOps repositories
+	FormatReassignment Format = "reassignment" // kafka-reassign-partitions.sh --reassignment-json-file input
+	FormatCSV          Format = "csv"          // One row per replica
+	FormatText         Format = "text"         // Human readable table
+	FormatPrint        Format = "print"        // Monochrome ASCII boxes and table for runbooks and PDFs
+)
+
+func init() {
+	Register(exporter{FormatJSON, "assignment and goal scores", []string{".json"}, writeJSON})
+	Register(exporter{FormatYAML, "the JSON document as YAML, for GitOps repositories", []string{".yaml", ".yml"},
+		func(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+			return writeYAML(w, newDocument(cfg, dcs))
+		}})
+	Register(exporter{FormatReassignment, "kafka-reassign-partitions.sh input", nil, writeReassignment})
+	Register(exporter{FormatCSV, "one row per replica", []string{".csv"}, writeCSV})
+	Register(exporter{FormatText, "human readable tables", []string{".txt"}, writeText})
+}
+
+// Formats returns all registered formats in registration order.
+func Formats() []Format {
+	formats := make([]Format, len(registry))
+	for i, e := range registry {
+		formats[i] = e.Format()
+	}
+	return formats
+}
+
+// ParseFormat returns the format with the given name.
+func ParseFormat(name string) (Format, error) {
+	if e, ok := Lookup(Format(strings.ToLower(name))); ok {
+		return e.Format(), nil
+	}
+	return "", fmt.Errorf("unknown export format %q (available: %s)", name, strings.Join(FormatNames(), ", "))
+}
+
+// FormatForPath picks a format from a file extension, e.g. .json, .yaml
+// (.yml) or .csv; anything no exporter claims is text.
+func FormatForPath(path string) Format {
+	ext := filepath.Ext(path)
+	for _, e := range registry {
+		for _, claimed := range e.Extensions() {
+			if strings.EqualFold(ext, claimed) {
+				return e.Format()
+			}
+		}
+	}
+	return FormatText
+}
+
+// WriteFile exports a placement to path.
+func WriteFile(path string, format Format, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var buf bytes.Buffer
+	if err := Write(&buf, format, cfg, dcs); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing export: %w", err)
+	}
+	return nil
+}
+
+// Write exports a placement in the given format.
+func Write(w io.Writer, format Format, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	e, ok := Lookup(format)
+	if !ok {
+		return fmt.Errorf("unknown export format %q", format)
+	}
+	return e.Write(w, cfg, dcs)
+}
+
+// --- JSON assignment document ---
+
+type document struct {
+	Topic             string             `json:"topic,omitempty"`
+	ClusterType       string             `json:"clusterType"`
+	Strategy          config.Strategy    `json:"strategy"`
+	Partitions        int                `json:"partitions"`
+	ReplicationFactor int                `json:"replicationFactor"`
+	MinInSyncReplicas int                `json:"minInSyncReplicas"`
+	ZeroBased         bool               `json:"zeroBasedPartitions,omitempty"` // Partitions numbered from 0, as in Kafka
+	DataCenters       []dataCenter       `json:"dataCenters"`
+	Scores            map[string]float64 `json:"scores"` // Goal scores, 0 is best
+	Health            health.Report      `json:"health"` // 0-100, higher is better
+}
+
+type dataCenter struct {
+	ID      int      `json:"id"`
+	Name    string   `json:"name,omitempty"`
+	Brokers []broker `json:"brokers"`
+}
+
+type broker struct {
+	ID       int       `json:"id"`
+	Replicas []replica `json:"replicas"`
+}
+
+type replica struct {
+	Partition int    `json:"partition"`
+	Role      string `json:"role"`
+}
+
+func writeJSON(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(newDocument(cfg, dcs))
+}
+
+// newDocument builds the assignment document of the JSON and YAML exports.
+func newDocument(cfg config.PlacementConfig, dcs map[int]*config.DCInfo) document {
+	doc := document{
+		Topic:             cfg.TopicName,
+		ClusterType:       clusterTypeName(cfg.ClusterType),
+		Strategy:          cfg.Strategy,
+		Partitions:        cfg.NumPartitions,
+		ReplicationFactor: cfg.ReplicationFactor,
+		MinInSyncReplicas: cfg.MinInSyncReplicas,
+		ZeroBased:         cfg.ZeroBasedPartitions,
+		Scores:            make(map[string]float64),
+		Health:            health.Evaluate(dcs, cfg.PartitionLoads),
+	}
+	for _, dc := range sortedDCs(dcs) {
+		d := dataCenter{ID: dc.ID, Name: dc.Name}
+		for _, b := range sortedBrokers(dc) {
+			eb := broker{ID: b.ID, Replicas: []replica{}}
+			for _, r := range sortedReplicas(b) {
+				eb.Replicas = append(eb.Replicas, replica{Partition: cfg.PartitionNumber(r.PartitionID), Role: string(r.Role)})
+			}
+			d.Brokers = append(d.Brokers, eb)
+		}
+		doc.DataCenters = append(doc.DataCenters, d)
+	}
+	scorers := placement.Scorers()
+	for i, score := range placement.NewAssignment(dcs, cfg.PartitionLoads).Scores(scorers) {
+		doc.Scores[scorers[i].Name()] = score
+	}
+	return doc
+}
+
+// --- kafka-reassign-partitions.sh input ---
+
+type reassignment struct {
+	Version    int                     `json:"version"`
+	Partitions []reassignmentPartition `json:"partitions"`
+}
+
+type reassignmentPartition struct {
+	Topic     string `json:"topic"`
+	Partition int    `json:"partition"`
+	Replicas  []int  `json:"replicas"`
+	Observers []int  `json:"observers,omitempty"` // Confluent Server extension
+}
+
+func writeReassignment(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	out := reassignment{Version: 1}
+	for _, p := range placement.Partitions(dcs) {
+		out.Partitions = append(out.Partitions, reassignmentOf(cfg, p))
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// reassignmentOf returns the reassignment entry of one partition.
+func reassignmentOf(cfg config.PlacementConfig, p placement.PartitionReplicas) reassignmentPartition {
+	topic := cfg.TopicName
+	if topic == "" {
+		topic = "topic" // The wizard does not ask for a name
+	}
+	return reassignmentPartition{
+		Topic:     topic,
+		Partition: p.ID - 1, // Kafka numbers partitions from 0
+		Replicas:  replicaOrder(p),
+		Observers: append([]int(nil), p.Brokers[config.Observer]...),
+	}
+}
+
+// replicaOrder lists a partition's brokers as Kafka orders replicas: the
+// first is the preferred leader and observers go last.
+func replicaOrder(p placement.PartitionReplicas) []int {
+	var replicas []int
+	replicas = append(replicas, p.Brokers[config.Leader]...)
+	replicas = append(replicas, p.Brokers[config.Follower]...)
+	replicas = append(replicas, p.Brokers[config.Observer]...)
+	return replicas
+}
+
+// --- CSV ---
+
+func writeCSV(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"topic", "partition", "broker", "dc", "dc_name", "role"})
+	for _, dc := range sortedDCs(dcs) {
+		for _, b := range sortedBrokers(dc) {
+			for _, r := range sortedReplicas(b) {
+				cw.Write([]string{
+					cfg.TopicName,
+					strconv.Itoa(cfg.PartitionNumber(r.PartitionID)),
+					strconv.Itoa(b.ID),
+					strconv.Itoa(dc.ID),
+					dc.Name,
+					string(r.Role),
+				})
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// --- Plain text ---
+
+func writeText(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var b strings.Builder
+	if cfg.TopicName != "" {
+		fmt.Fprintf(&b, "Topic: %s\n", cfg.TopicName)
+	}
+	fmt.Fprintf(&b, "Cluster: %s, %d partitions, RF %d, min ISR %d, strategy %s\n",
+		clusterTypeName(cfg.ClusterType), cfg.NumPartitions, cfg.ReplicationFactor, cfg.MinInSyncReplicas, cfg.Strategy)
+	report := health.Evaluate(dcs, cfg.PartitionLoads)
+	fmt.Fprintf(&b, "Health: %d/100 (%s)\n", report.Score, report.Summary())
+	for _, c := range report.Components {
+		fmt.Fprintf(&b, "  %-18s %3d  %s\n", c.Name, c.Score, c.Detail)
+	}
+	b.WriteString("\n")
+
+	fmt.Fprintf(&b, "%-10s %-8s %-20s %s\n", "Partition", "Leader", "Followers", "Observers")
+	for _, p := range placement.Partitions(dcs) {
+		fmt.Fprintf(&b, "%-10s %-8s %-20s %s\n",
+			fmt.Sprintf("p%d", cfg.PartitionNumber(p.ID)), joinInts(p.Brokers[config.Leader]), joinInts(p.Brokers[config.Follower]), joinInts(p.Brokers[config.Observer]))
+	}
+
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "%-8s %-6s %-9s %s\n", "Broker", "DC", "Replicas", "Leaders")
+	for _, dc := range sortedDCs(dcs) {
+		for _, br := range sortedBrokers(dc) {
+			leaders := 0
+			for _, r := range br.Replicas {
+				if r.Role == config.Leader {
+					leaders++
+				}
+			}
+			fmt.Fprintf(&b, "%-8d %-6d %-9d %d\n", br.ID, dc.ID, len(br.Replicas), leaders)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// --- Helpers ---
+
+func clusterTypeName(t config.ClusterType) string {
+	if t == config.MRC {
+		return "mrc"
+	}
+	return "single"
+}
+
+func sortedDCs(dcs map[int]*config.DCInfo) []*config.DCInfo {
+	result := make([]*config.DCInfo, 0, len(dcs))
+	for _, dc := range dcs {
+		result = append(result, dc)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func sortedBrokers(dc *config.DCInfo) []*config.BrokerInfo {
+	result := make([]*config.BrokerInfo, 0, len(dc.Brokers))
+	for _, b := range dc.Brokers {
+		result = append(result, b)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// sortedReplicas returns the replicas of a broker by partition ID; the
+// placement keeps them in the order they were assigned.
+func sortedReplicas(b *config.BrokerInfo) []config.ReplicaInfo {
+	result := append([]config.ReplicaInfo(nil), b.Replicas...)
+	sort.SliceStable(result, func(i, j int) bool { return result[i].PartitionID < result[j].PartitionID })
+	return result
+}
+
+func joinInts(ids []int) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
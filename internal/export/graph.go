@@ -0,0 +1,104 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+const (
+	FormatDOT     Format = "dot"     // Graphviz digraph of partitions and brokers
+	FormatMermaid Format = "mermaid" // Mermaid flowchart for Markdown docs and wikis
+)
+
+func init() {
+	Register(exporter{FormatDOT, "Graphviz graph, render with dot -Tpng", []string{".dot", ".gv"}, writeDOT})
+	Register(exporter{FormatMermaid, "Mermaid flowchart for Markdown docs", []string{".mmd", ".mermaid"}, writeMermaid})
+}
+
+// graphRoles lists the roles in the order their edges are drawn.
+var graphRoles = []config.ReplicaRole{config.Leader, config.Follower, config.Observer}
+
+// writeDOT writes the placement as a Graphviz digraph: one cluster per DC
+// holding its brokers, and an edge from each partition to every broker with
+// a replica of it, bold to the leader and dashed to observers.
+func writeDOT(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", topicLabel(cfg))
+	b.WriteString("  rankdir=LR;\n  node [shape=box];\n")
+	for _, dc := range sortedDCs(dcs) {
+		fmt.Fprintf(&b, "  subgraph cluster_dc%d {\n    label=%q;\n", dc.ID, dc.Rack())
+		for _, br := range sortedBrokers(dc) {
+			fmt.Fprintf(&b, "    b%d [label=\"broker %d\"];\n", br.ID, br.ID)
+		}
+		b.WriteString("  }\n")
+	}
+	for _, p := range placement.Partitions(dcs) {
+		n := cfg.PartitionNumber(p.ID)
+		fmt.Fprintf(&b, "  p%d [shape=ellipse, label=\"p%d\"];\n", n, n)
+		for _, role := range graphRoles {
+			style := ""
+			switch role {
+			case config.Leader:
+				style = ", style=bold"
+			case config.Observer:
+				style = ", style=dashed"
+			}
+			for _, id := range p.Brokers[role] {
+				fmt.Fprintf(&b, "  p%d -> b%d [label=%q%s];\n", n, id, strings.ToLower(string(role)), style)
+			}
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMermaid writes the placement as a Mermaid flowchart, which GitHub,
+// GitLab and most wikis render inside a ```mermaid block: a subgraph per DC
+// and thick, plain and dotted arrows to leaders, followers and observers.
+func writeMermaid(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%%%% %s\n", topicLabel(cfg))
+	b.WriteString("flowchart LR\n")
+	for _, dc := range sortedDCs(dcs) {
+		fmt.Fprintf(&b, "  subgraph dc%d [\"%s\"]\n", dc.ID, mermaidText(dc.Rack()))
+		for _, br := range sortedBrokers(dc) {
+			fmt.Fprintf(&b, "    b%d[\"broker %d\"]\n", br.ID, br.ID)
+		}
+		b.WriteString("  end\n")
+	}
+	arrows := map[config.ReplicaRole]string{
+		config.Leader:   "==>",
+		config.Follower: "-->",
+		config.Observer: "-.->",
+	}
+	for _, p := range placement.Partitions(dcs) {
+		n := cfg.PartitionNumber(p.ID)
+		fmt.Fprintf(&b, "  p%d((p%d))\n", n, n)
+		for _, role := range graphRoles {
+			for _, id := range p.Brokers[role] {
+				fmt.Fprintf(&b, "  p%d %s|%s| b%d\n", n, arrows[role], strings.ToLower(string(role)), id)
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// topicLabel names the placement in graph titles.
+func topicLabel(cfg config.PlacementConfig) string {
+	if cfg.TopicName == "" {
+		return fmt.Sprintf("%s placement", clusterTypeName(cfg.ClusterType))
+	}
+	return cfg.TopicName
+}
+
+// mermaidText escapes the quotes Mermaid labels can't hold.
+func mermaidText(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
@@ -0,0 +1,153 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// LoadFile reads an assignment document written by the JSON or YAML export
+// back into a placement, so an exported plan can be edited, diffed or put
+// through failure simulations again. YAML is picked by the .yaml or .yml
+// extension, JSON otherwise. The scores and health of the document are
+// ignored; they are recomputed from the placement.
+func LoadFile(path string) (config.PlacementConfig, map[int]*config.DCInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config.PlacementConfig{}, nil, fmt.Errorf("reading assignment: %w", err)
+	}
+	format := FormatJSON
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		format = FormatYAML
+	}
+	cfg, dcs, err := Load(data, format)
+	if err != nil {
+		return config.PlacementConfig{}, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, dcs, nil
+}
+
+// Load reads an assignment document in the JSON or YAML export format.
+func Load(data []byte, format Format) (config.PlacementConfig, map[int]*config.DCInfo, error) {
+	if format == FormatYAML {
+		v, err := parseYAML(data)
+		if err != nil {
+			return config.PlacementConfig{}, nil, err
+		}
+		if data, err = json.Marshal(v); err != nil {
+			return config.PlacementConfig{}, nil, err
+		}
+	} else if format != FormatJSON {
+		return config.PlacementConfig{}, nil, fmt.Errorf("cannot load the %s export format", format)
+	}
+	var doc document
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&doc); err != nil {
+		return config.PlacementConfig{}, nil, fmt.Errorf("not an assignment document: %w", err)
+	}
+	return doc.placement()
+}
+
+// placement rebuilds the placement of a document, checking that every
+// partition has exactly one leader and at most one replica per broker.
+func (doc document) placement() (config.PlacementConfig, map[int]*config.DCInfo, error) {
+	cfg := config.PlacementConfig{
+		ClusterType:       config.SingleCluster,
+		NumPartitions:     doc.Partitions,
+		ReplicationFactor: doc.ReplicationFactor,
+		MinInSyncReplicas: doc.MinInSyncReplicas,
+		TopicName:         doc.Topic,
+		Strategy:          doc.Strategy,
+		NumDCs:            len(doc.DataCenters),
+	}
+	// Shown numbered as in the document; the replicas keep 1-based IDs
+	cfg.ZeroBasedPartitions = doc.ZeroBased
+	switch doc.ClusterType {
+	case "mrc":
+		cfg.ClusterType = config.MRC
+	case "single", "":
+	default:
+		return cfg, nil, fmt.Errorf("unknown cluster type %q (want single or mrc)", doc.ClusterType)
+	}
+	if len(doc.DataCenters) == 0 {
+		return cfg, nil, fmt.Errorf("no data centers")
+	}
+	if cfg.NumPartitions < 1 {
+		return cfg, nil, fmt.Errorf("partitions must be at least 1")
+	}
+
+	dcs := make(map[int]*config.DCInfo)
+	brokerDC := make(map[int]int)
+	leaders := make(map[int]int)           // Partition -> leaders
+	voters := make(map[int]int)            // Partition -> leader and follower replicas
+	replicas := make(map[int]map[int]bool) // Partition -> brokers
+	for _, d := range doc.DataCenters {
+		if _, dup := dcs[d.ID]; dup || d.ID < 1 {
+			return cfg, nil, fmt.Errorf("data center %d: IDs must be unique and start at 1", d.ID)
+		}
+		dc := &config.DCInfo{ID: d.ID, Name: d.Name, Brokers: make(map[int]*config.BrokerInfo)}
+		dcs[d.ID] = dc
+		if d.Name != "" {
+			if cfg.DCNames == nil {
+				cfg.DCNames = make(map[int]string)
+			}
+			cfg.DCNames[d.ID] = d.Name
+		}
+		for _, b := range d.Brokers {
+			if b.ID < 0 {
+				return cfg, nil, fmt.Errorf("broker %d: IDs cannot be negative", b.ID)
+			}
+			if other, dup := brokerDC[b.ID]; dup {
+				return cfg, nil, fmt.Errorf("broker %d: listed in DC %d and DC %d", b.ID, other, d.ID)
+			}
+			brokerDC[b.ID] = d.ID
+			cfg.Brokers = append(cfg.Brokers, config.BrokerSpec{ID: b.ID, DCID: d.ID})
+			info := &config.BrokerInfo{ID: b.ID}
+			for _, r := range b.Replicas {
+				id := cfg.PartitionID(r.Partition)
+				role := config.ReplicaRole(r.Role)
+				if role != config.Leader && role != config.Follower && role != config.Observer {
+					return cfg, nil, fmt.Errorf("broker %d: partition %d has unknown role %q", b.ID, r.Partition, r.Role)
+				}
+				if id < 1 || id > cfg.NumPartitions {
+					return cfg, nil, fmt.Errorf("broker %d: partition %d is outside %d..%d", b.ID, r.Partition, cfg.PartitionNumber(1), cfg.PartitionNumber(cfg.NumPartitions))
+				}
+				if replicas[id] == nil {
+					replicas[id] = make(map[int]bool)
+				}
+				if replicas[id][b.ID] {
+					return cfg, nil, fmt.Errorf("broker %d: holds partition %d twice", b.ID, r.Partition)
+				}
+				replicas[id][b.ID] = true
+				if role == config.Leader {
+					leaders[id]++
+				}
+				if role != config.Observer {
+					voters[id]++
+				}
+				info.Replicas = append(info.Replicas, config.ReplicaInfo{PartitionID: id, Role: role})
+			}
+			dc.Brokers[b.ID] = info
+		}
+		cfg.NumBrokers = max(cfg.NumBrokers, len(d.Brokers))
+	}
+	if cfg.ClusterType == config.SingleCluster {
+		cfg.NumBrokers = len(brokerDC)
+	}
+	for p := 1; p <= cfg.NumPartitions; p++ {
+		if leaders[p] != 1 {
+			return cfg, nil, fmt.Errorf("partition %d has %d leaders, want exactly one", cfg.PartitionNumber(p), leaders[p])
+		}
+		if doc.ReplicationFactor == 0 {
+			// A hand-written document may leave the factor to its replicas
+			cfg.ReplicationFactor = max(cfg.ReplicationFactor, voters[p])
+		}
+	}
+	return cfg, dcs, nil
+}
@@ -0,0 +1,163 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/health"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	printBoxesPerRow = 3  // Broker boxes side by side, to fit 80 columns
+	printBoxWidth    = 22 // Inner width of a broker box
+)
+
+// printWidth measures the underlined headings in cells, so a topic or DC
+// name with CJK characters or emoji gets an underline as wide as itself.
+var printWidth = func() *runewidth.Condition {
+	c := runewidth.NewCondition()
+	c.EastAsianWidth = false
+	return c
+}()
+
+// printMarkers are the glyphs that stand in for the role colors on paper.
+var printMarkers = map[config.ReplicaRole]string{
+	config.Leader:   "L",
+	config.Follower: "F",
+	config.Observer: "O",
+}
+
+func init() {
+	Register(exporter{FormatPrint, "monochrome ASCII boxes and table for runbooks and PDFs", nil, writePrint})
+}
+
+// writePrint renders the placement for printing in runbooks and PDFs: plain
+// ASCII in 80 columns, each broker drawn as a box of its replicas with the
+// roles as L/F/O markers instead of colors, and a bordered table of the
+// partitions.
+func writePrint(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var b strings.Builder
+	title := "Kafka partition placement"
+	if cfg.TopicName != "" {
+		title += ": " + cfg.TopicName
+	}
+	b.WriteString(title + "\n")
+	b.WriteString(strings.Repeat("=", printWidth.StringWidth(title)) + "\n")
+	fmt.Fprintf(&b, "Cluster: %s, %d partitions, RF %d, min ISR %d, strategy %s\n",
+		clusterTypeName(cfg.ClusterType), cfg.NumPartitions, cfg.ReplicationFactor, cfg.MinInSyncReplicas, cfg.Strategy)
+	report := health.Evaluate(dcs, cfg.PartitionLoads)
+	fmt.Fprintf(&b, "Health: %d/100 (%s)\n", report.Score, report.Summary())
+	b.WriteString("Legend: L = leader, F = follower, O = observer\n")
+
+	for _, dc := range sortedDCs(dcs) {
+		b.WriteString("\n")
+		heading := fmt.Sprintf("DC %d", dc.ID)
+		if dc.Name != "" {
+			heading += " (" + dc.Name + ")"
+		}
+		b.WriteString(heading + "\n")
+		b.WriteString(strings.Repeat("-", printWidth.StringWidth(heading)) + "\n")
+		brokers := sortedBrokers(dc)
+		for start := 0; start < len(brokers); start += printBoxesPerRow {
+			row := make([][]string, 0, printBoxesPerRow)
+			for _, br := range brokers[start:min(start+printBoxesPerRow, len(brokers))] {
+				row = append(row, printBox(cfg, br))
+			}
+			if start > 0 {
+				b.WriteString("\n")
+			}
+			writeBoxRow(&b, row)
+		}
+	}
+
+	b.WriteString("\n")
+	rows := [][]string{{"Partition", "Leader", "Followers", "Observers"}}
+	for _, p := range placement.Partitions(dcs) {
+		rows = append(rows, []string{fmt.Sprintf("p%d", cfg.PartitionNumber(p.ID)),
+			joinInts(p.Brokers[config.Leader]), joinInts(p.Brokers[config.Follower]), joinInts(p.Brokers[config.Observer])})
+	}
+	writeTable(&b, rows)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// printBox returns the lines of a broker's box, borders included, with as
+// many replicas per line as fit the widest partition number.
+func printBox(cfg config.PlacementConfig, br *config.BrokerInfo) []string {
+	border := "+" + strings.Repeat("-", printBoxWidth+2) + "+"
+	lines := []string{border, boxLine(fmt.Sprintf("Broker %d", br.ID)), border}
+	replicas := sortedReplicas(br)
+	cell := len(fmt.Sprintf("L p%d", cfg.PartitionNumber(cfg.NumPartitions)))
+	for _, r := range replicas {
+		cell = max(cell, len(fmt.Sprintf("L p%d", cfg.PartitionNumber(r.PartitionID))))
+	}
+	perLine := max((printBoxWidth+1)/(cell+1), 1)
+	if len(replicas) == 0 {
+		lines = append(lines, boxLine("(empty)"))
+	}
+	for i := 0; i < len(replicas); i += perLine {
+		cells := make([]string, 0, perLine)
+		for _, r := range replicas[i:min(i+perLine, len(replicas))] {
+			cells = append(cells, fmt.Sprintf("%-*s", cell, fmt.Sprintf("%s p%d", printMarkers[r.Role], cfg.PartitionNumber(r.PartitionID))))
+		}
+		lines = append(lines, boxLine(strings.Join(cells, " ")))
+	}
+	return append(lines, border)
+}
+
+// boxLine pads text into a line of a broker box.
+func boxLine(text string) string {
+	return fmt.Sprintf("| %-*s |", printBoxWidth, text)
+}
+
+// writeBoxRow writes boxes side by side, padding the shorter ones.
+func writeBoxRow(b *strings.Builder, boxes [][]string) {
+	height := 0
+	for _, box := range boxes {
+		height = max(height, len(box))
+	}
+	blank := strings.Repeat(" ", printBoxWidth+4)
+	for i := 0; i < height; i++ {
+		cells := make([]string, len(boxes))
+		for j, box := range boxes {
+			cells[j] = blank
+			if i < len(box) {
+				cells[j] = box[i]
+			}
+		}
+		b.WriteString(strings.TrimRight(strings.Join(cells, " "), " ") + "\n")
+	}
+}
+
+// writeTable writes rows as an ASCII table, the first row as its header.
+func writeTable(b *strings.Builder, rows [][]string) {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	var border strings.Builder
+	border.WriteString("+")
+	for _, width := range widths {
+		border.WriteString(strings.Repeat("-", width+2) + "+")
+	}
+	b.WriteString(border.String() + "\n")
+	for i, row := range rows {
+		b.WriteString("|")
+		for j, cell := range row {
+			fmt.Fprintf(b, " %-*s |", widths[j], cell)
+		}
+		b.WriteString("\n")
+		if i == 0 {
+			b.WriteString(border.String() + "\n")
+		}
+	}
+	b.WriteString(border.String() + "\n")
+}
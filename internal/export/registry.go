@@ -0,0 +1,91 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Exporter writes a placement in one format. New formats implement it and
+// call Register from an init function; Write, WriteFile, ParseFormat,
+// FormatForPath and the --export-format help then know them, without
+// changes anywhere else.
+type Exporter interface {
+	// Format is the name --export-format selects the exporter by.
+	Format() Format
+	// Description says what the format is for, for help texts.
+	Description() string
+	// Extensions are the file extensions, with the dot, that pick the
+	// format for --export-on-exit without --export-format.
+	Extensions() []string
+	// Write exports the placement.
+	Write(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error
+}
+
+// registry holds the registered exporters in registration order.
+var registry []Exporter
+
+// Register adds an exporter. Like database/sql.Register it panics when an
+// exporter of the same format or with the same extension exists, as that
+// is a programming error.
+func Register(e Exporter) {
+	for _, other := range registry {
+		if other.Format() == e.Format() {
+			panic(fmt.Sprintf("export: format %q registered twice", e.Format()))
+		}
+		for _, ext := range e.Extensions() {
+			for _, taken := range other.Extensions() {
+				if strings.EqualFold(ext, taken) {
+					panic(fmt.Sprintf("export: extension %s of %q already picks %q", ext, e.Format(), other.Format()))
+				}
+			}
+		}
+	}
+	registry = append(registry, e)
+}
+
+// Exporters returns every registered exporter in registration order.
+func Exporters() []Exporter {
+	return append([]Exporter(nil), registry...)
+}
+
+// Lookup returns the exporter of a format.
+func Lookup(format Format) (Exporter, bool) {
+	for _, e := range registry {
+		if e.Format() == format {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// FormatNames returns the names of all formats, sorted, for help texts.
+func FormatNames() []string {
+	names := make([]string, len(registry))
+	for i, e := range registry {
+		names[i] = string(e.Format())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// exporter is an Exporter made of a write function, for the built-in
+// formats.
+type exporter struct {
+	format      Format
+	description string
+	extensions  []string
+	write       func(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error
+}
+
+func (e exporter) Format() Format       { return e.format }
+func (e exporter) Description() string  { return e.description }
+func (e exporter) Extensions() []string { return e.extensions }
+
+func (e exporter) Write(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	return e.write(w, cfg, dcs)
+}
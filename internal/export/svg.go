@@ -0,0 +1,68 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// FormatSVG is a picture of the broker boxes for slides and docs.
+const FormatSVG Format = "svg"
+
+const (
+	svgBoxWidth  = 140 // Width of a broker box
+	svgLine      = 16  // Height of a line of text
+	svgMargin    = 20  // Space around and between boxes
+	svgFontStyle = "font-family:monospace;font-size:12px"
+)
+
+// svgColors are the TUI's role colors.
+var svgColors = map[config.ReplicaRole]string{
+	config.Leader:   "#008700",
+	config.Follower: "#AF8700",
+	config.Observer: "#D70000",
+}
+
+func init() {
+	Register(exporter{FormatSVG, "SVG picture of the broker boxes", []string{".svg"}, writeSVG})
+}
+
+// writeSVG draws the placement like the TUI: a row of broker boxes per DC,
+// each listing its replicas in the role colors.
+func writeSVG(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) error {
+	var body strings.Builder
+	width, y := 0, svgMargin
+	text := func(x, y int, color, weight, s string) {
+		fmt.Fprintf(&body, "<text x=\"%d\" y=\"%d\" fill=\"%s\" font-weight=\"%s\">%s</text>\n", x, y, color, weight, html.EscapeString(s))
+	}
+
+	text(svgMargin, y+svgLine, "#000000", "bold", topicLabel(cfg))
+	y += 2 * svgLine
+	for _, dc := range sortedDCs(dcs) {
+		text(svgMargin, y+svgLine, "#000000", "bold", dc.Rack())
+		y += svgLine + svgLine/2
+		x, rowHeight := svgMargin, 0
+		for _, br := range sortedBrokers(dc) {
+			replicas := sortedReplicas(br)
+			h := (len(replicas)+1)*svgLine + svgLine/2
+			fmt.Fprintf(&body, "<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"none\" stroke=\"#808080\" rx=\"4\"/>\n", x, y, svgBoxWidth, h)
+			text(x+8, y+svgLine, "#000000", "bold", fmt.Sprintf("broker %d", br.ID))
+			for i, r := range replicas {
+				text(x+8, y+(i+2)*svgLine, svgColors[r.Role], "normal", fmt.Sprintf("p%d %s", cfg.PartitionNumber(r.PartitionID), strings.ToLower(string(r.Role))))
+			}
+			x += svgBoxWidth + svgMargin
+			rowHeight = max(rowHeight, h)
+		}
+		width = max(width, x)
+		y += rowHeight + svgMargin
+	}
+	width = max(width, 2*svgMargin+svgBoxWidth)
+
+	_, err := fmt.Fprintf(w, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" style=\"%s\">\n"+
+		"<rect width=\"100%%\" height=\"100%%\" fill=\"#FFFFFF\"/>\n%s</svg>\n", width, y, svgFontStyle, body.String())
+	return err
+}
@@ -0,0 +1,185 @@
+package export
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// WaveThrottle is the replication throttle of one wave: what each broker
+// copies and the rate it is throttled to.
+type WaveThrottle struct {
+	Wave int // 1-based
+	// LeaderRates and FollowerRates are the leader and follower
+	// replication.throttled.rate of each broker sending or receiving data,
+	// bytes/sec.
+	LeaderRates   map[int]float64
+	FollowerRates map[int]float64
+	// LeaderReplicas and FollowerReplicas are the topic's
+	// leader/follower.replication.throttled.replicas: the replicas a
+	// partition has before the wave, which serve the copies, and the new
+	// ones, as partition:broker with partitions numbered from 0.
+	LeaderReplicas   []string
+	FollowerReplicas []string
+	Sized            bool // Partition sizes are known, so the rates follow the bytes moved
+}
+
+// PlanThrottles derives the throttles of the waves of a migration. With
+// partition sizes, each broker's rates are sized so that it finishes its
+// share of the wave together with the busiest receiving broker copying at
+// rate, the throttle the wave duration estimate assumes. Without sizes
+// every broker moving data gets rate. Waves that only change roles copy
+// nothing and get no throttle.
+func PlanThrottles(cfg config.PlacementConfig, from, to map[int]*config.DCInfo, limits WaveLimits, rate float64) []WaveThrottle {
+	before := make(map[int][]int)
+	leaders := make(map[int]int)
+	for _, p := range placement.Partitions(from) {
+		before[p.ID] = replicaOrder(p)
+		if ids := p.Brokers[config.Leader]; len(ids) > 0 {
+			leaders[p.ID] = ids[0]
+		}
+	}
+	after := make(map[int][]int)
+	for _, p := range placement.Partitions(to) {
+		after[p.ID] = replicaOrder(p)
+	}
+
+	var throttles []WaveThrottle
+	for i, w := range PlanWaves(from, to, limits) {
+		if w.Moves == 0 {
+			continue
+		}
+		t := WaveThrottle{Wave: i + 1, LeaderRates: make(map[int]float64), FollowerRates: make(map[int]float64)}
+		received := make(map[int]float64) // Bytes each broker copies in
+		sent := make(map[int]float64)     // Bytes each leader serves
+		for _, id := range w.Partitions {
+			size := float64(cfg.PartitionLoads[id].SizeBytes)
+			t.Sized = t.Sized || size > 0
+			added := 0
+			for _, b := range after[id] {
+				if !slices.Contains(before[id], b) {
+					received[b] += size
+					t.FollowerRates[b] = 0
+					t.FollowerReplicas = append(t.FollowerReplicas, fmt.Sprintf("%d:%d", id-1, b))
+					added++
+				}
+			}
+			if added == 0 {
+				continue
+			}
+			for _, b := range before[id] {
+				t.LeaderReplicas = append(t.LeaderReplicas, fmt.Sprintf("%d:%d", id-1, b))
+			}
+			if leader, ok := leaders[id]; ok {
+				sent[leader] += size * float64(added) // The leader serves every copy
+				t.LeaderRates[leader] = 0
+			}
+		}
+
+		var most float64
+		for _, n := range received {
+			most = max(most, n)
+		}
+		scale := func(n float64) float64 {
+			if !t.Sized || most == 0 {
+				return rate
+			}
+			// Whole bytes, rounded up so the broker never lags the estimate
+			return max(1, math.Ceil(n/most*rate))
+		}
+		for b := range t.FollowerRates {
+			t.FollowerRates[b] = scale(received[b])
+		}
+		for b := range t.LeaderRates {
+			t.LeaderRates[b] = scale(sent[b])
+		}
+		throttles = append(throttles, t)
+	}
+	return throttles
+}
+
+// WriteWaveThrottles writes two shell scripts of kafka-configs commands
+// next to each wave of WriteWaves that copies data: plan-wave-1-throttle.sh
+// sets the brokers' replication throttles and the topic's throttled replicas
+// before the wave runs, and plan-wave-1-cleanup.sh removes them once
+// kafka-reassign-partitions --verify reports the wave complete, as a
+// throttle left in place slows down every later catch-up. It returns the
+// paths written.
+func WriteWaveThrottles(path string, cfg config.PlacementConfig, from, to map[int]*config.DCInfo, limits WaveLimits, rate float64) ([]string, error) {
+	topic := cfg.TopicName
+	if topic == "" {
+		topic = "topic" // The wizard does not ask for a name
+	}
+	ext := filepath.Ext(path)
+	if ext == "" {
+		ext = ".json"
+	}
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+
+	var paths []string
+	for _, t := range PlanThrottles(cfg, from, to, limits, rate) {
+		brokers := make([]int, 0, len(t.LeaderRates)+len(t.FollowerRates))
+		for b := range t.LeaderRates {
+			brokers = append(brokers, b)
+		}
+		for b := range t.FollowerRates {
+			if _, ok := t.LeaderRates[b]; !ok {
+				brokers = append(brokers, b)
+			}
+		}
+		sort.Ints(brokers)
+
+		var set, cleanup strings.Builder
+		set.WriteString("#!/bin/sh\n")
+		fmt.Fprintf(&set, "# Replication throttles for wave %d of the migration of topic %s modeled in kafka-viz.\n", t.Wave, topic)
+		if t.Sized {
+			fmt.Fprintf(&set, "# Rates follow the bytes each broker copies, so all finish with the busiest one at %.1f MiB/s.\n", rate/(1<<20))
+		} else {
+			fmt.Fprintf(&set, "# Without partition sizes (--weights) every broker moving data gets %.1f MiB/s.\n", rate/(1<<20))
+		}
+		fmt.Fprintf(&set, "# Run before kafka-reassign-partitions --execute of %s-wave-%d%s.\n", base, t.Wave, ext)
+		set.WriteString("BOOTSTRAP=${BOOTSTRAP:-localhost:9092}\n\n")
+		cleanup.WriteString("#!/bin/sh\n")
+		fmt.Fprintf(&cleanup, "# Removes the replication throttles of wave %d of topic %s once\n", t.Wave, topic)
+		fmt.Fprintf(&cleanup, "# kafka-reassign-partitions --verify reports %s-wave-%d%s complete.\n", base, t.Wave, ext)
+		cleanup.WriteString("BOOTSTRAP=${BOOTSTRAP:-localhost:9092}\n\n")
+
+		for _, b := range brokers {
+			var configs, keys, what []string
+			if r, ok := t.LeaderRates[b]; ok {
+				configs = append(configs, fmt.Sprintf("leader.replication.throttled.rate=%.0f", r))
+				keys = append(keys, "leader.replication.throttled.rate")
+				what = append(what, fmt.Sprintf("sends at %.1f MiB/s", r/(1<<20)))
+			}
+			if r, ok := t.FollowerRates[b]; ok {
+				configs = append(configs, fmt.Sprintf("follower.replication.throttled.rate=%.0f", r))
+				keys = append(keys, "follower.replication.throttled.rate")
+				what = append(what, fmt.Sprintf("receives at %.1f MiB/s", r/(1<<20)))
+			}
+			fmt.Fprintf(&set, "# Broker %d %s.\n", b, strings.Join(what, ", "))
+			fmt.Fprintf(&set, "kafka-configs --bootstrap-server \"$BOOTSTRAP\" --alter --entity-type brokers --entity-name %d \\\n  --add-config %s\n", b, strings.Join(configs, ","))
+			fmt.Fprintf(&cleanup, "kafka-configs --bootstrap-server \"$BOOTSTRAP\" --alter --entity-type brokers --entity-name %d \\\n  --delete-config %s\n", b, strings.Join(keys, ","))
+		}
+		set.WriteString("\n# The current replicas serve the copies; the new ones receive them.\n")
+		fmt.Fprintf(&set, "kafka-configs --bootstrap-server \"$BOOTSTRAP\" --alter --entity-type topics --entity-name %s \\\n  --add-config 'leader.replication.throttled.replicas=[%s],follower.replication.throttled.replicas=[%s]'\n",
+			topic, strings.Join(t.LeaderReplicas, ","), strings.Join(t.FollowerReplicas, ","))
+		fmt.Fprintf(&cleanup, "kafka-configs --bootstrap-server \"$BOOTSTRAP\" --alter --entity-type topics --entity-name %s \\\n  --delete-config leader.replication.throttled.replicas,follower.replication.throttled.replicas\n", topic)
+
+		for _, script := range []struct{ suffix, content string }{{"throttle", set.String()}, {"cleanup", cleanup.String()}} {
+			scriptPath := fmt.Sprintf("%s-wave-%d-%s.sh", base, t.Wave, script.suffix)
+			if err := os.WriteFile(scriptPath, []byte(script.content), 0o755); err != nil {
+				return paths, fmt.Errorf("writing wave %d %s: %w", t.Wave, script.suffix, err)
+			}
+			paths = append(paths, scriptPath)
+		}
+	}
+	return paths, nil
+}
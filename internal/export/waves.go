@@ -0,0 +1,163 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// DefaultMovesPerBroker is the default cap on concurrent replica moves per
+// broker, Cruise Control's num.concurrent.partition.movements.per.broker.
+const DefaultMovesPerBroker = 5
+
+// WaveLimits caps the replica moves that run together in one wave of a
+// migration. 0 means no limit.
+type WaveLimits struct {
+	PerBroker int // Moves a broker takes part in: receiving a new replica or, as the leader, sending one
+	PerDC     int // New replicas created in a DC, i.e. data copied into it
+}
+
+// Wave is one batch of a migration, run as a single reassignment.
+type Wave struct {
+	Partitions []int // 1-based partition IDs, sorted
+	Moves      int   // New replicas created
+}
+
+// partitionMove is what moving one partition costs.
+type partitionMove struct {
+	id      int
+	added   int
+	brokers map[int]int // Broker -> moves it takes part in
+	dcs     map[int]int // DC -> new replicas in it
+}
+
+// PlanWaves splits the migration from one placement to another into waves
+// that stay within the limits, filling the earliest wave a partition fits
+// in, by partition ID. A partition that exceeds the limits on its own gets
+// a wave to itself, as a partition's replicas move together. Partitions
+// whose replicas stay on the same brokers but change roles move no data
+// and go into the first wave.
+func PlanWaves(from, to map[int]*config.DCInfo, limits WaveLimits) []Wave {
+	dcOf := make(map[int]int)
+	for _, dc := range to {
+		for id := range dc.Brokers {
+			dcOf[id] = dc.ID
+		}
+	}
+	before := make(map[int][]int)
+	leaders := make(map[int]int)
+	for _, p := range placement.Partitions(from) {
+		before[p.ID] = replicaOrder(p)
+		if ids := p.Brokers[config.Leader]; len(ids) > 0 {
+			leaders[p.ID] = ids[0]
+		}
+	}
+
+	var moves []partitionMove
+	for _, p := range placement.Partitions(to) {
+		after := replicaOrder(p)
+		if slices.Equal(before[p.ID], after) {
+			continue
+		}
+		mv := partitionMove{id: p.ID, brokers: make(map[int]int), dcs: make(map[int]int)}
+		for _, id := range after {
+			if !slices.Contains(before[p.ID], id) {
+				mv.added++
+				mv.brokers[id]++
+				mv.dcs[dcOf[id]]++
+			}
+		}
+		if leader, ok := leaders[p.ID]; ok && mv.added > 0 {
+			mv.brokers[leader] += mv.added // The leader serves every copy
+		}
+		moves = append(moves, mv)
+	}
+
+	type load struct {
+		brokers map[int]int
+		dcs     map[int]int
+	}
+	var waves []Wave
+	var loads []load
+	fits := func(l load, mv partitionMove) bool {
+		for id, n := range mv.brokers {
+			if limits.PerBroker > 0 && l.brokers[id]+n > limits.PerBroker {
+				return false
+			}
+		}
+		for id, n := range mv.dcs {
+			if limits.PerDC > 0 && l.dcs[id]+n > limits.PerDC {
+				return false
+			}
+		}
+		return true
+	}
+	for _, mv := range moves {
+		i := 0
+		for ; i < len(waves); i++ {
+			if mv.added == 0 || fits(loads[i], mv) {
+				break
+			}
+		}
+		if i == len(waves) {
+			waves = append(waves, Wave{})
+			loads = append(loads, load{make(map[int]int), make(map[int]int)})
+		}
+		waves[i].Partitions = append(waves[i].Partitions, mv.id)
+		waves[i].Moves += mv.added
+		for id, n := range mv.brokers {
+			loads[i].brokers[id] += n
+		}
+		for id, n := range mv.dcs {
+			loads[i].dcs[id] += n
+		}
+	}
+	return waves
+}
+
+// WriteWaves writes one kafka-reassign-partitions.sh input per wave of the
+// migration from one placement to cfg's, named after path with the wave
+// number, e.g. plan-wave-1.json, and returns the paths written. Each file
+// holds the target replicas of its wave's partitions only, so the waves run
+// one after the other with --execute and --verify. Nothing is written when
+// the placements are the same.
+func WriteWaves(path string, cfg config.PlacementConfig, from, to map[int]*config.DCInfo, limits WaveLimits) ([]string, error) {
+	waves := PlanWaves(from, to, limits)
+	byID := make(map[int]placement.PartitionReplicas)
+	for _, p := range placement.Partitions(to) {
+		byID[p.ID] = p
+	}
+	ext := filepath.Ext(path)
+	if ext == "" {
+		ext = ".json"
+	}
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+
+	var paths []string
+	for i, wave := range waves {
+		out := reassignment{Version: 1}
+		for _, id := range wave.Partitions {
+			out.Partitions = append(out.Partitions, reassignmentOf(cfg, byID[id]))
+		}
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			return paths, err
+		}
+		wavePath := fmt.Sprintf("%s-wave-%d%s", base, i+1, ext)
+		if err := os.WriteFile(wavePath, buf.Bytes(), 0o644); err != nil {
+			return paths, fmt.Errorf("writing wave %d: %w", i+1, err)
+		}
+		paths = append(paths, wavePath)
+	}
+	return paths, nil
+}
@@ -0,0 +1,441 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// yamlNode is a JSON value with its object keys in document order, which a
+// map would lose.
+type yamlNode struct {
+	scalar string // Already formatted for YAML; set unless object or array
+	object bool
+	array  bool
+	keys   []string
+	values []yamlNode
+}
+
+// writeYAML writes v as YAML: it is encoded to JSON first, so the YAML has
+// exactly the fields, names and order of the JSON export.
+func writeYAML(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber() // Keep numbers as written
+	root, err := decodeYAMLNode(dec)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	switch {
+	case root.object && len(root.keys) > 0:
+		writeYAMLObject(&b, root, 0)
+	case root.array && len(root.values) > 0:
+		writeYAMLArray(&b, root, 0)
+	default:
+		b.WriteString(yamlInline(root) + "\n")
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+func decodeYAMLNode(dec *json.Decoder) (yamlNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return yamlNode{}, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		n := yamlNode{object: t == '{', array: t == '['}
+		for dec.More() {
+			if n.object {
+				key, err := dec.Token()
+				if err != nil {
+					return yamlNode{}, err
+				}
+				n.keys = append(n.keys, key.(string))
+			}
+			value, err := decodeYAMLNode(dec)
+			if err != nil {
+				return yamlNode{}, err
+			}
+			n.values = append(n.values, value)
+		}
+		_, err := dec.Token() // The closing delimiter
+		return n, err
+	case string:
+		return yamlNode{scalar: yamlString(t)}, nil
+	case json.Number:
+		return yamlNode{scalar: t.String()}, nil
+	case bool:
+		return yamlNode{scalar: strconv.FormatBool(t)}, nil
+	case nil:
+		return yamlNode{scalar: "null"}, nil
+	}
+	return yamlNode{}, fmt.Errorf("unexpected JSON token %v", tok)
+}
+
+// writeYAMLObject writes a non-empty object's keys at the given indent.
+func writeYAMLObject(b *strings.Builder, n yamlNode, indent int) {
+	pad := strings.Repeat(" ", indent)
+	for i, key := range n.keys {
+		writeYAMLEntry(b, pad+yamlString(key)+":", n.values[i], indent)
+	}
+}
+
+// writeYAMLArray writes a non-empty array's items at the given indent, an
+// object item starting on the dash line as Kubernetes manifests do.
+func writeYAMLArray(b *strings.Builder, n yamlNode, indent int) {
+	pad := strings.Repeat(" ", indent)
+	for _, item := range n.values {
+		if !item.object || len(item.keys) == 0 {
+			writeYAMLEntry(b, pad+"-", item, indent)
+			continue
+		}
+		var nested strings.Builder
+		writeYAMLObject(&nested, item, indent+2)
+		b.WriteString(pad + "- " + strings.TrimPrefix(nested.String(), pad+"  "))
+	}
+}
+
+// writeYAMLEntry writes a key or dash prefix and its value: inline for
+// scalars and empty collections, else nested below it.
+func writeYAMLEntry(b *strings.Builder, prefix string, v yamlNode, indent int) {
+	switch {
+	case v.object && len(v.keys) > 0:
+		b.WriteString(prefix + "\n")
+		writeYAMLObject(b, v, indent+2)
+	case v.array && len(v.values) > 0:
+		b.WriteString(prefix + "\n")
+		writeYAMLArray(b, v, indent+2)
+	default:
+		b.WriteString(prefix + " " + yamlInline(v) + "\n")
+	}
+}
+
+// yamlInline formats a scalar or an empty collection.
+func yamlInline(n yamlNode) string {
+	switch {
+	case n.object:
+		return "{}"
+	case n.array:
+		return "[]"
+	}
+	return n.scalar
+}
+
+// yamlString leaves a string plain unless YAML would read it as something
+// else (a number, boolean, null, or with special characters), and then
+// double-quotes it, which YAML parses like a JSON string.
+func yamlString(s string) string {
+	if s == "" || strings.TrimSpace(s) != s || strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\\\n\t") ||
+		strings.ContainsAny(s[:1], "-?") {
+		return strconv.Quote(s)
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~", "y", "n":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// --- Reading ---
+
+// yamlLine is a non-blank, non-comment line of a YAML document.
+type yamlLine struct {
+	number int // 1-based, for errors
+	indent int
+	text   string
+}
+
+// yamlParser reads the block YAML that writeYAML writes, and hand edits of
+// it: block mappings and sequences, plain, single- and double-quoted
+// scalars, flow sequences of scalars, empty flow collections and comments.
+// Anchors, tags and multi-line scalars are not supported.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML decodes a YAML document into maps, slices and scalars like
+// encoding/json does, so it can be re-encoded as JSON.
+func parseYAML(data []byte) (any, error) {
+	p := &yamlParser{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		text := strings.TrimLeft(line, " ")
+		if text == "" || strings.HasPrefix(text, "#") || text == "---" {
+			continue
+		}
+		if strings.HasPrefix(text, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		p.lines = append(p.lines, yamlLine{number: i + 1, indent: len(line) - len(text), text: text})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+	v, err := p.block(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", p.lines[p.pos].number)
+	}
+	return v, nil
+}
+
+// block parses the mapping or sequence starting at the current line.
+func (p *yamlParser) block(indent int) (any, error) {
+	if isYAMLItem(p.lines[p.pos].text) {
+		return p.sequence(indent)
+	}
+	if _, _, ok := splitYAMLKey(p.lines[p.pos].text); ok {
+		return p.mapping(indent)
+	}
+	line := p.lines[p.pos]
+	p.pos++
+	return parseYAMLScalar(line.text, line.number)
+}
+
+func (p *yamlParser) sequence(indent int) (any, error) {
+	items := []any{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isYAMLItem(p.lines[p.pos].text) {
+		line := p.lines[p.pos]
+		rest := strings.TrimLeft(strings.TrimPrefix(line.text, "-"), " ")
+		switch {
+		case rest == "":
+			// The item is the nested block below the dash
+			p.pos++
+			if p.pos >= len(p.lines) || p.lines[p.pos].indent <= indent {
+				items = append(items, nil)
+				continue
+			}
+			v, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		default:
+			if _, _, ok := splitYAMLKey(rest); ok || isYAMLItem(rest) {
+				// A mapping (or sequence) starting on the dash line continues at the column of its first key
+				p.lines[p.pos] = yamlLine{number: line.number, indent: line.indent + len(line.text) - len(rest), text: rest}
+				v, err := p.block(p.lines[p.pos].indent)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+				continue
+			}
+			v, err := parseYAMLScalar(rest, line.number)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+			p.pos++
+		}
+	}
+	return items, nil
+}
+
+func (p *yamlParser) mapping(indent int) (any, error) {
+	m := make(map[string]any)
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent {
+		line := p.lines[p.pos]
+		key, rest, ok := splitYAMLKey(line.text)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", line.number)
+		}
+		if _, dup := m[key]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", line.number, key)
+		}
+		p.pos++
+		if rest != "" {
+			v, err := parseYAMLScalar(rest, line.number)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			continue
+		}
+		// The value is the nested block below the key; a sequence may sit at the key's own indent
+		next := len(p.lines)
+		if p.pos < len(p.lines) {
+			next = p.pos
+		}
+		switch {
+		case next < len(p.lines) && p.lines[next].indent > indent,
+			next < len(p.lines) && p.lines[next].indent == indent && isYAMLItem(p.lines[next].text):
+			v, err := p.block(p.lines[next].indent)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+		default:
+			m[key] = nil
+		}
+	}
+	return m, nil
+}
+
+// isYAMLItem reports whether a line starts a sequence item.
+func isYAMLItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitYAMLKey splits "key: value" (or "key:") outside quotes. The key may
+// be quoted.
+func splitYAMLKey(text string) (key, rest string, ok bool) {
+	end := -1
+	switch text[0] {
+	case '{', '[':
+		return "", "", false // A flow collection, not a key
+	case '"', '\'':
+		quoted, n := scanYAMLQuoted(text)
+		if n < 0 {
+			return "", "", false
+		}
+		key, end = quoted, n
+		if end >= len(text) || text[end] != ':' {
+			return "", "", false
+		}
+	default:
+		for i := 0; i < len(text); i++ {
+			if text[i] == ':' && (i+1 == len(text) || text[i+1] == ' ') {
+				end = i
+				break
+			}
+			if text[i] == '#' && i > 0 && text[i-1] == ' ' {
+				return "", "", false
+			}
+		}
+		if end <= 0 {
+			return "", "", false
+		}
+		key = strings.TrimSpace(text[:end])
+	}
+	return key, strings.TrimSpace(stripYAMLComment(text[end+1:])), true
+}
+
+// scanYAMLQuoted reads the quoted string at the start of text and returns
+// it with the index just after the closing quote, or -1 if unterminated.
+func scanYAMLQuoted(text string) (string, int) {
+	q := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case q == '"' && text[i] == '\\':
+			i++ // Skip the escaped character
+		case q == '\'' && text[i] == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++ // '' is an escaped single quote
+		case text[i] == q:
+			raw := text[:i+1]
+			if q == '\'' {
+				return strings.ReplaceAll(raw[1:len(raw)-1], "''", "'"), i + 1
+			}
+			s, err := strconv.Unquote(raw)
+			if err != nil {
+				return "", -1
+			}
+			return s, i + 1
+		}
+	}
+	return "", -1
+}
+
+// stripYAMLComment removes a trailing " # comment" outside quotes.
+func stripYAMLComment(text string) string {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || text[i-1] == ' '):
+			return strings.TrimSpace(text[:i])
+		}
+	}
+	return text
+}
+
+// splitYAMLFlow splits the items of a flow sequence at commas outside
+// quotes.
+func splitYAMLFlow(inner string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(inner); i++ {
+		c := inner[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ',':
+			parts = append(parts, inner[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, inner[start:])
+}
+
+// parseYAMLScalar decodes a value written on one line.
+func parseYAMLScalar(text string, line int) (any, error) {
+	text = strings.TrimSpace(stripYAMLComment(text))
+	switch {
+	case text == "{}":
+		return map[string]any{}, nil
+	case strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]"):
+		items := []any{}
+		inner := strings.TrimSpace(text[1 : len(text)-1])
+		if inner == "" {
+			return items, nil
+		}
+		for _, part := range splitYAMLFlow(inner) {
+			v, err := parseYAMLScalar(part, line)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		return items, nil
+	case text[0] == '"' || text[0] == '\'':
+		s, end := scanYAMLQuoted(text)
+		if end != len(text) {
+			return nil, fmt.Errorf("line %d: bad quoted string %s", line, text)
+		}
+		return s, nil
+	case text[0] == '{' || text[0] == '&' || text[0] == '*' || text[0] == '!' || text[0] == '|' || text[0] == '>':
+		return nil, fmt.Errorf("line %d: unsupported YAML %q", line, text)
+	}
+	switch strings.ToLower(text) {
+	case "null", "~":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+	}
+	return text, nil
+}
@@ -0,0 +1,167 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// viaJSON returns v as encoding/json decodes it, numbers as json.Number.
+func viaJSON(t *testing.T, v any) any {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	v := map[string]any{
+		"plain":    "orders",
+		"empty":    "",
+		"spaces":   " padded ",
+		"bool":     "yes",
+		"null":     "null",
+		"number":   "1.50",
+		"colon":    "a: b",
+		"comment":  "x # not a comment",
+		"hash":     "#first",
+		"dash":     "-leading",
+		"quotes":   `it's "quoted"`,
+		"escapes":  "back\\slash\nnew line\ttab",
+		"flow":     "[not, a, list]",
+		"unicode":  "café ✓",
+		"key: odd": "value",
+		"ints":     []any{0, -3, 42},
+		"floats":   []any{1.5, 0.25},
+		"bools":    []any{true, false},
+		"nothing":  nil,
+		"noItems":  []any{},
+		"noKeys":   map[string]any{},
+		"nested": []any{
+			map[string]any{"id": 1, "replicas": []any{}},
+			map[string]any{"id": 2, "replicas": []any{map[string]any{"partition": 0, "role": "Leader"}}},
+			[]any{"a", []any{"b"}},
+			"scalar",
+			nil,
+		},
+	}
+	var b strings.Builder
+	if err := writeYAML(&b, v); err != nil {
+		t.Fatal(err)
+	}
+	got, err := parseYAML([]byte(b.String()))
+	if err != nil {
+		t.Fatalf("%v\n%s", err, b.String())
+	}
+	if want := viaJSON(t, v); !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip changed the value\n got %#v\nwant %#v\nyaml:\n%s", got, want, b.String())
+	}
+}
+
+func TestParseYAMLHandEdits(t *testing.T) {
+	doc := `---
+# A hand-edited plan
+topic: 'it''s'   # single-quoted, with a comment
+name: "tab\there"
+tags: [a, 'b, c', "d]"]
+empty: []
+none: {}
+missing:
+url: http://example.com/a#b
+brokers:
+- id: 1 # indented at the key
+  replicas:
+    - 3
+    -   4
+- id: 2
+  replicas: []
+`
+	want := map[string]any{
+		"topic":   "it's",
+		"name":    "tab\there",
+		"tags":    []any{"a", "b, c", "d]"},
+		"empty":   []any{},
+		"none":    map[string]any{},
+		"missing": nil,
+		"url":     "http://example.com/a#b",
+		"brokers": []any{
+			map[string]any{"id": json.Number("1"), "replicas": []any{json.Number("3"), json.Number("4")}},
+			map[string]any{"id": json.Number("2"), "replicas": []any{}},
+		},
+	}
+	got, err := parseYAML([]byte(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v\nwant %#v", got, want)
+	}
+}
+
+func TestParseYAMLErrors(t *testing.T) {
+	for _, doc := range []string{
+		"a: 1\na: 2\n",        // Duplicate key
+		"a: \"open\n",         // Unterminated quote
+		"a:\n\t- 1\n",         // Tab indentation
+		"a: &anchor 1\n",      // Anchors are not supported
+		"a: |\n  text\n",      // Nor block scalars
+		"a:\n  b: 1\n c: 2\n", // Indentation back to no level
+	} {
+		if _, err := parseYAML([]byte(doc)); err == nil {
+			t.Errorf("no error for %q", doc)
+		}
+	}
+}
+
+func TestYAMLExportLoads(t *testing.T) {
+	cfg := config.PlacementConfig{
+		ClusterType:         config.MRC,
+		NumDCs:              2,
+		NumBrokers:          1,
+		NumPartitions:       2,
+		ReplicationFactor:   2,
+		MinInSyncReplicas:   1,
+		TopicName:           "orders: eu",
+		DCNames:             map[int]string{1: "yes", 2: "#2"},
+		ZeroBasedPartitions: true,
+	}
+	dcs := map[int]*config.DCInfo{
+		1: {ID: 1, Name: "yes", Brokers: map[int]*config.BrokerInfo{
+			1: {ID: 1, Replicas: []config.ReplicaInfo{{PartitionID: 1, Role: config.Leader}, {PartitionID: 2, Role: config.Observer}}},
+		}},
+		2: {ID: 2, Name: "#2", Brokers: map[int]*config.BrokerInfo{
+			2: {ID: 2, Replicas: []config.ReplicaInfo{{PartitionID: 1, Role: config.Observer}, {PartitionID: 2, Role: config.Leader}}},
+			3: {ID: 3, Replicas: []config.ReplicaInfo{}},
+		}},
+	}
+	var b bytes.Buffer
+	if err := Write(&b, FormatYAML, cfg, dcs); err != nil {
+		t.Fatal(err)
+	}
+	loaded, loadedDCs, err := Load(b.Bytes(), FormatYAML)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, b.String())
+	}
+	if loaded.TopicName != cfg.TopicName || !loaded.ZeroBasedPartitions || !reflect.DeepEqual(loaded.DCNames, cfg.DCNames) {
+		t.Errorf("loaded config %+v", loaded)
+	}
+	var again bytes.Buffer
+	if err := Write(&again, FormatYAML, loaded, loadedDCs); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != b.String() {
+		t.Errorf("export of the loaded placement differs\n%s\nfrom\n%s", again.String(), b.String())
+	}
+}
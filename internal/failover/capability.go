@@ -0,0 +1,88 @@
+package failover
+
+import (
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Capability counts the partitions of a topic that still serve each kind of
+// client request during an outage, in the terms operators use in incidents.
+type Capability struct {
+	Partitions int
+	// AcksAll partitions accept acks=all writes: they have a leader and at
+	// least min ISR in-sync replicas.
+	AcksAll int
+	// Led partitions have a leader, so they accept acks=1 writes and serve
+	// consumers fetching from the leader.
+	Led int
+	// LocalReads partitions have a leader and a surviving replica in every
+	// surviving data center, so each DC's consumers can fetch from a local
+	// follower (KIP-392). Observers count: they serve follower fetches too.
+	LocalReads int
+	// DCs is how many data centers still have a running broker.
+	DCs int
+}
+
+// Capabilities fails the given brokers and every broker of the given data
+// centers together, like SimulateOutage and SimulateDCOutage, and counts
+// the partitions that still serve each kind of request.
+func Capabilities(dcs map[int]*config.DCInfo, q Quorum, failedBrokers map[int]bool, failedDCs []int, minInSyncReplicas int) Capability {
+	failed := make(map[int]bool, len(failedBrokers))
+	for id := range failedBrokers {
+		failed[id] = true
+	}
+	lostDCs := make(map[int]bool, len(failedDCs))
+	for _, id := range failedDCs {
+		lostDCs[id] = true
+		if dc, ok := dcs[id]; ok {
+			for brokerID := range dc.Brokers {
+				failed[brokerID] = true
+			}
+		}
+	}
+	o := outage(dcs, failed, !q.Survives(failed, lostDCs), minInSyncReplicas)
+	offline := make(map[int]bool, len(o.Offline))
+	for _, id := range o.Offline {
+		offline[id] = true
+	}
+
+	// The data centers each partition keeps a running replica in, and the
+	// data centers with a running broker, i.e. with consumers still running
+	local := make(map[int]map[int]bool)
+	surviving := make(map[int]bool)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			if !failed[broker.ID] {
+				surviving[dc.ID] = true
+			}
+			for _, r := range broker.Replicas {
+				if local[r.PartitionID] == nil {
+					local[r.PartitionID] = make(map[int]bool)
+				}
+				if !failed[broker.ID] {
+					local[r.PartitionID][dc.ID] = true
+				}
+			}
+		}
+	}
+
+	c := Capability{
+		Partitions: len(local),
+		AcksAll:    len(local) - len(o.NoWrites()),
+		Led:        len(local) - len(o.Offline),
+		DCs:        len(surviving),
+	}
+	for id, in := range local {
+		if offline[id] {
+			continue
+		}
+		everywhere := true
+		for dcID := range surviving {
+			everywhere = everywhere && in[dcID]
+		}
+		if everywhere {
+			c.LocalReads++
+		}
+	}
+	return c
+}
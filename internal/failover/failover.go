@@ -0,0 +1,343 @@
+// Package failover estimates how long partitions stay leaderless when a
+// broker fails: the controller first has to notice that the broker is gone,
+// then elects a new leader for each partition it led, one at a time, and
+// finally clients have to learn about the new leaders.
+package failover
+
+import (
+	"sort"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Timing holds the assumptions the estimates are based on.
+type Timing struct {
+	SessionTimeout time.Duration // Until the controller fences the broker (broker.session.timeout.ms)
+	ElectionTime   time.Duration // Controller time per partition leader election
+	MetadataDelay  time.Duration // Until clients fetch metadata with the new leader
+	ObserverLag    time.Duration // How far asynchronous observers trail their leader
+	PromotionTime  time.Duration // Until an operator has promoted observers after a DC loss
+	// ControllerFailover is how long the quorum has no active controller
+	// after losing it: the voters' fetch timeout plus an election
+	// (controller.quorum.fetch.timeout.ms, controller.quorum.election.timeout.ms).
+	ControllerFailover time.Duration
+}
+
+// DefaultTiming returns the KRaft session timeout default, rough election
+// and metadata refresh costs, a typical observer lag and manual promotion
+// time, and the KRaft controller failover defaults.
+func DefaultTiming() Timing {
+	return Timing{
+		SessionTimeout:     9 * time.Second,
+		ElectionTime:       2 * time.Millisecond,
+		MetadataDelay:      100 * time.Millisecond,
+		ObserverLag:        5 * time.Second,
+		PromotionTime:      5 * time.Minute,
+		ControllerFailover: 3 * time.Second,
+	}
+}
+
+// Estimate is the outcome of one simulated broker failure.
+type Estimate struct {
+	BrokerID int
+	Led      int   // Partitions the broker led
+	Elected  int   // Partitions that get a new leader from their in-sync replicas
+	Offline  []int // Partitions without another in-sync replica, leaderless until the broker returns
+	// WorstCase is how long the last re-elected partition is leaderless, 0
+	// when the broker led nothing that can be re-elected.
+	WorstCase time.Duration
+	// Voter is set when a controller voter runs on the broker.
+	Voter bool
+	// ActiveController is set when the broker ran the active controller,
+	// the leader of the metadata log: the voters elect another one before
+	// any partition gets a new leader.
+	ActiveController bool
+	// QuorumLost is set when the broker took the quorum's majority with it:
+	// nothing is re-elected and every partition it led is offline until a
+	// majority of the voters is back.
+	QuorumLost bool
+}
+
+// Simulate fails every broker in turn and estimates how long the partitions
+// it led remain leaderless. Only leaders and followers are in sync; an
+// observer cannot take over without an unclean election or a manual
+// promotion. Losing the active controller adds a controller failover, and
+// losing the quorum's majority leaves every partition the broker led
+// offline.
+// Estimates are sorted worst first: failures that leave partitions offline,
+// then by worst case time.
+func Simulate(dcs map[int]*config.DCInfo, q Quorum, t Timing) []Estimate {
+	inSync := make(map[int]int) // PartitionID -> in-sync replica count
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, r := range broker.Replicas {
+				if r.Role == config.Leader || r.Role == config.Follower {
+					inSync[r.PartitionID]++
+				}
+			}
+		}
+	}
+
+	var estimates []Estimate
+	active, hasActive := q.ActiveVoter()
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			e := Estimate{
+				BrokerID:   broker.ID,
+				Voter:      q.OnBroker(broker.ID),
+				QuorumLost: !q.Survives(map[int]bool{broker.ID: true}, nil),
+			}
+			e.ActiveController = e.Voter && hasActive && active.NodeID == broker.ID
+			for _, r := range broker.Replicas {
+				if r.Role != config.Leader {
+					continue
+				}
+				e.Led++
+				if inSync[r.PartitionID] > 1 && !e.QuorumLost {
+					e.Elected++
+				} else {
+					e.Offline = append(e.Offline, r.PartitionID)
+				}
+			}
+			sort.Ints(e.Offline)
+			if e.Elected > 0 {
+				e.WorstCase = t.SessionTimeout + time.Duration(e.Elected)*t.ElectionTime + t.MetadataDelay
+				if e.ActiveController {
+					e.WorstCase += t.ControllerFailover
+				}
+			}
+			estimates = append(estimates, e)
+		}
+	}
+	sort.Slice(estimates, func(i, j int) bool {
+		a, b := estimates[i], estimates[j]
+		if len(a.Offline) != len(b.Offline) {
+			return len(a.Offline) > len(b.Offline)
+		}
+		if a.WorstCase != b.WorstCase {
+			return a.WorstCase > b.WorstCase
+		}
+		return a.BrokerID < b.BrokerID
+	})
+	return estimates
+}
+
+// Design is an MRC replication design evaluated under the loss of a DC.
+type Design int
+
+const (
+	SyncStretch    Design = iota // Every replica in sync across DCs (observers count as followers)
+	AsyncObservers               // Replicas as placed: followers in sync, observers asynchronous
+)
+
+// Designs returns all designs in declaration order.
+func Designs() []Design {
+	return []Design{SyncStretch, AsyncObservers}
+}
+
+// String returns the name shown in the UI.
+func (d Design) String() string {
+	if d == SyncStretch {
+		return "sync stretch"
+	}
+	return "async + observers"
+}
+
+// DCLossEstimate is the outcome of losing one DC under a design.
+type DCLossEstimate struct {
+	Design   Design
+	DCID     int
+	Affected int   // Partitions led in the lost DC
+	Promoted int   // Affected partitions that need an observer promoted
+	Lost     []int // Affected partitions without a surviving replica
+	// QuorumLost is set when the DC held the quorum's majority: no leader is
+	// elected and no observer promoted until the controllers are recovered,
+	// so RTO is 0 (unbounded).
+	QuorumLost bool
+	// RPO is the data written before the loss that may be gone: 0 when every
+	// recoverable partition fails over to an in-sync replica.
+	RPO time.Duration
+	// RTO is how long until every recoverable partition has a leader again.
+	RTO time.Duration
+}
+
+// SimulateDCLoss fails every DC in turn and estimates RPO and RTO for the
+// partitions led there. A surviving in-sync replica takes over
+// automatically without data loss; otherwise a surviving observer has to be
+// promoted, losing up to the observer lag. Both need the controller quorum:
+// losing the active controller adds a controller failover, and losing the
+// majority stops recovery altogether. Estimates are sorted by DC.
+func SimulateDCLoss(dcs map[int]*config.DCInfo, q Quorum, t Timing, d Design) []DCLossEstimate {
+	type replica struct {
+		dcID int
+		role config.ReplicaRole
+	}
+	byPartition := make(map[int][]replica)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, r := range broker.Replicas {
+				role := r.Role
+				if d == SyncStretch && role == config.Observer {
+					role = config.Follower
+				}
+				byPartition[r.PartitionID] = append(byPartition[r.PartitionID], replica{dc.ID, role})
+			}
+		}
+	}
+	partitionIDs := make([]int, 0, len(byPartition))
+	for id := range byPartition {
+		partitionIDs = append(partitionIDs, id)
+	}
+	sort.Ints(partitionIDs)
+
+	active, hasActive := q.ActiveVoter()
+	var estimates []DCLossEstimate
+	for _, dc := range dcs {
+		e := DCLossEstimate{Design: d, DCID: dc.ID, QuorumLost: !q.Survives(nil, map[int]bool{dc.ID: true})}
+		elected := 0
+		for _, id := range partitionIDs {
+			var ledHere, inSync, observer bool
+			for _, r := range byPartition[id] {
+				switch {
+				case r.dcID == dc.ID:
+					ledHere = ledHere || r.role == config.Leader
+				case r.role == config.Follower:
+					inSync = true
+				case r.role == config.Observer:
+					observer = true
+				}
+			}
+			if !ledHere {
+				continue
+			}
+			e.Affected++
+			switch {
+			case inSync:
+				elected++
+			case observer:
+				e.Promoted++
+			default:
+				e.Lost = append(e.Lost, id)
+			}
+		}
+		if elected > 0 {
+			e.RTO = t.SessionTimeout + time.Duration(elected)*t.ElectionTime + t.MetadataDelay
+		}
+		if e.Promoted > 0 {
+			e.RPO = t.ObserverLag
+			e.RTO = max(e.RTO, t.SessionTimeout+t.PromotionTime+t.MetadataDelay)
+		}
+		switch {
+		case e.QuorumLost:
+			e.RTO = 0
+		case e.RTO > 0 && hasActive && active.DCID == dc.ID:
+			e.RTO += t.ControllerFailover
+		}
+		estimates = append(estimates, e)
+	}
+	sort.Slice(estimates, func(i, j int) bool { return estimates[i].DCID < estimates[j].DCID })
+	return estimates
+}
+
+// Outage is the effect of a set of brokers failing at the same time.
+type Outage struct {
+	// Offline partitions have no in-sync replica left: they are leaderless
+	// until a broker returns or an observer is promoted.
+	Offline []int
+	// UnderMinISR partitions still have a leader but fewer in-sync replicas
+	// than min ISR, so acks=all writes are rejected.
+	UnderMinISR []int
+	// QuorumLost is set when the failure took the controller quorum's
+	// majority: partitions whose leader failed stay offline even with
+	// in-sync replicas left, as nothing can elect a new leader.
+	QuorumLost bool
+}
+
+// NoWrites returns the partitions that reject acks=all writes: the offline
+// ones and those under min ISR.
+func (o Outage) NoWrites() []int {
+	ids := append(append([]int(nil), o.Offline...), o.UnderMinISR...)
+	sort.Ints(ids)
+	return ids
+}
+
+// SimulateOutage fails the given brokers together. Only leaders and
+// followers are in sync; observers don't take over on their own.
+func SimulateOutage(dcs map[int]*config.DCInfo, q Quorum, failed map[int]bool, minInSyncReplicas int) Outage {
+	return outage(dcs, failed, !q.Survives(failed, nil), minInSyncReplicas)
+}
+
+// SimulateDCOutage fails every broker of the given data centers together,
+// along with the controllers running there.
+func SimulateDCOutage(dcs map[int]*config.DCInfo, q Quorum, failedDCs []int, minInSyncReplicas int) Outage {
+	failed := make(map[int]bool)
+	lostDCs := make(map[int]bool, len(failedDCs))
+	for _, id := range failedDCs {
+		lostDCs[id] = true
+		if dc, ok := dcs[id]; ok {
+			for brokerID := range dc.Brokers {
+				failed[brokerID] = true
+			}
+		}
+	}
+	return outage(dcs, failed, !q.Survives(failed, lostDCs), minInSyncReplicas)
+}
+
+// DCCombinations returns every set of data centers that can fail while at
+// least one survives, each sorted: all single DCs first, then all pairs and
+// so on, in order of their IDs.
+func DCCombinations(dcIDs []int) [][]int {
+	ids := append([]int(nil), dcIDs...)
+	sort.Ints(ids)
+	var combos [][]int
+	var pick func(start, size int, combo []int)
+	pick = func(start, size int, combo []int) {
+		if len(combo) == size {
+			combos = append(combos, append([]int(nil), combo...))
+			return
+		}
+		for i := start; i < len(ids); i++ {
+			pick(i+1, size, append(combo, ids[i]))
+		}
+	}
+	for size := 1; size < len(ids); size++ {
+		pick(0, size, nil)
+	}
+	return combos
+}
+
+// outage fails the given brokers; quorumLost tells whether the controller
+// quorum went with them.
+func outage(dcs map[int]*config.DCInfo, failed map[int]bool, quorumLost bool, minInSyncReplicas int) Outage {
+	o := Outage{QuorumLost: quorumLost}
+	alive := make(map[int]int)       // PartitionID -> surviving in-sync replicas
+	leaderLost := make(map[int]bool) // Partitions whose leader failed
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, r := range broker.Replicas {
+				if _, ok := alive[r.PartitionID]; !ok {
+					alive[r.PartitionID] = 0
+				}
+				if failed[broker.ID] && r.Role == config.Leader {
+					leaderLost[r.PartitionID] = true
+				}
+				if !failed[broker.ID] && (r.Role == config.Leader || r.Role == config.Follower) {
+					alive[r.PartitionID]++
+				}
+			}
+		}
+	}
+	for id, n := range alive {
+		switch {
+		case n == 0, o.QuorumLost && leaderLost[id]:
+			o.Offline = append(o.Offline, id)
+		case n < minInSyncReplicas:
+			o.UnderMinISR = append(o.UnderMinISR, id)
+		}
+	}
+	sort.Ints(o.Offline)
+	sort.Ints(o.UnderMinISR)
+	return o
+}
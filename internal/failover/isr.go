@@ -0,0 +1,395 @@
+package failover
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// DefaultCatchUp is how long a follower that fell behind fetches before it
+// has caught up with the leader again.
+const DefaultCatchUp = 10 * time.Second
+
+// DefaultReplicaLagTimeMax is Kafka's replica.lag.time.max.ms: how long a
+// follower may go without catching up to the leader before the leader
+// drops it from the ISR.
+const DefaultReplicaLagTimeMax = 30 * time.Second
+
+// BrokerEventKind is what happens to a broker on the ISR timeline.
+type BrokerEventKind int
+
+const (
+	EventSlowdown BrokerEventKind = iota // Still up, but its fetches fall behind (slow disk, GC, saturated NIC)
+	EventRestart                         // Down, then back as a follower that has to catch up
+)
+
+// String returns the kind's name as used in event specs.
+func (k BrokerEventKind) String() string {
+	if k == EventRestart {
+		return "restart"
+	}
+	return "slow"
+}
+
+// BrokerEvent is one slowdown or restart of a broker.
+type BrokerEvent struct {
+	Kind     BrokerEventKind
+	BrokerID int
+	At       time.Duration // Since the start of the timeline
+	Duration time.Duration
+}
+
+// String formats the event as parsed by ParseBrokerEvents, e.g.
+// "slow:1@10s+45s".
+func (e BrokerEvent) String() string {
+	return fmt.Sprintf("%s:%d@%s+%s", e.Kind, e.BrokerID, e.At, e.Duration)
+}
+
+// ParseBrokerEvents parses a comma-separated list of events, each
+// kind:broker@start+duration with kind slow or restart, e.g.
+// "slow:1@10s+45s,restart:2@90s+20s".
+func ParseBrokerEvents(spec string) ([]BrokerEvent, error) {
+	var events []BrokerEvent
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kind, rest, ok1 := strings.Cut(part, ":")
+		broker, window, ok2 := strings.Cut(rest, "@")
+		start, length, ok3 := strings.Cut(window, "+")
+		if !ok1 || !ok2 || !ok3 {
+			return nil, fmt.Errorf("invalid event %q, expected kind:broker@start+duration", part)
+		}
+		var e BrokerEvent
+		switch kind {
+		case "slow":
+			e.Kind = EventSlowdown
+		case "restart":
+			e.Kind = EventRestart
+		default:
+			return nil, fmt.Errorf("invalid event %q: kind must be slow or restart", part)
+		}
+		var err error
+		if e.BrokerID, err = strconv.Atoi(broker); err != nil || e.BrokerID < 0 {
+			return nil, fmt.Errorf("invalid event %q: bad broker ID", part)
+		}
+		if e.At, err = time.ParseDuration(start); err != nil || e.At < 0 {
+			return nil, fmt.Errorf("invalid event %q: bad start time", part)
+		}
+		if e.Duration, err = time.ParseDuration(length); err != nil || e.Duration <= 0 {
+			return nil, fmt.Errorf("invalid event %q: bad duration", part)
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no events given")
+	}
+	return events, nil
+}
+
+// ISRParams sets up the ISR timeline.
+type ISRParams struct {
+	LagTimeMax     time.Duration // replica.lag.time.max.ms
+	SessionTimeout time.Duration // Until a down broker is fenced and leaves every ISR
+	CatchUp        time.Duration // Fetching a recovered follower needs to catch up again
+	MinISR         int
+	// Quorum commits every ISR change, fencing and election to the metadata
+	// log; while restarts take its majority down, the ISRs stay as they are.
+	Quorum Quorum
+}
+
+// ReplicaState is a replica's ISR membership at one moment.
+type ReplicaState int
+
+const (
+	StateInSync    ReplicaState = iota // In the ISR and caught up
+	StateLagging                       // Still in the ISR, but behind the leader
+	StateOutOfSync                     // Dropped from the ISR, catching up
+	StateOffline                       // The broker is down
+)
+
+// ISREvent is one change on the timeline, e.g. a follower leaving the ISR.
+type ISREvent struct {
+	At          time.Duration
+	BrokerID    int // -1 for partition-wide events
+	PartitionID int
+	What        string
+	Alert       bool // Writes start failing or the metadata quorum is lost
+}
+
+// PartitionISR is the ISR membership of one partition over the timeline,
+// one entry per second.
+type PartitionISR struct {
+	PartitionID int
+	Brokers     []int            // The preferred leader first, then its followers
+	States      [][]ReplicaState // States[i][t] is the state of Brokers[i] at second t
+	Leader      []int            // -1 while the partition has no leader or it is down
+	ISR         []int            // ISR size
+}
+
+// UnderMinISR reports whether acks=all writes fail at second t: the
+// partition has no leader or fewer in-sync replicas than min ISR.
+func (p PartitionISR) UnderMinISR(t, minISR int) bool {
+	return p.Leader[t] < 0 || p.ISR[t] < minISR
+}
+
+// ISRTimeline is the simulated ISR membership of every partition.
+type ISRTimeline struct {
+	Length     time.Duration
+	Partitions []PartitionISR
+	Events     []ISREvent // Sorted by time
+}
+
+// Horizon returns a timeline length that shows every event and its
+// aftermath: the last recovery, the catch-up and one lag window more.
+func Horizon(events []BrokerEvent, p ISRParams) time.Duration {
+	var end time.Duration
+	for _, e := range events {
+		end = max(end, e.At+e.Duration)
+	}
+	return end + p.CatchUp + p.LagTimeMax/2
+}
+
+// SimulateISR follows every partition's ISR through broker slowdowns and
+// restarts, second by second. A follower catches up while its broker is up
+// and not slowed down, needing p.CatchUp of steady fetching after it was
+// behind. The leader drops a follower that has not caught up for
+// p.LagTimeMax; a down broker leaves every ISR once it is fenced after the
+// session timeout, and its leaderships move to the first other in-sync
+// replica. Without one the partition stays offline until the broker
+// returns, as unclean leader election is off. A follower back in sync
+// rejoins the ISR. All of these are metadata changes: while restarted
+// combined controllers take the quorum's majority, none is committed and
+// every ISR and leader stays as it was. Observers are asynchronous and never
+// in the ISR, so they are left out.
+func SimulateISR(dcs map[int]*config.DCInfo, events []BrokerEvent, length time.Duration, p ISRParams) ISRTimeline {
+	seconds := int(length / time.Second)
+	down := make(map[int][]bool) // BrokerID -> down at second t
+	slow := make(map[int][]bool)
+	for _, e := range events {
+		target := slow
+		if e.Kind == EventRestart {
+			target = down
+		}
+		if target[e.BrokerID] == nil {
+			target[e.BrokerID] = make([]bool, seconds)
+		}
+		from := int(e.At / time.Second)
+		to := int((e.At + e.Duration) / time.Second)
+		for t := from; t < to && t < seconds; t++ {
+			target[e.BrokerID][t] = true
+		}
+	}
+	isDown := func(id, t int) bool { return down[id] != nil && down[id][t] }
+	tl := ISRTimeline{Length: length}
+	// A cluster-wide event, logged for partition 0
+	cluster := func(t int, alert bool, what string) {
+		tl.Events = append(tl.Events, ISREvent{At: time.Duration(t) * time.Second, BrokerID: -1, What: what, Alert: alert})
+	}
+	quorumUp := make([]bool, seconds)
+	for t := range quorumUp {
+		failed := make(map[int]bool)
+		for id := range down {
+			failed[id] = isDown(id, t)
+		}
+		quorumUp[t] = p.Quorum.Survives(failed, nil)
+		if (t > 0 && quorumUp[t] != quorumUp[t-1]) || (t == 0 && !quorumUp[t]) {
+			if quorumUp[t] {
+				cluster(t, false, i18n.T("isr.quorumBack"))
+			} else {
+				cluster(t, true, i18n.T("isr.quorumLost", MetadataTopic))
+			}
+		}
+	}
+	isSlow := func(id, t int) bool { return slow[id] != nil && slow[id][t] }
+	lagMax := int(p.LagTimeMax / time.Second)
+	fence := int(p.SessionTimeout / time.Second)
+	catchUp := int(p.CatchUp / time.Second)
+
+	for _, part := range partitionsOf(dcs) {
+		pt := PartitionISR{PartitionID: part.id, Brokers: part.brokers}
+		n := len(part.brokers)
+		pt.States = make([][]ReplicaState, n)
+		for i := range pt.States {
+			pt.States[i] = make([]ReplicaState, seconds)
+		}
+		inISR := make([]bool, n)
+		lastCaughtUp := make([]int, n)
+		steady := make([]int, n) // Seconds of steady fetching since the replica fell behind
+		behind := make([]bool, n)
+		downSince := make([]int, n)
+		for i := range inISR {
+			inISR[i] = true
+			downSince[i] = -1
+		}
+		leader := 0      // Index into Brokers
+		lastLeader := -1 // The leader while the partition is offline
+		failing := ""    // Why writes fail
+		event := func(t, i int, alert bool, what string) {
+			id := -1
+			if i >= 0 {
+				id = part.brokers[i]
+			}
+			tl.Events = append(tl.Events, ISREvent{At: time.Duration(t) * time.Second, BrokerID: id, PartitionID: part.id, What: what, Alert: alert})
+		}
+
+		for t := 0; t < seconds; t++ {
+			leaderless := leader < 0 || isDown(part.brokers[leader], t)
+			for i, id := range part.brokers {
+				switch {
+				case isDown(id, t):
+					if downSince[i] < 0 {
+						downSince[i] = t
+					}
+					behind[i], steady[i] = true, 0
+				case isSlow(id, t) && i != leader:
+					behind[i], steady[i] = true, 0
+				default:
+					downSince[i] = -1
+					if behind[i] {
+						steady[i]++
+						if steady[i] >= catchUp {
+							behind[i] = false
+						}
+					}
+				}
+				if !behind[i] || leaderless {
+					lastCaughtUp[i] = t // Nothing to fetch without a leader
+				}
+			}
+
+			// A fenced broker leaves every ISR and loses its leaderships
+			for i := range part.brokers {
+				if !quorumUp[t] || downSince[i] < 0 || t-downSince[i] < fence || i == lastLeader || !inISR[i] {
+					continue
+				}
+				if leader != i {
+					inISR[i] = false
+					event(t, i, false, i18n.T("isr.fenced"))
+					continue
+				}
+				leader = -1
+				for j := range part.brokers {
+					if j != i && inISR[j] && downSince[j] < 0 {
+						leader = j
+						break
+					}
+				}
+				if leader >= 0 {
+					inISR[i] = false
+					event(t, i, false, i18n.T("isr.fenced"))
+					event(t, leader, false, i18n.T("isr.elected"))
+				} else {
+					// The last in-sync replica stays in the ISR, as only it has every acknowledged write
+					lastLeader = i
+					event(t, i, true, i18n.T("isr.offline"))
+				}
+			}
+			// The last in-sync replica leads again as soon as it is back
+			if quorumUp[t] && leader < 0 && lastLeader >= 0 && downSince[lastLeader] < 0 {
+				leader = lastLeader
+				lastLeader = -1
+				behind[leader] = false
+				event(t, leader, false, i18n.T("isr.leadsAgain"))
+			}
+			// The leader shrinks and expands the ISR
+			if quorumUp[t] && leader >= 0 {
+				for i := range part.brokers {
+					if i == leader || downSince[i] >= 0 {
+						continue
+					}
+					switch {
+					case inISR[i] && t-lastCaughtUp[i] > lagMax:
+						inISR[i] = false
+						event(t, i, false, i18n.T("isr.leaves", p.LagTimeMax))
+					case !inISR[i] && !behind[i]:
+						inISR[i] = true
+						event(t, i, false, i18n.T("isr.rejoins"))
+					}
+				}
+			}
+
+			size := 0
+			for i, id := range part.brokers {
+				switch {
+				case isDown(id, t):
+					pt.States[i][t] = StateOffline
+				case !inISR[i]:
+					pt.States[i][t] = StateOutOfSync
+				case behind[i] && i != leader:
+					pt.States[i][t] = StateLagging
+				default:
+					pt.States[i][t] = StateInSync
+				}
+				if inISR[i] {
+					size++
+				}
+			}
+			pt.ISR = append(pt.ISR, size)
+			if leader >= 0 && !isDown(part.brokers[leader], t) {
+				pt.Leader = append(pt.Leader, part.brokers[leader])
+			} else {
+				pt.Leader = append(pt.Leader, -1) // A down leader serves nothing until it is replaced
+			}
+			var why string // Why writes fail, "" when they succeed
+			switch {
+			case pt.Leader[t] < 0:
+				why = i18n.T("isr.noLeader")
+			case size < p.MinISR:
+				why = i18n.T("isr.underMinISR", size, p.MinISR)
+			}
+			if why != failing {
+				if why == "" {
+					why = i18n.T("isr.writesBack", size, p.MinISR)
+					failing = ""
+				} else {
+					failing = why
+				}
+				event(t, -1, failing != "", why)
+			}
+		}
+		tl.Partitions = append(tl.Partitions, pt)
+	}
+	sort.SliceStable(tl.Events, func(i, j int) bool { return tl.Events[i].At < tl.Events[j].At })
+	return tl
+}
+
+// partitionReplicas is a partition's ISR-eligible brokers, leader first.
+type partitionReplicas struct {
+	id      int
+	brokers []int
+}
+
+// partitionsOf lists the leader and followers of every partition, sorted
+// by partition ID.
+func partitionsOf(dcs map[int]*config.DCInfo) []partitionReplicas {
+	leaders := make(map[int]int)
+	followers := make(map[int][]int)
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			for _, r := range b.Replicas {
+				switch r.Role {
+				case config.Leader:
+					leaders[r.PartitionID] = b.ID
+				case config.Follower:
+					followers[r.PartitionID] = append(followers[r.PartitionID], b.ID)
+				}
+			}
+		}
+	}
+	result := make([]partitionReplicas, 0, len(leaders))
+	for id, leader := range leaders {
+		f := followers[id]
+		sort.Ints(f)
+		result = append(result, partitionReplicas{id: id, brokers: append([]int{leader}, f...)})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].id < result[j].id })
+	return result
+}
@@ -0,0 +1,99 @@
+package failover
+
+import (
+	"sort"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// MetadataTopic is the single-partition log a KRaft cluster keeps all of
+// its metadata in: topics, partition leaders and ISRs, broker registrations.
+const MetadataTopic = "__cluster_metadata"
+
+// ActiveVoter returns the active controller, the leader of the metadata
+// log: Active if it names a voter, else the voter with the lowest node ID.
+// With a ZooKeeper ensemble it stands for the ensemble's leader. ok is
+// false without voters.
+func (q Quorum) ActiveVoter() (v Voter, ok bool) {
+	if len(q.Voters) == 0 {
+		return Voter{}, false
+	}
+	for _, v := range q.Voters {
+		if v.NodeID == q.Active {
+			return v, true
+		}
+	}
+	return q.Voters[0], true // Sorted by node ID
+}
+
+// MetadataLog is the placement of the __cluster_metadata partition. The
+// active controller leads it and every voter holds a replica that counts
+// towards its commits, while every other broker fetches it as an observer
+// to keep its metadata cache current.
+type MetadataLog struct {
+	Leader    Voter
+	Voters    []Voter
+	Observers []int // Broker IDs, sorted
+}
+
+// NewMetadataLog places the metadata log of the quorum on the brokers of
+// dcs. ok is false for a ZooKeeper-based cluster, which keeps its metadata
+// in ZooKeeper instead.
+func NewMetadataLog(q Quorum, dcs map[int]*config.DCInfo) (l MetadataLog, ok bool) {
+	leader, ok := q.ActiveVoter()
+	if !ok || q.Mode == config.ControllersZooKeeper {
+		return MetadataLog{}, false
+	}
+	l = MetadataLog{Leader: leader, Voters: q.Voters}
+	for _, dc := range dcs {
+		for id := range dc.Brokers {
+			if !q.OnBroker(id) {
+				l.Observers = append(l.Observers, id)
+			}
+		}
+	}
+	sort.Ints(l.Observers)
+	return l, true
+}
+
+// MetadataOutcome is the state of the metadata log after a failure.
+type MetadataOutcome struct {
+	// Writable is set while a majority of the voters is up: new leaders,
+	// ISR changes and broker registrations can still be committed.
+	Writable bool
+	// LeaderMoved is set when the active controller failed, so the voters
+	// elect another one before any metadata changes.
+	LeaderMoved bool
+	Leader      int // Node ID of the active controller afterwards, -1 when none
+	VotersUp    int
+}
+
+// After returns the metadata log once the given brokers and DCs have failed
+// together. Failed brokers only take voters with them in combined mode. The
+// new active controller is the surviving voter with the lowest node ID.
+func (l MetadataLog) After(q Quorum, failedBrokers, failedDCs map[int]bool) MetadataOutcome {
+	down := func(v Voter) bool {
+		return failedDCs[v.DCID] || (q.Mode == config.ControllersCombined && failedBrokers[v.NodeID])
+	}
+	o := MetadataOutcome{Writable: q.Survives(failedBrokers, failedDCs), Leader: -1, LeaderMoved: down(l.Leader)}
+	for _, v := range l.Voters {
+		if !down(v) {
+			o.VotersUp++
+		}
+	}
+	if !o.Writable {
+		return o
+	}
+	if !o.LeaderMoved {
+		o.Leader = l.Leader.NodeID
+		return o
+	}
+	for _, v := range l.Voters {
+		if !down(v) {
+			o.Leader = v.NodeID
+			break
+		}
+	}
+	return o
+}
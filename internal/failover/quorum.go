@@ -0,0 +1,197 @@
+package failover
+
+import (
+	"sort"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// DefaultQuorumSize is the number of KRaft controller voters when the config
+// does not set one: the smallest quorum that survives a voter failure.
+const DefaultQuorumSize = 3
+
+// Voter is one member of the KRaft controller quorum.
+type Voter struct {
+	NodeID int // The broker's ID in combined mode, a node ID after the brokers' when dedicated
+	DCID   int
+}
+
+// Quorum is the KRaft controller quorum of a placement. Leader elections,
+// observer promotions and reassignments all go through the active
+// controller, so they stop while a majority of the voters is down; the
+// partitions keep their current leaders meanwhile. The zero Quorum models
+// no controllers and always keeps its majority.
+type Quorum struct {
+	Mode   config.ControllerMode
+	Voters []Voter // Sorted by node ID
+	Active int     // Node ID of the active controller, see ActiveVoter
+}
+
+// NewQuorum places the controller quorum of cfg on the topology of dcs,
+// spreading the voters over the DCs in turn. Combined voters run on the
+// lowest broker IDs of each DC; dedicated and ZooKeeper voters get node IDs
+// after the highest broker ID, as separate pools usually do. Explicit
+// ControllerNodes are used as they are.
+func NewQuorum(cfg config.PlacementConfig, dcs map[int]*config.DCInfo) Quorum {
+	q := Quorum{Mode: cfg.Controllers, Active: -1}
+	if len(cfg.ControllerNodes) > 0 {
+		for _, n := range cfg.ControllerNodes {
+			q.Voters = append(q.Voters, Voter{NodeID: n.ID, DCID: n.DCID})
+		}
+		sort.Slice(q.Voters, func(i, j int) bool { return q.Voters[i].NodeID < q.Voters[j].NodeID })
+		return q
+	}
+	size := cfg.ControllerCount
+	if size == 0 {
+		size = DefaultQuorumSize
+	}
+
+	dcIDs := make([]int, 0, len(dcs))
+	byDC := make(map[int][]int)
+	total, maxID := 0, -1
+	for id, dc := range dcs {
+		dcIDs = append(dcIDs, id)
+		for brokerID := range dc.Brokers {
+			byDC[id] = append(byDC[id], brokerID)
+			maxID = max(maxID, brokerID)
+			total++
+		}
+		sort.Ints(byDC[id])
+	}
+	sort.Ints(dcIDs)
+	if len(dcIDs) == 0 {
+		return q
+	}
+
+	if cfg.Controllers != config.ControllersCombined {
+		for i := 0; i < size; i++ {
+			q.Voters = append(q.Voters, Voter{NodeID: maxID + 1 + i, DCID: dcIDs[i%len(dcIDs)]})
+		}
+		return q
+	}
+	size = min(size, total)
+	for round := 0; len(q.Voters) < size; round++ {
+		for _, id := range dcIDs {
+			if round < len(byDC[id]) && len(q.Voters) < size {
+				q.Voters = append(q.Voters, Voter{NodeID: byDC[id][round], DCID: id})
+			}
+		}
+	}
+	sort.Slice(q.Voters, func(i, j int) bool { return q.Voters[i].NodeID < q.Voters[j].NodeID })
+	return q
+}
+
+// Majority returns the number of voters that have to be up for the quorum
+// to elect an active controller.
+func (q Quorum) Majority() int {
+	return len(q.Voters)/2 + 1
+}
+
+// OnBroker reports whether a voter runs in the given broker's process.
+func (q Quorum) OnBroker(brokerID int) bool {
+	if q.Mode != config.ControllersCombined {
+		return false
+	}
+	for _, v := range q.Voters {
+		if v.NodeID == brokerID {
+			return true
+		}
+	}
+	return false
+}
+
+// InDC reports whether a voter runs in the given DC.
+func (q Quorum) InDC(dcID int) bool {
+	for _, v := range q.Voters {
+		if v.DCID == dcID {
+			return true
+		}
+	}
+	return false
+}
+
+// Survives reports whether a majority of the voters is left when the given
+// brokers and DCs fail together. Failed brokers only take voters with them
+// in combined mode; a failed DC takes every voter in it.
+func (q Quorum) Survives(failedBrokers, failedDCs map[int]bool) bool {
+	if len(q.Voters) == 0 {
+		return true
+	}
+	up := 0
+	for _, v := range q.Voters {
+		if failedDCs[v.DCID] || (q.Mode == config.ControllersCombined && failedBrokers[v.NodeID]) {
+			continue
+		}
+		up++
+	}
+	return up >= q.Majority()
+}
+
+// QuorumRisk is a DC whose loss takes the quorum's majority although every
+// partition keeps a replica elsewhere: the data survives but cannot fail
+// over, as nothing elects new leaders.
+type QuorumRisk struct {
+	DCID   int
+	Voters int // Voters in the DC
+}
+
+// QuorumRisks returns the DCs whose loss alone loses the quorum while the
+// data survives, sorted by DC. DCs without brokers, e.g. a site holding only
+// voters, count too.
+func QuorumRisks(dcs map[int]*config.DCInfo, q Quorum) []QuorumRisk {
+	perDC := make(map[int]int)
+	for _, v := range q.Voters {
+		perDC[v.DCID]++
+	}
+	var risks []QuorumRisk
+	for dcID, n := range perDC {
+		if q.Survives(nil, map[int]bool{dcID: true}) || !dataSurvives(dcs, dcID) {
+			continue
+		}
+		risks = append(risks, QuorumRisk{DCID: dcID, Voters: n})
+	}
+	sort.Slice(risks, func(i, j int) bool { return risks[i].DCID < risks[j].DCID })
+	return risks
+}
+
+// dataSurvives reports whether every partition has a replica outside the
+// lost DC.
+func dataSurvives(dcs map[int]*config.DCInfo, lost int) bool {
+	outside := make(map[int]bool)
+	all := make(map[int]bool)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, r := range broker.Replicas {
+				all[r.PartitionID] = true
+				if dc.ID != lost {
+					outside[r.PartitionID] = true
+				}
+			}
+		}
+	}
+	return len(outside) == len(all)
+}
+
+// SuggestVoters spreads a quorum over the given DCs so that it survives the
+// loss of any one of them: voters per DC, keyed by DC ID. It keeps the
+// quorum's size if it can, else grows it to the next size that spreads
+// (e.g. 4 voters over 3 DCs become 5). ok is false with fewer than 3 DCs,
+// where one DC always holds a majority and a tiebreaker site is needed.
+func SuggestVoters(q Quorum, dcIDs []int) (perDC map[int]int, ok bool) {
+	if len(dcIDs) < 3 {
+		return nil, false
+	}
+	ids := append([]int(nil), dcIDs...)
+	sort.Ints(ids)
+	size := max(len(q.Voters), DefaultQuorumSize)
+	// A DC may hold at most the voters the quorum can lose
+	for (size+len(ids)-1)/len(ids) > (size-1)/2 {
+		size++
+	}
+	perDC = make(map[int]int, len(ids))
+	for i := 0; i < size; i++ {
+		perDC[ids[i%len(ids)]]++
+	}
+	return perDC, true
+}
@@ -0,0 +1,214 @@
+package failover
+
+import (
+	"sort"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Rebalance holds the controller's automatic preferred leader election
+// settings and how long a failed broker takes to come back.
+type Rebalance struct {
+	Enabled          bool          // auto.leader.rebalance.enable
+	CheckInterval    time.Duration // leader.imbalance.check.interval.seconds
+	ImbalancePercent int           // leader.imbalance.per.broker.percentage
+	RestartTime      time.Duration // Until the failed broker is running again
+	CatchUpTime      time.Duration // Until its replicas rejoin the ISR after the restart
+}
+
+// DefaultRebalance returns Kafka's rebalance defaults (enabled, checked
+// every 5 minutes, 10% imbalance allowed) and a broker that restarts after
+// 5 minutes and catches up in 30 seconds.
+func DefaultRebalance() Rebalance {
+	return Rebalance{
+		Enabled:          true,
+		CheckInterval:    5 * time.Minute,
+		ImbalancePercent: 10,
+		RestartTime:      5 * time.Minute,
+		CatchUpTime:      30 * time.Second,
+	}
+}
+
+// RebalancePhase is what happens at one step of a rebalance timeline.
+type RebalancePhase int
+
+const (
+	PhaseFenced    RebalancePhase = iota // The controller fenced the broker and moved its leaderships
+	PhaseRestarted                       // The broker runs again; its replicas are catching up
+	PhaseInSync                          // Its replicas are back in the ISR, still led elsewhere
+	PhaseCheck                           // The controller checked the leader imbalance
+)
+
+// RebalanceStep is one step of a rebalance timeline.
+type RebalanceStep struct {
+	At    time.Duration // Since the broker failed
+	Phase RebalancePhase
+	// Moved is the number of leaderships that moved at this step.
+	Moved int
+	// NonPreferred is the number of partitions led by another replica than
+	// their preferred leader after the step.
+	NonPreferred int
+	// MaxLeaders is the number of partitions the busiest broker leads after
+	// the step.
+	MaxLeaders int
+	// Imbalance is the broker's leader imbalance in percent at a check: the
+	// share of the partitions it prefers that another replica leads.
+	Imbalance int
+}
+
+// RebalanceTimeline is how leadership moves when one broker fails and
+// comes back.
+type RebalanceTimeline struct {
+	BrokerID  int
+	Preferred int // Partitions the broker is the preferred leader of
+	Steps     []RebalanceStep
+	// Returned is when leadership is back on the preferred replicas, 0 when
+	// it never returns automatically.
+	Returned time.Duration
+	// Window is how long the imbalance outlasts the broker's recovery: from
+	// its replicas rejoining the ISR until leadership returns.
+	Window time.Duration
+	// Stuck is set when leadership stays on the other replicas: automatic
+	// rebalancing is disabled or the imbalance is within the threshold, so
+	// it takes a manual preferred leader election.
+	Stuck bool
+}
+
+// SimulateRebalance fails a broker, brings it back after r.RestartTime and
+// follows its leaderships: the controller moves them to another in-sync
+// replica once the broker is fenced (the lowest broker ID, as the
+// simulator keeps no replica order), the broker returns as a follower,
+// and leadership only moves back at the first imbalance check after it
+// caught up, if the broker's imbalance is above r.ImbalancePercent. Checks
+// run every r.CheckInterval since the failure; in practice the window after
+// the recovery is anywhere up to one interval. Partitions without another
+// in-sync replica, or all of them when the quorum is lost, stay leaderless
+// and return with the broker.
+func SimulateRebalance(dcs map[int]*config.DCInfo, q Quorum, t Timing, r Rebalance, brokerID int) RebalanceTimeline {
+	tl := RebalanceTimeline{BrokerID: brokerID}
+	var estimate Estimate
+	for _, e := range Simulate(dcs, q, t) {
+		if e.BrokerID == brokerID {
+			estimate = e
+		}
+	}
+
+	preferred := make(map[int]int)   // PartitionID -> preferred (placed) leader
+	followers := make(map[int][]int) // PartitionID -> in-sync followers, sorted
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, rep := range broker.Replicas {
+				switch rep.Role {
+				case config.Leader:
+					preferred[rep.PartitionID] = broker.ID
+				case config.Follower:
+					followers[rep.PartitionID] = append(followers[rep.PartitionID], broker.ID)
+				}
+			}
+		}
+	}
+	for _, ids := range followers {
+		sort.Ints(ids)
+	}
+	leader := make(map[int]int, len(preferred)) // PartitionID -> current leader, -1 if none
+	var mine []int
+	for id, b := range preferred {
+		leader[id] = b
+		if b == brokerID {
+			mine = append(mine, id)
+		}
+	}
+	sort.Ints(mine)
+	tl.Preferred = len(mine)
+
+	step := func(at time.Duration, phase RebalancePhase, moved int) RebalanceStep {
+		s := RebalanceStep{At: at, Phase: phase, Moved: moved}
+		counts := make(map[int]int)
+		for id, b := range leader {
+			if b != preferred[id] {
+				s.NonPreferred++
+			}
+			if b >= 0 {
+				counts[b]++
+				s.MaxLeaders = max(s.MaxLeaders, counts[b])
+			}
+		}
+		if len(mine) > 0 {
+			s.Imbalance = (len(mine) - countLedBy(leader, mine, brokerID)) * 100 / len(mine)
+		}
+		return s
+	}
+
+	// Fenced: leadership moves to the first other in-sync replica
+	fenced := t.SessionTimeout
+	if estimate.WorstCase > 0 {
+		fenced = estimate.WorstCase
+	}
+	moved := 0
+	for _, id := range mine {
+		leader[id] = -1
+		if estimate.QuorumLost {
+			continue
+		}
+		for _, f := range followers[id] {
+			if f != brokerID {
+				leader[id] = f
+				moved++
+				break
+			}
+		}
+	}
+	tl.Steps = append(tl.Steps, step(fenced, PhaseFenced, moved))
+
+	// Restarted: leaderless partitions come back with their only replica
+	back := 0
+	for _, id := range mine {
+		if leader[id] < 0 {
+			leader[id] = brokerID
+			back++
+		}
+	}
+	restarted := max(r.RestartTime, fenced)
+	tl.Steps = append(tl.Steps, step(restarted, PhaseRestarted, back))
+	inSync := restarted + r.CatchUpTime
+	tl.Steps = append(tl.Steps, step(inSync, PhaseInSync, 0))
+	if moved == 0 {
+		return tl // Nothing to give back
+	}
+
+	if !r.Enabled || r.CheckInterval <= 0 {
+		tl.Stuck = true
+		return tl
+	}
+	// The first check that finds the broker in sync
+	check := (inSync + r.CheckInterval - 1) / r.CheckInterval * r.CheckInterval
+	s := step(check, PhaseCheck, 0)
+	if s.Imbalance <= r.ImbalancePercent {
+		tl.Steps = append(tl.Steps, s)
+		tl.Stuck = true
+		return tl
+	}
+	for _, id := range mine {
+		leader[id] = brokerID // Preferred leader election
+	}
+	imbalance := s.Imbalance
+	s = step(check, PhaseCheck, moved)
+	s.Imbalance = imbalance // As found by the check
+	tl.Steps = append(tl.Steps, s)
+	tl.Returned = check
+	tl.Window = check - inSync
+	return tl
+}
+
+// countLedBy counts the partitions of ids that the broker leads.
+func countLedBy(leader map[int]int, ids []int, brokerID int) int {
+	n := 0
+	for _, id := range ids {
+		if leader[id] == brokerID {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,178 @@
+package failover
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// DefaultRecoveryRate is the fetch rate assumed for a recovering broker
+// without a replication throttle or a known network capacity: a 1 Gbit/s
+// link.
+const DefaultRecoveryRate = 125e6
+
+// RecoveryParams sets up a recovery.
+type RecoveryParams struct {
+	// Downtime is how long the broker was down: its replicas are behind by
+	// what the partitions took in meanwhile.
+	Downtime time.Duration
+	// Rebuild recreates the broker's replicas on an empty broker instead,
+	// e.g. after replacing a failed disk, so every byte is copied.
+	Rebuild bool
+	// Rate caps what a broker fetches, and what a leader sends, in
+	// bytes/sec: the replication throttle, or the network without one.
+	Rate  float64
+	Loads map[int]config.PartitionLoad
+}
+
+// PartitionCatchUp is the recovery of one replica of the broker.
+type PartitionCatchUp struct {
+	PartitionID int
+	Role        config.ReplicaRole // Its role in the placement
+	Source      int                // Broker it fetches from
+	Bytes       int64              // Behind when the broker returns
+	// Done is how long after the return the replica caught up, -1 if it
+	// never does because the partition takes in data faster than the
+	// replica may fetch.
+	Done time.Duration
+}
+
+// Recovery is how a failed broker's replicas catch up when it returns.
+type Recovery struct {
+	BrokerID   int
+	Partitions []PartitionCatchUp // Slowest first
+	Bytes      int64
+	// Done is when the last replica caught up, -1 if one never does.
+	Done time.Duration
+	// Unknown counts the replicas without the size (Rebuild) or ingest rate
+	// the estimate needs; they are left out.
+	Unknown int
+}
+
+// SimulateRecovery estimates how the replicas of a broker catch up when it
+// returns after p.Downtime, or when they are rebuilt from scratch. Each
+// replica fetches from the partition's leader, or from its first follower
+// when the broker itself led it, while the partition keeps taking in data.
+// The broker's fetch rate is shared evenly by the replicas still behind,
+// and a leader sending to several of them is held to the same rate; what a
+// busy leader leaves unused is not handed to the other replicas.
+func SimulateRecovery(dcs map[int]*config.DCInfo, brokerID int, p RecoveryParams) Recovery {
+	rec := Recovery{BrokerID: brokerID}
+	source := make(map[int]int)      // PartitionID -> leader
+	followers := make(map[int][]int) // PartitionID -> followers, sorted
+	var mine []config.ReplicaInfo
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			for _, r := range b.Replicas {
+				switch {
+				case b.ID == brokerID:
+					mine = append(mine, r)
+				case r.Role == config.Leader:
+					source[r.PartitionID] = b.ID
+				case r.Role == config.Follower:
+					followers[r.PartitionID] = append(followers[r.PartitionID], b.ID)
+				}
+			}
+		}
+	}
+	for _, ids := range followers {
+		sort.Ints(ids)
+	}
+
+	type catchUp struct {
+		PartitionCatchUp
+		left   float64 // Bytes still behind
+		inRate float64
+	}
+	var behind []*catchUp
+	for _, r := range mine {
+		load := p.Loads[r.PartitionID]
+		c := &catchUp{PartitionCatchUp: PartitionCatchUp{PartitionID: r.PartitionID, Role: r.Role, Source: -1}, inRate: load.BytesInPerSec}
+		if src, ok := source[r.PartitionID]; ok {
+			c.Source = src
+		} else if len(followers[r.PartitionID]) > 0 {
+			c.Source = followers[r.PartitionID][0] // Led by the broker itself, so a follower took over
+		}
+		switch {
+		case c.Source < 0:
+			continue // The only replica: nothing to fetch it from
+		case p.Rebuild && load.SizeBytes > 0:
+			c.Bytes = load.SizeBytes
+		case !p.Rebuild && load.BytesInPerSec > 0:
+			c.Bytes = int64(load.BytesInPerSec * p.Downtime.Seconds())
+			if load.SizeBytes > 0 {
+				c.Bytes = min(c.Bytes, load.SizeBytes) // Retention caps what can be missed
+			}
+		default:
+			rec.Unknown++
+			continue
+		}
+		c.left = float64(c.Bytes)
+		rec.Bytes += c.Bytes
+		behind = append(behind, c)
+	}
+
+	// Advance from one catch-up to the next: rates only change when a
+	// replica is done
+	var now float64 // Seconds since the return
+	active := append([]*catchUp(nil), behind...)
+	for len(active) > 0 {
+		share := p.Rate / float64(len(active))
+		perSource := make(map[int]int)
+		for _, c := range active {
+			perSource[c.Source]++
+		}
+		next, progress := math.Inf(1), false
+		rates := make([]float64, len(active))
+		for i, c := range active {
+			rate := min(share, p.Rate/float64(perSource[c.Source]))
+			rates[i] = rate - c.inRate
+			if rates[i] > 0 {
+				progress = true
+				next = min(next, c.left/rates[i])
+			}
+		}
+		if !progress {
+			for _, c := range active {
+				c.Done = -1
+			}
+			break
+		}
+		now += next
+		var still []*catchUp
+		for i, c := range active {
+			if rates[i] > 0 {
+				c.left -= rates[i] * next
+			}
+			if c.left <= 1 {
+				c.Done = time.Duration(now * float64(time.Second)).Round(time.Second)
+			} else {
+				still = append(still, c)
+			}
+		}
+		active = still
+	}
+
+	for _, c := range behind {
+		rec.Partitions = append(rec.Partitions, c.PartitionCatchUp)
+		if c.Done < 0 || rec.Done < 0 {
+			rec.Done = -1
+		} else {
+			rec.Done = max(rec.Done, c.Done)
+		}
+	}
+	sort.Slice(rec.Partitions, func(i, j int) bool {
+		a, b := rec.Partitions[i], rec.Partitions[j]
+		if (a.Done < 0) != (b.Done < 0) {
+			return a.Done < 0
+		}
+		if a.Done != b.Done {
+			return a.Done > b.Done
+		}
+		return a.PartitionID < b.PartitionID
+	})
+	return rec
+}
@@ -0,0 +1,111 @@
+package failover
+
+import (
+	"sort"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// ShutdownMode is how each broker of a rolling restart is stopped.
+type ShutdownMode int
+
+const (
+	// ControlledShutdown is a SIGTERM with controlled.shutdown.enable (the
+	// default): the broker asks the controller to move its leaderships to
+	// other in-sync replicas before it stops, so no one waits for a session
+	// timeout.
+	ControlledShutdown ShutdownMode = iota
+	// HardKill is a SIGKILL or a crash: the partitions the broker led are
+	// leaderless until the controller fences it after the session timeout.
+	HardKill
+)
+
+// String returns the name of the shutdown mode.
+func (s ShutdownMode) String() string {
+	if s == HardKill {
+		return "hard kill"
+	}
+	return "controlled shutdown"
+}
+
+// RollingStep is the restart of one broker.
+type RollingStep struct {
+	BrokerID int
+	Start    time.Duration // Since the rolling restart began
+	Moved    int           // Leaderships handed to another in-sync replica
+	// Offline lists the partitions without another in-sync replica, or every
+	// one the broker led when the restart takes the quorum's majority:
+	// unavailable until the broker is back, however it is stopped.
+	Offline []int
+	// Window is how long the moved partitions are leaderless in the worst
+	// case.
+	Window time.Duration
+	// ActiveController is set when the broker ran the active controller.
+	ActiveController bool
+}
+
+// RollingRestart is a rolling restart of every broker in one shutdown mode.
+type RollingRestart struct {
+	Mode  ShutdownMode
+	Steps []RollingStep
+	// Duration is until the last broker is back in sync.
+	Duration time.Duration
+	// Worst is the longest window of a moved partition over the restarts.
+	Worst time.Duration
+	// Unavailable adds up the leaderless time of every moved partition, an
+	// upper bound as each is counted with its restart's worst case.
+	Unavailable time.Duration
+	// Offline adds up the time partitions without another in-sync replica
+	// are down, the same in both modes.
+	Offline time.Duration
+}
+
+// SimulateRollingRestart restarts every broker in turn, the active
+// controller last as operators do, each down for r.RestartTime and waiting
+// r.CatchUpTime for its replicas to rejoin the ISR before the next one
+// stops. Leadership is assumed back on the preferred replicas before each
+// stop (a preferred leader election between restarts).
+//
+// With a controlled shutdown the controller moves the broker's leaderships
+// one election at a time while it is still up, and clients learn the new
+// leaders on their next metadata refresh; stopping the active controller
+// hands the quorum over without a fetch timeout. A hard kill adds the
+// session timeout, and the controller failover for the active controller,
+// like a broker failure.
+func SimulateRollingRestart(dcs map[int]*config.DCInfo, q Quorum, t Timing, r Rebalance, mode ShutdownMode) RollingRestart {
+	rr := RollingRestart{Mode: mode}
+	estimates := Simulate(dcs, q, t)
+	sort.Slice(estimates, func(i, j int) bool {
+		a, b := estimates[i], estimates[j]
+		if a.ActiveController != b.ActiveController {
+			return b.ActiveController
+		}
+		return a.BrokerID < b.BrokerID
+	})
+
+	var at time.Duration
+	for _, e := range estimates {
+		s := RollingStep{BrokerID: e.BrokerID, Start: at, Moved: e.Elected, Offline: e.Offline, ActiveController: e.ActiveController}
+		if e.Elected > 0 {
+			if mode == HardKill {
+				s.Window = e.WorstCase
+			} else {
+				s.Window = time.Duration(e.Elected)*t.ElectionTime + t.MetadataDelay
+			}
+		}
+		down := r.RestartTime
+		if mode == HardKill {
+			// The broker is fenced before it comes back at the earliest
+			down = max(down, s.Window)
+		}
+		rr.Steps = append(rr.Steps, s)
+		rr.Worst = max(rr.Worst, s.Window)
+		rr.Unavailable += time.Duration(s.Moved) * s.Window
+		rr.Offline += time.Duration(len(s.Offline)) * down
+		at += down + r.CatchUpTime
+	}
+	rr.Duration = at
+	return rr
+}
@@ -0,0 +1,71 @@
+// Package glossary explains the Kafka terms the visualizer works with, in a
+// sentence or two each, for contextual help in the UI.
+package glossary
+
+import (
+	"sort"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// Entry is one glossary term and its explanation.
+type Entry struct {
+	Term string
+	Text string
+}
+
+// entries is the built-in glossary, keyed by lower-case term.
+var entries = map[string]Entry{
+	"broker": {"Broker", "A Kafka server. Each broker stores replicas of many partitions; " +
+		"the more brokers, the more the load spreads and the more replicas a cluster can hold."},
+	"data center": {"Data Center", "A failure domain such as a site, region or availability zone, set as broker.rack. " +
+		"An MRC (Multi-Region Cluster) stretches one cluster over several of them so it survives losing one."},
+	"partition": {"Partition", "A topic is split into partitions, the unit of parallelism: each has one leader " +
+		"that takes writes, and consumers in a group read different partitions. A multiple of the broker count spreads leaders evenly."},
+	"replication factor": {"Replication Factor", "How many copies (replicas) of each partition exist, on different brokers. " +
+		"RF 3 survives two broker failures without data loss; in an MRC, RF counts the leader, followers and observers."},
+	"min isr": {"Min ISR", "min.insync.replicas: how many in-sync replicas (leader included) must have a write before an acks=all producer gets a success. " +
+		"With RF 3 and min ISR 2 one broker may fail without blocking writes; min ISR = RF blocks writes on any failure."},
+	"leader": {"Leader", "The replica of a partition that handles all writes (and by default reads). " +
+		"When its broker fails, an in-sync follower is elected leader."},
+	"follower": {"Follower", "A replica that copies the leader synchronously and counts towards min ISR, " +
+		"so it can take over as leader without data loss."},
+	"observer": {"Observer", "An MRC replica that copies the leader asynchronously and does not count towards min ISR. " +
+		"In the visualizer an MRC has min ISR - 1 followers and RF - min ISR observers; observers must be promoted to take over after a DC loss."},
+	"isr": {"ISR", "The in-sync replicas: the leader plus the followers that are caught up with it. " +
+		"Only members of the ISR can be elected leader in a clean election."},
+}
+
+// Lookup returns the entry for a term, case-insensitively.
+func Lookup(term string) (Entry, bool) {
+	key := strings.ToLower(term)
+	e, ok := entries[key]
+	if ok {
+		e = translate(key, e)
+	}
+	return e, ok
+}
+
+// translate returns an entry in the UI language, keeping the English term
+// or text where the catalog has no translation.
+func translate(key string, e Entry) Entry {
+	if term, ok := i18n.Translated("glossary." + key + ".term"); ok {
+		e.Term = term
+	}
+	if text, ok := i18n.Translated("glossary." + key + ".text"); ok {
+		e.Text = text
+	}
+	return e
+}
+
+// All returns every entry sorted by term.
+func All() []Entry {
+	result := make([]Entry, 0, len(entries))
+	for key, e := range entries {
+		result = append(result, translate(key, e))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Term < result[j].Term })
+	return result
+}
@@ -0,0 +1,136 @@
+// Package health condenses a placement into a single 0-100 score: how even
+// the load is spread over the brokers, how many partitions survive a broker
+// failure and how many respect rack/DC anti-affinity. The breakdown keeps
+// the score explainable.
+package health
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// Component is one part of the health score.
+type Component struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"` // Share of the overall score, the weights add up to 100
+	Score  int    `json:"score"`  // 0-100, higher is better
+	Detail string `json:"detail"`
+}
+
+// Report is the overall score and its breakdown.
+type Report struct {
+	Score      int         `json:"score"`
+	Components []Component `json:"components"`
+}
+
+// Summary renders the breakdown on one line, e.g. "balance 92, failure
+// tolerance 100, constraints 100". The component names stay English: they
+// key the saved snapshots and the exports.
+func (r Report) Summary() string {
+	parts := make([]string, len(r.Components))
+	for i, c := range r.Components {
+		parts[i] = fmt.Sprintf("%s %d", c.Name, c.Score)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Evaluate scores a placement. loads may be nil when partition sizes are
+// unknown; disk usage is then left out of the balance.
+func Evaluate(dcs map[int]*config.DCInfo, loads map[int]config.PartitionLoad) Report {
+	a := placement.NewAssignment(dcs, loads)
+	partitions := placement.Partitions(dcs)
+
+	components := []Component{
+		balance(a),
+		tolerance(partitions),
+		constraints(a, len(partitions)),
+	}
+	var total float64
+	for _, c := range components {
+		total += float64(c.Weight*c.Score) / 100
+	}
+	return Report{Score: int(math.Round(total)), Components: components}
+}
+
+// metric is a named per-broker value. The name suffixes the catalog key
+// "health.metric.<name>".
+type metric struct {
+	name  string
+	value func(brokerID int) float64
+}
+
+// balance scores the mean coefficient of variation of replicas, leaders and
+// (when known) bytes per broker: 0 scores 100, a spread as large as the
+// mean itself scores 0.
+func balance(a *placement.Assignment) Component {
+	metrics := []metric{
+		{"replicas", func(id int) float64 { return float64(a.ReplicaCount(id)) }},
+		{"leaders", func(id int) float64 { return float64(a.LeaderCount(id)) }},
+	}
+	var bytes int64
+	for _, id := range a.BrokerIDs() {
+		bytes += a.BrokerBytes(id)
+	}
+	if bytes > 0 {
+		metrics = append(metrics, metric{"disk", func(id int) float64 { return float64(a.BrokerBytes(id)) }})
+	}
+
+	var sum float64
+	details := make([]string, len(metrics))
+	for i, m := range metrics {
+		cv := a.Imbalance(m.value)
+		sum += cv
+		details[i] = fmt.Sprintf("%s %.2f", i18n.T("health.metric."+m.name), cv)
+	}
+	score := 100 * (1 - math.Min(1, sum/float64(len(metrics))))
+	return Component{
+		Name:   "balance",
+		Weight: 40,
+		Score:  int(math.Round(score)),
+		Detail: i18n.T("health.balance", strings.Join(details, ", ")),
+	}
+}
+
+// tolerance scores the share of partitions that keep a leader when any one
+// of their brokers fails, i.e. that have at least two in-sync replicas.
+// Observers don't count, they can't take over without a manual promotion.
+func tolerance(partitions []placement.PartitionReplicas) Component {
+	survive := 0
+	for _, p := range partitions {
+		if len(p.Brokers[config.Leader])+len(p.Brokers[config.Follower]) >= 2 {
+			survive++
+		}
+	}
+	return Component{
+		Name:   "failure tolerance",
+		Weight: 40,
+		Score:  percent(survive, len(partitions)),
+		Detail: i18n.T("health.tolerance", survive, len(partitions)),
+	}
+}
+
+// constraints scores the share of partitions that respect rack/DC
+// anti-affinity.
+func constraints(a *placement.Assignment, partitions int) Component {
+	violations := len(a.RackViolations())
+	return Component{
+		Name:   "constraints",
+		Weight: 20,
+		Score:  percent(partitions-violations, partitions),
+		Detail: i18n.T("health.constraints", violations, partitions),
+	}
+}
+
+// percent returns n out of total as 0-100, 100 for an empty placement.
+func percent(n, total int) int {
+	if total == 0 {
+		return 100
+	}
+	return int(math.Round(100 * float64(n) / float64(total)))
+}
@@ -0,0 +1,859 @@
+package i18n
+
+// english is the source catalog: every key the UI looks up has a message
+// here, and other languages fall back to it.
+var english = map[string]string{
+	// --- Title, cluster type menu and errors ---
+	"menu.title":       "Select cluster type:",
+	"menu.single":      "[S] Single Cluster",
+	"menu.mrc":         "[M] Multi-Region Cluster (MRC)",
+	"menu.tutorial":    "[T] Tutorial: a guided 3-broker example",
+	"menu.quiz":        "[Q] Quiz: what do these failures break?",
+	"menu.library":     "[L] Scenario library: classic setups and pitfalls",
+	"menu.connect":     "[C] Connect to a live cluster: show a topic's actual placement",
+	"menu.resume":      "[R] Resume last session (%s, saved %s)",
+	"menu.session":     "%d partitions, RF %d",
+	"menu.help":        "(Press S, M, T, Q, L or C. Ctrl+C to quit)",
+	"menu.help.resume": "(Press S, M, T, Q, L, C or R. Ctrl+C to quit)",
+	"app.title":        "Kafka Partition Visualizer",
+	"error.title":      "Error: %s",
+	"error.unexpected": "An unexpected error occurred.",
+	"error.help":       "(Press Enter to restart. E to edit the values. Ctrl+C to quit)",
+
+	// --- Configuration form ---
+	"form.title.single":    "Enter Single Cluster Configuration:",
+	"form.title.mrc":       "Enter MRC Configuration:",
+	"field.dcs":            "Data Centers",
+	"field.brokers":        "Total Brokers",
+	"field.brokersPerDC":   "Brokers per DC",
+	"field.partitions":     "Partitions",
+	"field.rf":             "Replication Factor",
+	"field.minISR":         "Min ISR",
+	"form.label":           "%s:",
+	"form.suggested":       " (suggested, type to replace)",
+	"form.problems":        "Please fix %d problems:",
+	"form.help":            "Use Tab/Shift+Tab or Up/Down to navigate. Enter to confirm/move next. Ctrl+T to define several topics. Ctrl+C to quit.",
+	"form.empty":           "input for '%s' cannot be empty",
+	"form.invalid":         "invalid number for '%s': %v",
+	"form.positive":        "input for '%s' must be positive",
+	"form.mrcDCs":          "MRC requires at least 2 Data Centers",
+	"form.brokerCounts":    "'%s' must be one number, or a positive number per DC separated by commas (e.g. 4,3,2)",
+	"form.rackCount":       "%d broker counts given for %d Data Centers",
+	"form.rfOverBrokers":   "replication Factor (%d) cannot exceed total brokers (%d)",
+	"form.minISROverRF":    "min ISR (%d) cannot exceed Replication Factor (%d)",
+	"form.replicasPerBrkr": "%d replicas per broker exceed the recommended maximum of %d, add brokers or reduce partitions",
+
+	// --- Placement ---
+	"placement.title":          "Partition Placement Visualization:",
+	"placement.topic":          "Topic: %s",
+	"placement.topics":         "Topics:",
+	"placement.topicsSwitch":   "([ and ] to switch)",
+	"placement.strategy":       "Strategy: %s",
+	"placement.strategy.live":  "Strategy: none (actual assignment of the live cluster)",
+	"placement.seed":           " (seed %d)",
+	"placement.recommendation": "MRC Recommendation: %s",
+
+	// --- Recommendations ---
+	"recommend.distribute":     "Distribute %d replicas across %d DCs for fault tolerance.",
+	"recommend.onePerDC":       " Aim for at most one replica per DC per partition.",
+	"recommend.perDC":          " Aim for ~%d replicas per DC, with %d DCs having an extra replica.",
+	"recommend.uneven":         " The DCs have %s brokers: with the replicas spread over them, the brokers of %s host %.1f replicas each, %.1fx the average. Adding brokers there evens the load.",
+	"recommend.unevenBalanced": " The DCs have %s brokers; the replicas still load every broker about evenly.",
+
+	// --- Observer analysis ---
+	"observers.none":              "RF %d with min ISR %d leaves no observers: every replica must be in sync, so acks=all writes stop as soon as one replica or its DC is down.",
+	"observers.none.fix":          "Raise RF to %d to keep observers to promote, or lower min ISR to %d.",
+	"observers.none.fixRF":        "Raise RF to %d to keep observers to promote.",
+	"observers.none.fixISR":       "Lower min ISR to %d; there are not enough brokers to raise RF.",
+	"observers.noFollowers":       "Min ISR 1 makes all %d replicas but the leader observers: acks=all writes are confirmed by the leader alone, and are lost with its DC.",
+	"observers.noFollowers.fix":   "Raise min ISR to 2 so a follower in another DC confirms every write.",
+	"observers.noFollowers.fixRF": "Raise RF to 3 and min ISR to 2 so a follower in another DC confirms every write.",
+	"observers.everyDC":           "Min ISR %d puts a synchronous replica in every one of the %d DCs: no DC can fail without blocking acks=all writes until an observer is promoted.",
+	"observers.everyDC.fix":       "Lower min ISR to %d to leave a DC with observers only, or spread the brokers over %d DCs.",
+	"observers.everyDC.fixDC":     "Spread the brokers over %d DCs to leave one with observers only.",
+
+	// --- Legend ---
+	"legend.title":           "Legend: ",
+	"legend.colored":         "Legend (colored %s): ",
+	"legend.leader":          "Leader (pX)",
+	"legend.follower":        "Follower (pX)",
+	"legend.observer":        "Observer (pX)",
+	"legend.leader.bold":     "Leader (bold)",
+	"legend.observer.italic": "Observer (italic)",
+	"legend.lagHot":          "Lag ≥ threshold",
+	"legend.lagStuck":        "Stuck consumer",
+
+	// --- Placement keys ---
+	"keys.press":       "(Press %s)",
+	"keys.separator":   ". ",
+	"keys.footer":      "(? for legend and keys)",
+	"keys.restart":     "Enter to restart",
+	"keys.stats":       "S to toggle stats",
+	"keys.failover":    "F for failover times",
+	"keys.trend":       "G for the health trend",
+	"keys.k8s":         "K for Kubernetes spread",
+	"keys.arrows":      "Arrows to select",
+	"keys.details":     "Enter for broker details",
+	"keys.deselect":    "Esc to deselect",
+	"keys.select":      "Arrows to select a broker",
+	"keys.lag":         "L to toggle lag overlay",
+	"keys.urp":         "U for under-replicated partitions",
+	"keys.snapshots":   "Z for snapshots",
+	"keys.mirror":      "X for the MM2 topic mapping",
+	"keys.tenants":     "O for the tenant footprint",
+	"keys.matrix":      "M for the min ISR survivability matrix",
+	"keys.dr":          "Y to compare with 2 clusters + MM2",
+	"keys.rf":          "+/- to try another RF",
+	"keys.isr":         "I for the ISR timeline",
+	"keys.locality":    "W for consumer fetch locality",
+	"keys.timeline":    "R for the event timeline",
+	"keys.log":         "J for the log",
+	"keys.controllers": "V for combined/dedicated controllers",
+	"keys.rebalance":   "B to toggle auto leader rebalance",
+	"keys.graphical":   "A for the graphical view",
+	"keys.summary":     "A for a text summary",
+	"keys.placement":   "H for the placement",
+	"keys.histograms":  "H for histograms",
+	"keys.coloring":    "C to change coloring",
+	"keys.tag":         "/ to highlight a broker tag",
+	"keys.edit":        "E to edit this scenario",
+	"keys.strategy":    "P for strategy options",
+	"keys.topics":      "T to edit topics",
+	"keys.note":        "N to add a note",
+	"keys.screenshot":  "Ctrl+S for a screenshot",
+	"keys.hideFooter":  "? to hide this footer",
+	"keys.quit":        "Ctrl+C to quit",
+
+	// --- Counts ---
+	"noun.broker.one":                 "%d broker",
+	"noun.broker.other":               "%d brokers",
+	"noun.consumer.one":               "%d consumer",
+	"noun.consumer.other":             "%d consumers",
+	"noun.data center.one":            "%d data center",
+	"noun.data center.other":          "%d data centers",
+	"noun.earlier run.one":            "%d earlier run",
+	"noun.earlier run.other":          "%d earlier runs",
+	"noun.in-sync replica.one":        "%d in-sync replica",
+	"noun.in-sync replica.other":      "%d in-sync replicas",
+	"noun.leader.one":                 "%d leader",
+	"noun.leader.other":               "%d leaders",
+	"noun.leaderless partition.one":   "%d leaderless partition",
+	"noun.leaderless partition.other": "%d leaderless partitions",
+	"noun.leadership.one":             "%d leadership",
+	"noun.leadership.other":           "%d leaderships",
+	"noun.message.one":                "%d message",
+	"noun.message.other":              "%d messages",
+	"noun.mirrored topic.one":         "%d mirrored topic",
+	"noun.mirrored topic.other":       "%d mirrored topics",
+	"noun.more combination.one":       "%d more combination",
+	"noun.more combination.other":     "%d more combinations",
+	"noun.move.one":                   "%d move",
+	"noun.move.other":                 "%d moves",
+	"noun.new replica.one":            "%d new replica",
+	"noun.new replica.other":          "%d new replicas",
+	"noun.new warning.one":            "%d new warning",
+	"noun.new warning.other":          "%d new warnings",
+	"noun.offline partition.one":      "%d offline partition",
+	"noun.offline partition.other":    "%d offline partitions",
+	"noun.older snapshot.one":         "%d older snapshot",
+	"noun.older snapshot.other":       "%d older snapshots",
+	"noun.partition.one":              "%d partition",
+	"noun.partition.other":            "%d partitions",
+	"noun.rack.one":                   "%d rack",
+	"noun.rack.other":                 "%d racks",
+	"noun.replica.one":                "%d replica",
+	"noun.replica.other":              "%d replicas",
+	"noun.rule.one":                   "%d rule",
+	"noun.rule.other":                 "%d rules",
+	"noun.step.one":                   "%d step",
+	"noun.step.other":                 "%d steps",
+	"noun.tenant.one":                 "%d tenant",
+	"noun.tenant.other":               "%d tenants",
+	"noun.topic.one":                  "%d topic",
+	"noun.topic.other":                "%d topics",
+	"noun.wave.one":                   "%d wave",
+	"noun.wave.other":                 "%d waves",
+	"list.brokers.one":                "broker %s",
+	"list.brokers.other":              "brokers %s",
+	"list.more":                       "...and %d more",
+	"ago.now":                         "just now",
+	"ago.minutes":                     "%dm ago",
+	"ago.hours":                       "%dh ago",
+	"ago.days":                        "%d days ago",
+
+	// --- Controllers and metadata log ---
+	"controllers.dedicated":      "dedicated nodes %s",
+	"controllers.zookeeper":      "ZooKeeper nodes %s",
+	"controllers.combined":       "combined with brokers %s",
+	"controllers.status":         "Controllers: %s, %d of %d needed",
+	"controllers.metadataLeader": ", %s led by node %d",
+	"controllers.survives":       "survives the loss of any one DC",
+	"controllers.lost":           "losing %s loses the quorum",
+	"controllers.or":             " or ",
+	"controllers.risk":           "⚠ %s holds %d of %d voters: losing it stops all leader elections although every partition keeps a replica elsewhere",
+	"controllers.suggested":      "  Suggested: %s",
+	"controllers.addDCs":         "add data centers for the voters",
+	"controllers.tiebreaker":     "a tiebreaker site",
+	"controllers.votersIn":       "%d in %s",
+	"controllers.voters":         "%d voters, %s",
+	"controllers.tiebreakerNode": " (the tiebreaker as a dedicated controller)",
+	"metadata.title":             "Metadata log (%s), led by the active controller, node %d in %s:",
+	"metadata.voters":            "  Voter replicas: %s; commits need %d of %d",
+	"metadata.observers":         "  Observer replicas: brokers %s, fetching it for their metadata cache",
+	"metadata.losing":            "  Losing %s: ",
+	"metadata.losing.broker":     "broker %d",
+	"metadata.readOnly":          "%d of %d voters left, the log is read-only; no elections, ISR changes, topic changes or broker registrations until %d are back",
+	"metadata.leaderMoved":       "node %d leads the log after a controller failover (%s); every election waits for it",
+	"metadata.writable":          "still writable, %d of %d voters left",
+	"metadata.help":              "Observers and other brokers keep serving their cached metadata through any of these failures. Set the active controller with --active-controller.",
+
+	// --- Throughput capacity ---
+	"capacity.over":                   "✗ Throughput: over capacity on %s (S for the headroom)",
+	"capacity.near":                   "⚠ Throughput: above %.0f%% of capacity on %s",
+	"capacity.title":                  "Throughput headroom per broker:",
+	"capacity.none":                   "No capacity or throughput data loaded (set --network-capacity/--disk-capacity or --broker-capacity, with bytes_in/bytes_out in --weights).",
+	"capacity.col.broker":             "Broker",
+	"capacity.col.in":                 "In",
+	"capacity.col.out":                "Out",
+	"capacity.col.diskWrite":          "Disk write",
+	"capacity.col.network":            "Network",
+	"capacity.col.disk":               "Disk",
+	"capacity.col.headroom":           "Headroom",
+	"capacity.unknown":                "unknown capacity",
+	"capacity.headroom":               "%s (%s)",
+	"capacity.overBy":                 "over by %s (%s)",
+	"capacity.bottleneck.disk":        "disk",
+	"capacity.bottleneck.network in":  "network in",
+	"capacity.bottleneck.network out": "network out",
+	"capacity.help":                   "Leaders send produce, consume and replication traffic; every replica receives and writes the produced bytes.",
+
+	// --- Reassignment, drift and snapshots ---
+	"reassign.failed":     "Reloading the assignment failed: %v",
+	"reassign.progress":   "Reassignment in progress: %s moving",
+	"reassign.bar":        "%s %d%% (%d of %d %s)",
+	"reassign.inSync":     "new replicas in sync",
+	"reassign.approved":   "partitions match the approved placement",
+	"reassign.catchingUp": "catching up",
+	"reassign.caughtUp":   "in sync",
+	"reassign.adding":     "adding %s",
+	"reassign.removing":   "removing %s",
+	"drift.none":          "✓ No drift: the live assignment matches the approved placement",
+	"drift.found":         "⚠ Drift: %s drifted from the approved placement",
+	"snapshots.title":     "Snapshots of %s (%d in %s):",
+	"snapshots.every":     "A snapshot is saved every %s while the assignment changes.",
+	"snapshots.none":      "No snapshots yet.",
+	"snapshots.older":     "...%s before these",
+	"snapshots.same":      "✓ The live assignment is the same as in this snapshot",
+	"snapshots.changed":   "%s changed since this snapshot:",
+	"snapshots.help":      "(< and > compare with an older or newer snapshot)",
+	"snapshots.saved":     "Saved a snapshot of the assignment of %s to %s",
+	"snapshots.loadError": "loading snapshot %s",
+	"snapshots.notSaving": "Not saving snapshots: %v",
+
+	// --- Log ---
+	"log.title":        "Log:",
+	"log.none":         "Nothing logged yet.",
+	"log.older":        "...%s before these",
+	"log.badge":        "⚠ %s in the log (J to show)",
+	"log.session":      "Ignoring saved session: %v",
+	"log.history":      "Ignoring history: %v",
+	"log.reloadFailed": "Reloading the assignment %s failed: %v",
+	"log.reassignDone": "Reassignment finished: no partitions of %s moving",
+	"log.reassigning":  "Reassignment: %s of %s moving",
+	"log.reloaded":     "Reloaded the assignment of %s",
+	"log.driftNone":    "The live assignment matches the approved placement",
+	"log.drift":        "Drift from the approved placement: %s",
+
+	// --- Import ---
+	"import.title":         "Importing",
+	"import.strimziFiles":  "Importing Strimzi cluster from %s",
+	"import.strimzi":       "Importing Strimzi cluster %s",
+	"import.loading":       "Loading %s",
+	"import.bundle":        "Opening bundle %s",
+	"import.progress":      "%s %d%% (%d of %d %s)",
+	"import.unit.files":    "files",
+	"import.unit.topics":   "topics",
+	"import.unit.requests": "requests",
+	"import.starting":      "Starting...",
+	"import.help":          "(Esc to cancel. Ctrl+C to quit)",
+	"import.cancelled":     "%s cancelled",
+	"import.failed":        "%s failed: %v",
+	"import.finished":      "%s finished",
+
+	// --- Strategies and client affinity ---
+	"strategy.random":         "Round-robin leaders, shuffled followers",
+	"strategy.sizeAware":      "Balance partition bytes rather than replica counts",
+	"strategy.goals":          "Optimize Cruise Control style goals in priority order",
+	"strategy.clientAffinity": "Lead partitions from the DCs producing the most (--client-traffic)",
+	"strategy.kafkaDefault":   "What Kafka assigns a new topic, ignoring racks (start index + shift)",
+	"strategy.rackAware":      "What Kafka assigns a new topic with broker.rack set, DCs as racks",
+	"affinity.unknownDC":      "⚠ No DC named %s; client traffic there is ignored",
+	"affinity.produce":        "produce %s of %s (%s)",
+	"affinity.consume":        "consume %s of %s (%s)",
+	"affinity.crossing":       "Client traffic crossing DCs to leaders: %s",
+	"affinity.hint":           "The client-affinity strategy leads partitions from the DCs producing the most.",
+	"affinity.fewer":          "✓ %s fewer cross-DC produce hops than round-robin leaders (%s)",
+	"affinity.notFewer":       "No fewer cross-DC produce hops than round-robin leaders (%s)",
+
+	// --- Rolling restart ---
+	"rolling.title":                    "Rolling restart of %s (down %s, %s to catch up, the active controller last):",
+	"rolling.col.shutdown":             "Shutdown",
+	"rolling.col.worst":                "Leaderless for up to",
+	"rolling.col.unavailable":          "Partition-time leaderless",
+	"rolling.col.takes":                "Takes",
+	"rolling.mode.controlled shutdown": "controlled shutdown",
+	"rolling.mode.hard kill":           "hard kill",
+	"rolling.saves":                    "✓ Controlled shutdown saves %s of leaderless partition-time: leaders move before each broker stops instead of after the %s session timeout",
+	"rolling.offlineBroker":            "broker %d: %s",
+	"rolling.offline":                  "✗ Offline while their broker restarts, in either mode (no other in-sync replica): %s",
+	"rolling.help":                     "Stop brokers with SIGTERM and controlled.shutdown.enable=true (the default); a SIGKILL or a crash behaves like the hard kill. Each restart assumes leadership was moved back to the preferred replicas before it.",
+
+	// --- Live cluster ---
+	"kafka.title":          "Connect to a live Kafka cluster:",
+	"kafka.bootstrap":      "Bootstrap servers:",
+	"kafka.topic":          "Topic:",
+	"kafka.topicHint":      "empty to pick one from the cluster",
+	"kafka.tls":            "Connecting over TLS.",
+	"kafka.plaintext":      "Connecting in plaintext (--kafka-tls for TLS).",
+	"kafka.sasl":           "Authenticating as %s with %s.",
+	"kafka.noSASL":         "Without SASL authentication (--kafka-sasl to authenticate).",
+	"kafka.help":           "(Tab to switch fields. Enter to connect. Esc to go back. Ctrl+C to quit)",
+	"kafka.noBootstrap":    "enter the bootstrap servers, e.g. localhost:9092",
+	"kafka.noTopics":       "the cluster behind %s has no topics",
+	"kafka.readAssignment": "reading the assignment of %s",
+	"kafka.connecting":     "Connecting to %s",
+	"kafka.reading":        "Reading %s from %s",
+	"kafka.controller":     "broker %d",
+	"kafka.topics":         "Topics of %s (%s, %s):",
+	"kafka.above":          "...%d more above",
+	"kafka.below":          "...%d more below",
+	"kafka.topicsHelp":     "(↑/↓ to choose. Enter to show its placement. Esc to go back. Ctrl+C to quit)",
+
+	// --- Racks, cluster metadata and constraints ---
+	"group.dc":               "Data Center %d:",
+	"group.dcNamed":          "Data Center %d (%s):",
+	"group.rack":             "Rack %s:",
+	"broker.header.dc":       "Broker %d — Data Center %d",
+	"broker.header.rack":     "Broker %d — Rack %s",
+	"cluster.title":          "Cluster %s",
+	"cluster.details":        "  ID: %s  Kafka: %s  Controller: %s",
+	"cluster.racks":          "Racks: %s",
+	"cluster.noRack":         "no rack",
+	"cluster.unknown":        "unknown",
+	"constraints.violations": "%s violating rack/DC anti-affinity (%s)",
+	"constraints.unracked":   "%s without broker.rack",
+	"constraints.ok":         "✓ Constraints: every partition spread over as many DCs as possible",
+	"constraints.failed":     "✗ Constraints: %s",
+
+	// --- Placement warnings ---
+	"placement.rackFallback": "Strategy %s needs racks on a single cluster (--racks); placed as %s instead",
+
+	// --- Condensed view ---
+	"condensed.warning": "⚠ Condensed for %dx%d, boxes need %dx%d",
+	"condensed.health":  "Health %d/100",
+	"condensed.more":    "...%d more lines (A for the text summary)",
+	"condensed.help":    "(A summary. Enter restart. Ctrl+C quit)",
+	"condensed.empty":   "(empty)",
+
+	// --- Min ISR survivability ---
+	"survivability.title":       "Min ISR survivability by failed data centers:",
+	"survivability.oneDC":       "Needs more than one data center.",
+	"survivability.failedDCs":   "Failed DCs",
+	"survivability.controllers": "Controllers",
+	"survivability.topic":       "topic",
+	"survivability.column":      "%s (min ISR %d)",
+	"survivability.noMajority":  "✗ no majority",
+	"survivability.rejecting":   "✗ %d of %d",
+	"survivability.more":        "...and %s",
+	"survivability.help":        "✓ every partition keeps min ISR in-sync replicas. ✗ n of N: partitions rejecting acks=all writes (offline or under min ISR). Observers are not promoted.",
+
+	// --- DR design comparison ---
+	"dr.title":              "DR design comparison, losing a data center:",
+	"dr.needsMRC":           "Needs an MRC placement with more than one data center.",
+	"dr.row.brokers":        "Brokers",
+	"dr.row.storage":        "Storage",
+	"dr.row.crossDC":        "Cross-DC writes",
+	"dr.help":               "MM2 lag %s (--mm2-lag), cutover %s (the observer promotion time). Storage and traffic need --weights.",
+	"dr.mrc":                "MRC (this placement)",
+	"dr.mrc.sizing":         "%d DCs, one cluster",
+	"dr.mrc.rpoLost":        "%d partitions have every replica in one DC",
+	"dr.mrc.rpoObservers":   "observer lag, when promoted",
+	"dr.mrc.rpoInSync":      "an in-sync replica survives",
+	"dr.mrc.rtoQuorum":      "until the controller quorum returns",
+	"dr.mrc.rtoPromoted":    "observers promoted by an operator",
+	"dr.mrc.rtoElection":    "automatic leader election",
+	"dr.mrc.oneCluster":     "One cluster: clients keep their bootstrap servers, topic names and consumer offsets through a DC loss.",
+	"dr.mrc.crossFollowers": "acks=all waits for followers in other DCs, so every write pays the cross-DC round trip.",
+	"dr.mrc.observers":      "Observers take over only when promoted, an operator step in the runbook.",
+	"dr.mrc.quorumLost":     "The controller quorum does not survive losing %s; a voter in a third site fixes that.",
+	"dr.mrc.or":             " or ",
+	"dr.mrc.links":          "Needs low-latency links between the DCs for replication and the controller quorum.",
+	"dr.mm2":                "2 clusters + MM2",
+	"dr.mm2.sizing":         "%d per cluster",
+	"dr.mm2.sizingUneven":   "%v per cluster",
+	"dr.mm2.rpo":            "MM2 lag; asynchronous",
+	"dr.mm2.rto":            "clients repointed by an operator",
+	"dr.mm2.local":          "Writes stay local: acks=all never waits on the other DC.",
+	"dr.mm2.offsets":        "Consumers resume from offsets MM2 translated, reprocessing up to %s of messages.",
+	"dr.mm2.renames":        "The default replication policy renames topics on the target (e.g. primary.orders); IdentityReplicationPolicy keeps the names.",
+	"dr.mm2.operate":        "Two clusters to operate, upgrade and secure, plus the MM2 Connect workers.",
+	"dr.mm2.failback":       "Failing back needs a reverse flow and a second cutover.",
+	"dr.mm2.unused":         "Only %s and %s host a cluster; the other DCs are unused.",
+
+	// --- Topic naming ---
+	"naming.title":     "Topic naming (%d/%d topics pass",
+	"naming.ignored":   ", %d ignored",
+	"naming.pass":      "✓ Every topic follows the naming rules",
+	"naming.more":      "… %d more in the log (J) and the summary (A)",
+	"naming.log":       "Topic %s breaks naming rule %q: %s",
+	"naming.summary":   "Topic naming.",
+	"naming.passCount": "%d of %s pass the naming rules.",
+	"naming.failed":    "Failed: %s breaks %s (%s).",
+
+	// --- Client capabilities during a failure ---
+	"capability.title":       "What still works during the failure:",
+	"capability.none":        "No failures to simulate.",
+	"capability.selected":    "broker %d down (selected)",
+	"capability.worst":       "broker %d down (worst)",
+	"capability.dcLost":      "%s lost",
+	"capability.topic":       "topic %d",
+	"capability.topicHeader": "%s (min ISR %d):",
+	"capability.col.failure": "Failure",
+	"capability.col.leader":  "Leader reads",
+	"capability.col.local":   "Local follower reads",
+	"capability.failing":     "✗ %d of %d",
+	"capability.more":        "...and %s more",
+	"capability.help":        "✓ every partition. ✗ n of N: partitions that fail it. acks=1 and leader reads need a leader, acks=all also min ISR in-sync replicas.",
+	"capability.help.local":  " Local follower reads (KIP-392) need a replica in every DC still running.",
+	"capability.help.select": " Select a broker to add its failure.",
+
+	// --- Replay ---
+	"replay.start":    "▶ Replaying %s, recorded %s: %s. Any key plays the first one, Ctrl+C quits.",
+	"replay.step":     "▶ Replay step %d of %d: pressed %s at %s. Any key plays the next one, Ctrl+C quits.",
+	"replay.finished": "■ Replay finished: pressed %s, the last of %s. The keys control the session again.",
+
+	// --- Screenshots ---
+	"screenshot.saved":            "✓ Screenshot saved to %s",
+	"screenshot.logSaved":         "Screenshot saved to %s",
+	"screenshot.logFailed":        "Screenshot not saved: %v",
+	"screenshot.topic":            "the topic",
+	"screenshot.topicNamed":       "topic %s",
+	"screenshot.topicOf":          "%s of %d",
+	"screenshot.scenario":         "%s: %s, %s, RF %d, min ISR %d",
+	"screenshot.health":           "%d/100 (%s)",
+	"screenshot.broker":           "broker %d down (failover pane)",
+	"screenshot.eachBroker":       "each single broker down (failover pane)",
+	"screenshot.dcLost":           "%s lost, observers promoted",
+	"screenshot.defaults":         "default events",
+	"screenshot.isr":              "ISR timeline: %s",
+	"screenshot.timeline":         "event timeline: %s",
+	"screenshot.matrix":           "every combination of failed DCs (min ISR matrix)",
+	"screenshot.caption.scenario": "Scenario: %s",
+	"screenshot.caption.taken":    "Taken: %s",
+	"screenshot.caption.health":   "Health: %s",
+	"screenshot.caption.failure":  "Failure simulated: %s",
+	"screenshot.caption.none":     "none",
+
+	// --- Topic editor ---
+	"topics.title":          "Edit Topics:",
+	"topics.col.name":       "Name",
+	"topics.col.partitions": "Partitions",
+	"topics.col.rf":         "RF",
+	"topics.col.minISR":     "Min ISR",
+	"topics.col.tenant":     "Tenant",
+	"topics.emptyName":      "row %d: topic name cannot be empty",
+	"topics.duplicate":      "row %d: duplicate topic %q",
+	"topics.notPositive":    "row %d: %s must be a positive number",
+	"topics.row":            "row %d",
+	"topics.topic":          "topic %s",
+	"topics.help":           "(Tab/Shift+Tab to move between cells. Up/Down to change row. Ctrl+N to add a row. Ctrl+D to delete a row. Enter to place all topics. Esc to cancel)",
+
+	// --- Roles and lists ---
+	"role.Leader":   "Leader",
+	"role.Follower": "Follower",
+	"role.Observer": "Observer",
+	"list.and":      "%s and %s",
+	"list.none":     "none",
+
+	// --- Accessible summary ---
+	"summary.title":          "Placement summary.",
+	"summary.cluster":        "Cluster %s, ID %s, Kafka %s, controller %s.",
+	"summary.racks":          "Racks: %s.",
+	"summary.scenario":       "Scenario %s: %s",
+	"summary.notePartition":  "Note on partition %d: %s",
+	"summary.noteBroker":     "Note on broker %d: %s",
+	"summary.note":           "Note: %s",
+	"summary.theTopic":       "the topic",
+	"summary.topic":          "topic %s",
+	"summary.placement":      "Placement of %s: %s in %s, %s, replication factor %d, minimum in-sync replicas %d, strategy %s.",
+	"summary.recommendation": "Recommendation: %s",
+	"summary.warning":        "Warning: %s %s",
+	"summary.brokers":        "Brokers.",
+	"summary.dc":             "Data center %d",
+	"summary.rack":           "Rack %s",
+	"summary.dcBrokers":      "%s has %s: %s.",
+	"summary.broker":         "Broker %d hosts %s",
+	"summary.brokerBytes":    " totalling %s",
+	"summary.brokerTags":     ", tagged %s",
+	"summary.role":           "%s for partitions %s.",
+	"summary.partitions":     "Partitions.",
+	"summary.partition":      "Partition %d: leader broker %s",
+	"summary.followers":      "; followers on brokers %s",
+	"summary.observers":      "; observers on brokers %s",
+	"summary.lag":            "; consumer lag %d",
+	"summary.rules":          "Rules.",
+	"summary.rulePassed":     "Passed: %s",
+	"summary.ruleFailed":     "Failed: %s",
+
+	// --- Tutorial ---
+	"tutorial.title":               "Tutorial %d/%d: %s",
+	"tutorial.welcome.title":       "Welcome",
+	"tutorial.welcome.text":        "This tutorial walks through a small Kafka cluster: 3 brokers and one topic with 3 partitions, replication factor 3 and min ISR 2. Each page explains one concept on that cluster and the last pages simulate broker failures.",
+	"tutorial.brokers.title":       "Brokers",
+	"tutorial.brokers.text":        "Below are the three brokers, each listing the partition replicas it stores.",
+	"tutorial.leaders.title":       "Partitions and leaders",
+	"tutorial.leaders.text":        "Every partition has exactly one leader, shown in green. The leaders are spread so each broker leads one partition and shares the write load.",
+	"tutorial.replication.title":   "Replication factor and followers",
+	"tutorial.replication.text":    "With RF 3 on 3 brokers every broker holds a replica of every partition: the leader and two followers, shown in yellow. No broker holds two replicas of the same partition.",
+	"tutorial.isr.title":           "In-sync replicas and min ISR",
+	"tutorial.isr.text":            "All three replicas of each partition are in sync, one more than min ISR 2 requires, so the cluster has one broker failure of headroom for acks=all writes.",
+	"tutorial.failure.title":       "A broker fails",
+	"tutorial.failure.text":        "The first broker fails. The controller notices once its session times out and elects an in-sync follower as leader of the partition it led. Two in-sync replicas remain, which still meets min ISR 2: producers and consumers carry on after a short pause.",
+	"tutorial.secondFailure.title": "A second broker fails",
+	"tutorial.secondFailure.text":  "Now a second broker fails. Every partition still has a leader on the last broker, so consumers keep reading and no data is lost. But only one replica is in sync, below min ISR 2: acks=all producers get NotEnoughReplicas errors until a broker returns.",
+	"tutorial.next.title":          "Next steps",
+	"tutorial.next.text":           "Press Enter to model your own cluster. On the placement screen F simulates the failure of every broker and S compares strategies. A Multi-Region Cluster spreads replicas over data centers and can add observers.",
+	"tutorial.help":                "(→/Enter next. ← back. Esc to leave. Ctrl+C to quit)",
+	"tutorial.helpLast":            "(Enter to model your own cluster. ← back. Ctrl+C to quit)",
+	"tutorial.offline":             "offline, no replica left",
+	"tutorial.leaderMoves":         "leader moves from broker %d to %d (after ~%s)",
+	"tutorial.leaderStays":         "broker %d stays leader",
+	"tutorial.inSync":              "%d in sync, acks=all writes ok",
+	"tutorial.underMinISR":         "%d in sync < min ISR %d, acks=all writes rejected",
+	"tutorial.dc":                  "Data Center %d:",
+	"tutorial.broker":              "Broker %d:",
+	"tutorial.failed":              "✗ failed",
+
+	// --- Quiz ---
+	"quiz.title":          "Quiz: question %d (score %d/%d)",
+	"quiz.single":         "Single cluster",
+	"quiz.mrc":            "MRC with %d DCs",
+	"quiz.cluster":        "%s, %s, RF %d, min ISR %d",
+	"quiz.dc":             "DC %d",
+	"quiz.broker":         "broker %d",
+	"quiz.offline.one":    "Which partitions become unavailable (no in-sync replica left) if %s fails?",
+	"quiz.offline.other":  "Which partitions become unavailable (no in-sync replica left) if %s fail?",
+	"quiz.noWrites.one":   "Which partitions reject acks=all writes (min ISR %d) if %s fails?",
+	"quiz.noWrites.other": "Which partitions reject acks=all writes (min ISR %d) if %s fail?",
+	"quiz.none":           "none",
+	"quiz.placeholder":    "e.g. p1 p3, or none",
+	"quiz.notPartition":   "%q is not a partition; enter partitions like p1 p3, or none",
+	"quiz.correct":        "✓ Correct: %s",
+	"quiz.wrong":          "✗ The answer is: %s",
+	"quiz.observers":      "Only leaders and followers are in sync; observers don't take over without a promotion.",
+	"quiz.help":           "(Press Enter to answer. Esc to leave. Ctrl+C to quit)",
+	"quiz.helpNext":       "(Press Enter for the next question. Esc to leave. Ctrl+C to quit)",
+
+	// --- Health and trend ---
+	"health.line":                   "Health: %d/100 (%s)",
+	"health.title":                  "Health score %d/100:",
+	"health.weight":                 "(weight %d%%)",
+	"health.name.balance":           "balance",
+	"health.name.failure tolerance": "failure tolerance",
+	"health.name.constraints":       "constraints",
+	"health.metric.replicas":        "replicas",
+	"health.metric.leaders":         "leaders",
+	"health.metric.disk":            "disk",
+	"health.balance":                "imbalance %s",
+	"health.tolerance":              "%d of %d partitions survive any single broker failure",
+	"health.constraints":            "%d of %d partitions violate rack/DC anti-affinity",
+	"rules.title":                   "Rules (%d/%d passed):",
+	"rules.breached":                "✗ %s breached: %s",
+	"trend.title":                   "Trend for %s:",
+	"trend.none":                    "No earlier runs on this cluster yet; each exit saves a snapshot.",
+	"trend.health":                  "health",
+	"trend.replicaImbalance":        "replica imbalance",
+	"trend.leaderImbalance":         "leader imbalance",
+	"trend.saved":                   "Saved",
+	"trend.strategy":                "Strategy",
+	"trend.replicas":                "Replicas",
+	"trend.leaders":                 "Leaders",
+	"trend.now":                     "now",
+	"trend.footer":                  "%s, imbalance is stddev/mean per broker (0 is even)",
+
+	// --- Placement analysis ---
+	"placement.broker":          "Broker %d:",
+	"placement.empty":           "(empty)",
+	"placement.kubernetes":      "Kubernetes (Strimzi) topology spread for this layout:",
+	"column.broker":             "Broker",
+	"column.leaders":            "Leaders",
+	"lag.unknownGroup":          "unknown group",
+	"lag.noData":                "Consumer lag (%s): no data for this topic",
+	"lag.summary":               "Consumer lag (%s): total %s, max %s",
+	"lag.maxOn":                 " on p%d",
+	"lag.hotStuck":              ", %d hot, %d stuck",
+	"network.title":             "Estimated network load per broker:",
+	"network.none":              "No throughput data loaded (add bytes_in/bytes_out columns to --weights).",
+	"network.current":           "current",
+	"network.random":            "random",
+	"network.legend":            "before = %s strategy, after = %s strategy",
+	"network.in":                "In (before → after)",
+	"network.out":               "Out (before → after)",
+	"network.busiest":           "Busiest broker egress: %s → %s",
+	"comparison.title":          "Strategy comparison (0 = satisfied, lower is better):",
+	"comparison.calculating":    "Calculating...",
+	"comparison.scorer":         "Scorer",
+	"comparison.current":        "* current strategy",
+	"failover.title":            "Broker failure estimates (session timeout %s, %s per election):",
+	"failover.noBrokers":        "No brokers.",
+	"failover.leads":            "Leads",
+	"failover.reelected":        "Re-elected",
+	"failover.offline":          "Offline",
+	"failover.leaderless":       "Leaderless for up to",
+	"failover.controller":       " (incl. %s controller failover)",
+	"failover.more":             "... and %d more brokers",
+	"failover.worst.quorum":     "Worst case: losing broker %d loses the controller quorum, so the %s it leads stay offline until a majority of the controllers is back",
+	"failover.worst.offline":    "Worst case: losing broker %d leaves %s without an in-sync replica until it returns",
+	"failover.worst.leaderless": "Worst case: losing broker %d leaves partitions leaderless for up to %s",
+	"failover.noLeaders":        "No broker leads any partition.",
+	"failover.untilQuorum":      "until the quorum returns",
+	"failover.untilBroker":      "until the broker returns",
+	"dcLoss.title":              "Data center loss (observer lag %s, observer promotion %s):",
+	"dcLoss.design":             "Design",
+	"dcLoss.lostDC":             "Lost DC",
+	"dcLoss.affected":           "Affected",
+	"dcLoss.promoted":           "Promoted",
+	"dcLoss.lost":               ", %s lost",
+	"dcLoss.note":               "Sync stretch counts observers as in-sync followers; async + observers uses the placed roles. Losing the DC of the active controller adds a controller failover.",
+	"dcLoss.hint":               "(D to show the observer promotion commands for losing a data center)",
+	"dcLoss.commands":           "Observer promotion commands for losing data center %d (D for the next):",
+	"dcLoss.noPromotion":        "No observers need promoting: every partition led there fails over to an in-sync replica or has no surviving replica.",
+
+	// --- Leader rebalance and re-replication ---
+	"rebalance.settings":      "checked every %s, above %d%%",
+	"rebalance.title":         "Leader rebalance after broker %d fails and returns after %s (%s):",
+	"rebalance.notPreferred":  "Broker %d is the preferred leader of no partition.",
+	"rebalance.fenced":        "fenced: %s moved to other replicas, the busiest broker leads %d",
+	"rebalance.leaderless":    ", %s leaderless",
+	"rebalance.restarted":     "restarted, catching up as a follower",
+	"rebalance.leadsAgain":    "; leads its %s again",
+	"rebalance.inSync":        "back in sync: %s led by a non-preferred replica",
+	"rebalance.checkMoved":    "imbalance check: %d%% > %d%%, %s moved back to broker %d",
+	"rebalance.checkWithin":   "imbalance check: %d%% is within %d%%, nothing moves",
+	"rebalance.stuckDisabled": "Leadership does not return by itself with auto.leader.rebalance.enable=false; run %s",
+	"rebalance.stuck":         "Leadership does not return by itself: the imbalance is within leader.imbalance.per.broker.percentage=%d; run %s",
+	"rebalance.returned":      "Leadership is back on the preferred replicas at t+%s: imbalanced for %s after broker %d caught up (up to %s, depending on when the checks run)",
+	"rebalance.noneMoved":     "No leadership moved, so nothing has to return.",
+	"recovery.rate.broker":    "its network capacity",
+	"recovery.rate.default":   "the network capacity",
+	"recovery.rate.assumed":   "an assumed 1Gbit/s link",
+	"recovery.title":          "Re-replication of broker %d's replicas (fetching at %s, %s):",
+	"recovery.returns":        "Returns after %s",
+	"recovery.rebuilt":        "Rebuilt on an empty disk",
+	"recovery.unknown":        "unknown without the partitions' %s (--weights)",
+	"recovery.done":           "%s to fetch, in sync after %s",
+	"recovery.never":          "%s to fetch, never in sync: some partitions take in data faster than the broker may fetch it",
+	"recovery.leftOut":        " (%s without load data left out)",
+	"recovery.more":           "… %d more",
+	"recovery.neverDone":      "never",
+	"recovery.from":           "from broker %-3d",
+	"recovery.catchUp":        "The leader rebalance timeline assumes the replicas catch up in %s; the load data suggests %s (set --catch-up-time)",
+	"recovery.help":           "Each replica fetches from the partition's leader while it keeps taking in writes; replicas still behind share the broker's rate, and a leader sends no faster than that either. Throttle with leader/follower.replication.throttled.rate to protect client traffic.",
+
+	// --- Consumer fetch locality ---
+	"locality.title":            "Consumer fetch locality (%s: %s):",
+	"locality.unknownDC":        "⚠ No DC named %s; consumers there are ignored",
+	"locality.none":             "No consumers in any DC of this placement.",
+	"locality.leaderOnly":       "Leader only",
+	"locality.followerFetching": "KIP-392 follower fetching",
+	"locality.scenario":         "Scenario",
+	"locality.local":            "Local",
+	"locality.crossDC":          "Cross-DC",
+	"locality.unavailable":      "Unavailable",
+	"locality.allUp":            "all brokers up",
+	"locality.dcLost":           "%s lost",
+	"locality.brokerDown":       "broker %d down",
+	"locality.worst":            " (worst)",
+	"locality.stopped":          " (%s stopped)",
+	"locality.dc":               "%s (%s): %s local from leaders, %s with KIP-392",
+	"locality.fromObservers":    " (%s from observers)",
+	"locality.noReplica":        "; %s of its partitions have no replica here",
+	"locality.traffic":          "Cross-DC fetch traffic: %s from leaders only, %s with KIP-392",
+	"locality.observerReads":    "Observer reads: %s of fetches stay local only thanks to an observer",
+	"locality.saving":           ", saving %s cross-DC",
+	"locality.replication":      "Cross-DC replication: %s to followers (in acks), %s to observers (async, outside acks)",
+	"locality.help":             "KIP-392 needs replica.selector.class=org.apache.kafka.common.replica.RackAwareReplicaSelector on the brokers and client.rack set to the DC's rack on the consumers. Observers serve follower fetches too, without counting toward acks. Fetches are weighted by partition bytes out when known; set --consumer-dcs to place the consumers.",
+
+	// --- Event timeline ---
+	"timeline.title":           "Event timeline (%s; %s):",
+	"timeline.noBase":          "nothing to reassign without a placement to move from",
+	"timeline.base":            "reassigning from %s to the shown placement",
+	"timeline.columns":         "one column per %s, worst value within it",
+	"timeline.row.down":        "Brokers down",
+	"timeline.row.leaderless":  "Leaderless",
+	"timeline.row.underMinISR": "< min ISR",
+	"timeline.row.moving":      "Moving",
+	"timeline.more":            "… %d more entries",
+	"timeline.writesOK":        "acks=all writes never fail",
+	"timeline.writesFail":      "acks=all writes fail on some partition for %s in total",
+	"timeline.done":            "; the reassignment is done at t+%s (%s)",
+	"timeline.help":            "Set the script with --timeline (e.g. fail:1@0s,recover:1@5m,reassign@10m); the ISR timeline replays its outages. Waves copy at --reassign-throttle with partition sizes, else take --wave-time each.",
+	"timeline.fails":           "fails",
+	"timeline.recovers":        "recovers",
+	"timeline.noBroker":        "no broker %d, %s ignored",
+	"timeline.broker":          "broker %d %s",
+	"timeline.noDC":            "no DC %d, %s ignored",
+	"timeline.dc":              "%s %s (%s)",
+	"timeline.reassignNothing": "reassignment starts: nothing to move",
+	"timeline.reassignStarts":  "reassignment starts: %s in %s",
+	"timeline.quorumBack":      "the controller quorum has a majority again",
+	"timeline.quorumLost":      "the controller quorum lost its majority: nothing is fenced or elected until it is back",
+	"timeline.controllerDown":  "the active controller (node %d) is down; the voters elect another within %s",
+	"timeline.fenced":          "broker %d fenced after the %s session timeout",
+	"timeline.moved":           "%s moved to other in-sync replicas",
+	"timeline.offline":         "%s offline: no other in-sync replica",
+	"timeline.leadsAgain":      "broker %d leads its %s again",
+	"timeline.caughtUp":        "broker %d caught up, rejoins the ISR of %s",
+	"timeline.wavePauses":      "wave %d pauses: broker %d is down",
+	"timeline.waveWaits":       "wave %d waits: broker %d is down",
+	"timeline.waveResumes":     "wave %d resumes",
+	"timeline.waveStarts":      "wave %d of %d starts: %s, %s (about %s)",
+	"timeline.waveDone":        "wave %d of %d done",
+	"timeline.reassignDone":    "reassignment done after %s",
+	"timeline.noWrites":        "acks=all writes fail on %s (%d leaderless, %d under min ISR %d)",
+	"timeline.writesBack":      "every partition accepts acks=all writes again",
+	"timeline.reassignNotDone": "reassignment not done: %s of %d left",
+
+	// --- Diff and strategy parameters ---
+	"diff.random":                     "the random strategy",
+	"diff.legend":                     "Legend (changes vs %s):",
+	"diff.added":                      "+pX added (%d)",
+	"diff.removed":                    "-pX removed (%d)",
+	"diff.changed":                    "~pX role changed (%d)",
+	"diff.nothingMoves":               "Migration: nothing moves",
+	"diff.perBroker":                  "%s per broker",
+	"diff.perDC":                      "%s per DC",
+	"diff.migration":                  "Migration: %s, %s in %s",
+	"diff.atMost":                     " (at most %s at a time)",
+	"diff.wavesFlag":                  "; --waves writes one reassignment JSON per wave",
+	"strategy.title":                  "Placement Strategy:",
+	"strategy.selector":               "Strategy:",
+	"strategy.param.rackStrictness":   "Rack strictness (1 = spread MRC replicas over all DCs first, 0 = ignore DCs)",
+	"strategy.param.leaderSpread":     "Leader spread (1 = balance leader traffic, 0 = keep leaders as placed)",
+	"strategy.param.goals":            "Goals (comma-separated, highest priority first; empty = all)",
+	"strategy.param.maxMoves":         "Max moves (0 = one per replica)",
+	"strategy.param.maxMoves.invalid": "max moves must be 0 or a positive number",
+	"strategy.param.notDigit":         "%q must be 0 or 1",
+	"strategy.previous":               "the previous %s placement",
+	"strategy.noParams":               "This strategy has no parameters.",
+	"strategy.help":                   "(Left/Right to change strategy. Tab/Shift+Tab or Up/Down to move. Ctrl+R for the strategy's defaults. Enter to place again. Esc to cancel)",
+
+	// --- Color modes ---
+	"colorMode.dc":       "by data center",
+	"colorMode.replicas": "by replica density",
+	"colorMode.leaders":  "by leader density",
+	"colorMode.diff":     "by changes",
+	"colorMode.role":     "by role",
+
+	// --- ISR timeline ---
+	"isr.slow":           "broker %d slow t+%s…%s",
+	"isr.down":           "broker %d down t+%s…%s",
+	"isr.title":          "ISR timeline (%s; replica.lag.time.max.ms=%d, min ISR %d):",
+	"isr.none":           "No partition has a leader to follow.",
+	"isr.columns":        "one column per %s, | every %s",
+	"isr.morePartitions": "… %d more partitions (%d of %d affected)",
+	"isr.moreEvents":     "… %d more events",
+	"isr.brokerEvent":    "broker %d %s (%s)",
+	"isr.help":           "█ in sync, ▒ in the ISR but lagging, · out of the ISR, ✗ broker down; L is the preferred leader. A follower leaves the ISR after %s without catching up; set --replica-lag-time-max and --isr-events.",
+	"isr.quorumBack":     "metadata quorum back: pending ISR changes and elections are committed",
+	"isr.quorumLost":     "metadata quorum lost (%s unwritable): ISRs and leaders freeze",
+	"isr.fenced":         "fenced, leaves the ISR",
+	"isr.elected":        "elected leader",
+	"isr.offline":        "fenced; no other in-sync replica, the partition is offline",
+	"isr.leadsAgain":     "back, leads again",
+	"isr.leaves":         "leaves the ISR: not caught up for more than %s",
+	"isr.rejoins":        "caught up, rejoins the ISR",
+	"isr.noLeader":       "no leader: every write fails",
+	"isr.underMinISR":    "ISR %d < min ISR %d: acks=all writes fail (NotEnoughReplicas)",
+	"isr.writesBack":     "ISR back to %d ≥ min ISR %d: writes succeed again",
+
+	// --- Replication, RF change, tenants and MM2 ---
+	"replication.title":           "Under-replicated and offline partitions (live):",
+	"replication.ok":              "✓ Every partition has a leader and all its replicas in sync",
+	"replication.counts":          "%d offline, %d under-replicated",
+	"replication.underReplicated": "under-replicated",
+	"replication.offline":         "offline",
+	"replication.partition":       "p%d %s: ISR %s of replicas %s",
+	"replication.outOfSync":       ", out of sync: %s",
+	"replication.more":            "...and %d more",
+	"replication.help":            "Brokers with out-of-sync replicas have a red double border.",
+	"rf.error":                    "RF change: %v",
+	"rf.title":                    "What-if: RF %d → %d",
+	"rf.help":                     " (+/- to change it again; export with --export-on-exit --export-format reassignment or --waves)",
+	"rf.added":                    "New replicas: %s",
+	"rf.copy":                     "; %s to copy",
+	"rf.copyUnknown":              "; data to copy unknown without partition sizes (--weights)",
+	"rf.removed":                  "Removed replicas: %s",
+	"rf.tolerance":                "Broker failures survived with acks=all writes: %d → %d; without data loss: %d → %d",
+	"rf.dcLoss":                   "; a DC loss keeps every partition's data: %s → %s",
+	"rf.yes":                      "yes",
+	"rf.no":                       "no",
+	"tenants.none":                "(no tenant)",
+	"tenants.title":               "Tenant footprint (%s over %s):",
+	"tenants.tenant":              "Tenant",
+	"tenants.topics":              "Topics",
+	"tenants.partitions":          "Partitions",
+	"tenants.size":                "Size",
+	"tenants.replicasLeaders":     "Replicas/leaders",
+	"tenants.isolated":            "✓ %s is isolated on its own %s",
+	"tenants.shares":              "%s shares %d of its %s with other tenants: %s",
+	"tenants.help":                "Replicas/leaders per DC and broker; shares are of the whole cluster. Set tenants in the topics CSV or the topic editor (T).",
+	"mirror.status":               "✗ MM2: %s with a different partition count than the source (X for the topic mapping)",
+	"mirror.title":                "MirrorMaker 2 topic mapping (%s, separator %q):",
+	"mirror.mirrored":             ": %s mirrored",
+	"mirror.loop":                 "⚠ %s -> %s is enabled too: IdentityReplicationPolicy cannot tell mirrored topics apart, so records loop between the clusters",
+	"mirror.source":               "Source topic",
+	"mirror.target":               "Target topic",
+	"mirror.more":                 "... and %d more",
+	"mirror.unknown":              "unknown",
+	"mirror.present":              "present",
+	"mirror.missing":              "missing",
+	"mirror.internal":             "%s on %s (%s): %s",
+	"mirror.partitions.unknown":   "%d -> ? (target topics not loaded)",
+	"mirror.partitions.missing":   "%d -> not created yet",
+	"mirror.partitions.fewer":     "%d -> %d: fewer on the target until MM2 refreshes the topic",
+	"mirror.partitions.more":      "%d -> %d: extra target partitions stay empty; MM2 never removes them",
+	"mirror.purpose.offsetSyncs":  "maps source to target offsets, written by the source connector",
+	"mirror.purpose.checkpoints":  "translated consumer group offsets, for failing consumers over",
+	"mirror.purpose.heartbeats":   "mirrored heartbeats, showing the flow is alive",
+
+	// --- Broker details, notes and storage ---
+	"broker.none":          "No broker selected.",
+	"broker.tags":          "Tags: %s",
+	"broker.partition":     "Partition",
+	"broker.topic":         "Topic",
+	"broker.role":          "Role",
+	"broker.preferred":     "Preferred leader",
+	"broker.help":          "(Up/Down or PgUp/PgDn to scroll. Esc or Enter to close. Ctrl+C to quit)",
+	"broker.rows":          "Rows %d-%d of %d. %s",
+	"layout.rows":          "Broker rows %d-%d of %d (PgUp/PgDn to scroll)",
+	"histogram.partitions": "Partitions per broker (%s):",
+	"histogram.leaders":    "Leaders per broker:",
+	"note.placeholder":     "note for the reviewers, or p3: ... for a partition",
+	"note.title":           "Note",
+	"note.broker":          "Note on broker %d",
+	"note.help":            "(Enter to add the note. Esc to discard it)",
+	"storage.others":       "%d other topics",
+	"storage.title":        "Disk by topic: %s %s",
+	"storage.ratio":        " (%.1f× its average broker)",
+	"storage.hot":          "⚠ Hot broker: %.0f%% above the average broker (%s); %s adds the most, %s over its average share",
+	"tags.filter":          "Tag %s: %s, %s (/ for the next tag)",
+	"tags.or":              " or ",
+	"tags.avoided":         "✓ Leaders: no partition led by a %s broker",
+	"tags.notAvoided":      "✗ Leaders: %s led by a %s broker, having no other in-sync replica (%s)",
+	"form.beforeEdit":      "the placement before the edit",
+	"form.notNumber":       "must be a number",
+	"library.title":        "Scenario library",
+	"library.help":         "(↑/↓ to choose. Enter to place it. Esc to go back. Ctrl+C to quit)",
+	"snapshots.base":       "the snapshot of %s",
+}
@@ -0,0 +1,879 @@
+package i18n
+
+// indonesian translates the UI into Bahasa Indonesia. Kafka terms that
+// appear as such in configs and docs (broker, leader, follower, observer,
+// ISR) stay untranslated.
+var indonesian = map[string]string{
+	// --- Title, cluster type menu and errors ---
+	"menu.title":       "Pilih jenis cluster:",
+	"menu.single":      "[S] Cluster Tunggal",
+	"menu.mrc":         "[M] Multi-Region Cluster (MRC)",
+	"menu.tutorial":    "[T] Tutorial: contoh 3 broker dengan panduan",
+	"menu.quiz":        "[Q] Kuis: apa yang rusak oleh kegagalan ini?",
+	"menu.library":     "[L] Pustaka skenario: penyiapan klasik dan jebakannya",
+	"menu.connect":     "[C] Hubungkan ke cluster aktif: tampilkan penempatan nyata sebuah topik",
+	"menu.resume":      "[R] Lanjutkan sesi terakhir (%s, disimpan %s)",
+	"menu.session":     "%d partisi, RF %d",
+	"menu.help":        "(Tekan S, M, T, Q, L atau C. Ctrl+C untuk keluar)",
+	"menu.help.resume": "(Tekan S, M, T, Q, L, C atau R. Ctrl+C untuk keluar)",
+	"app.title":        "Visualisasi Partisi Kafka",
+	"error.title":      "Kesalahan: %s",
+	"error.unexpected": "Terjadi kesalahan yang tidak terduga.",
+	"error.help":       "(Tekan Enter untuk mulai ulang. E untuk mengubah nilai. Ctrl+C untuk keluar)",
+
+	// --- Configuration form ---
+	"form.title.single":    "Masukkan Konfigurasi Cluster Tunggal:",
+	"form.title.mrc":       "Masukkan Konfigurasi MRC:",
+	"field.dcs":            "Data Center",
+	"field.brokers":        "Jumlah Broker",
+	"field.brokersPerDC":   "Broker per DC",
+	"field.partitions":     "Partisi",
+	"field.rf":             "Replication Factor",
+	"field.minISR":         "Min ISR",
+	"form.label":           "%s:",
+	"form.suggested":       " (saran, ketik untuk mengganti)",
+	"form.problems":        "Perbaiki %d masalah berikut:",
+	"form.help":            "Gunakan Tab/Shift+Tab atau Atas/Bawah untuk berpindah. Enter untuk konfirmasi/lanjut. Ctrl+T untuk menentukan beberapa topik. Ctrl+C untuk keluar.",
+	"form.empty":           "isian '%s' tidak boleh kosong",
+	"form.invalid":         "angka tidak valid untuk '%s': %v",
+	"form.positive":        "isian '%s' harus positif",
+	"form.mrcDCs":          "MRC memerlukan minimal 2 Data Center",
+	"form.brokerCounts":    "'%s' harus berupa satu angka, atau satu angka positif per DC dipisahkan koma (mis. 4,3,2)",
+	"form.rackCount":       "%d jumlah broker diberikan untuk %d Data Center",
+	"form.rfOverBrokers":   "Replication Factor (%d) tidak boleh melebihi jumlah broker (%d)",
+	"form.minISROverRF":    "Min ISR (%d) tidak boleh melebihi Replication Factor (%d)",
+	"form.replicasPerBrkr": "%d replika per broker melebihi batas maksimum yang disarankan yaitu %d, tambah broker atau kurangi partisi",
+
+	// --- Placement ---
+	"placement.title":          "Visualisasi Penempatan Partisi:",
+	"placement.topic":          "Topik: %s",
+	"placement.topics":         "Topik:",
+	"placement.topicsSwitch":   "([ dan ] untuk berganti)",
+	"placement.strategy":       "Strategi: %s",
+	"placement.strategy.live":  "Strategi: tidak ada (penempatan aktual dari cluster live)",
+	"placement.seed":           " (seed %d)",
+	"placement.recommendation": "Rekomendasi MRC: %s",
+
+	// --- Recommendations ---
+	"recommend.distribute":     "Sebarkan %d replika ke %d DC agar tahan terhadap kegagalan.",
+	"recommend.onePerDC":       " Usahakan paling banyak satu replika per DC untuk setiap partisi.",
+	"recommend.perDC":          " Usahakan sekitar %d replika per DC, dengan %d DC mendapat satu replika tambahan.",
+	"recommend.uneven":         " Jumlah broker per DC adalah %s: dengan replika tersebar ke semua DC, broker di %s masing-masing menampung %.1f replika, %.1fx rata-rata. Menambah broker di sana meratakan beban.",
+	"recommend.unevenBalanced": " Jumlah broker per DC adalah %s; replika tetap membebani setiap broker secara merata.",
+
+	// --- Observer analysis ---
+	"observers.none":              "RF %d dengan min ISR %d tidak menyisakan observer: setiap replika harus sinkron, sehingga penulisan acks=all berhenti begitu satu replika atau DC-nya mati.",
+	"observers.none.fix":          "Naikkan RF menjadi %d agar ada observer untuk dipromosikan, atau turunkan min ISR menjadi %d.",
+	"observers.none.fixRF":        "Naikkan RF menjadi %d agar ada observer untuk dipromosikan.",
+	"observers.none.fixISR":       "Turunkan min ISR menjadi %d; broker tidak cukup untuk menaikkan RF.",
+	"observers.noFollowers":       "Min ISR 1 menjadikan semua %d replika selain leader observer: penulisan acks=all hanya dikonfirmasi oleh leader, dan hilang bersama DC-nya.",
+	"observers.noFollowers.fix":   "Naikkan min ISR menjadi 2 agar follower di DC lain mengonfirmasi setiap penulisan.",
+	"observers.noFollowers.fixRF": "Naikkan RF menjadi 3 dan min ISR menjadi 2 agar follower di DC lain mengonfirmasi setiap penulisan.",
+	"observers.everyDC":           "Min ISR %d menempatkan replika sinkron di setiap %d DC: tidak ada DC yang boleh gagal tanpa memblokir penulisan acks=all sampai observer dipromosikan.",
+	"observers.everyDC.fix":       "Turunkan min ISR menjadi %d agar ada DC yang hanya berisi observer, atau sebarkan broker ke %d DC.",
+	"observers.everyDC.fixDC":     "Sebarkan broker ke %d DC agar ada satu DC yang hanya berisi observer.",
+
+	// --- Legend ---
+	"legend.title":           "Keterangan: ",
+	"legend.colored":         "Keterangan (warna menurut %s): ",
+	"legend.leader":          "Leader (pX)",
+	"legend.follower":        "Follower (pX)",
+	"legend.observer":        "Observer (pX)",
+	"legend.leader.bold":     "Leader (tebal)",
+	"legend.observer.italic": "Observer (miring)",
+	"legend.lagHot":          "Lag ≥ ambang batas",
+	"legend.lagStuck":        "Consumer macet",
+
+	// --- Placement keys ---
+	"keys.press":       "(Tekan %s)",
+	"keys.separator":   ". ",
+	"keys.footer":      "(? untuk keterangan dan tombol)",
+	"keys.restart":     "Enter untuk mulai ulang",
+	"keys.stats":       "S untuk statistik",
+	"keys.failover":    "F untuk waktu failover",
+	"keys.trend":       "G untuk tren kesehatan",
+	"keys.k8s":         "K untuk sebaran Kubernetes",
+	"keys.arrows":      "Panah untuk memilih",
+	"keys.details":     "Enter untuk detail broker",
+	"keys.deselect":    "Esc untuk batal memilih",
+	"keys.select":      "Panah untuk memilih broker",
+	"keys.lag":         "L untuk overlay lag",
+	"keys.urp":         "U untuk partisi under-replicated",
+	"keys.snapshots":   "Z untuk snapshot",
+	"keys.mirror":      "X untuk pemetaan topik MM2",
+	"keys.tenants":     "O untuk jejak tenant",
+	"keys.matrix":      "M untuk matriks ketahanan min ISR",
+	"keys.dr":          "Y untuk membandingkan dengan 2 cluster + MM2",
+	"keys.rf":          "+/- untuk mencoba RF lain",
+	"keys.isr":         "I untuk linimasa ISR",
+	"keys.locality":    "W untuk lokalitas fetch consumer",
+	"keys.timeline":    "R untuk linimasa kejadian",
+	"keys.log":         "J untuk log",
+	"keys.controllers": "V untuk controller gabungan/terpisah",
+	"keys.rebalance":   "B untuk auto leader rebalance",
+	"keys.graphical":   "A untuk tampilan grafis",
+	"keys.summary":     "A untuk ringkasan teks",
+	"keys.placement":   "H untuk penempatan",
+	"keys.histograms":  "H untuk histogram",
+	"keys.coloring":    "C untuk mengganti pewarnaan",
+	"keys.tag":         "/ untuk menyorot tag broker",
+	"keys.edit":        "E untuk mengubah skenario ini",
+	"keys.strategy":    "P untuk opsi strategi",
+	"keys.topics":      "T untuk mengubah topik",
+	"keys.note":        "N untuk menambah catatan",
+	"keys.screenshot":  "Ctrl+S untuk tangkapan layar",
+	"keys.hideFooter":  "? untuk menyembunyikan footer ini",
+	"keys.quit":        "Ctrl+C untuk keluar",
+
+	// --- Counts ---
+	"noun.broker.one":                 "%d broker",
+	"noun.broker.other":               "%d broker",
+	"noun.consumer.one":               "%d consumer",
+	"noun.consumer.other":             "%d consumer",
+	"noun.data center.one":            "%d data center",
+	"noun.data center.other":          "%d data center",
+	"noun.earlier run.one":            "%d eksekusi sebelumnya",
+	"noun.earlier run.other":          "%d eksekusi sebelumnya",
+	"noun.in-sync replica.one":        "%d replika in-sync",
+	"noun.in-sync replica.other":      "%d replika in-sync",
+	"noun.leader.one":                 "%d leader",
+	"noun.leader.other":               "%d leader",
+	"noun.leaderless partition.one":   "%d partisi tanpa leader",
+	"noun.leaderless partition.other": "%d partisi tanpa leader",
+	"noun.leadership.one":             "%d kepemimpinan",
+	"noun.leadership.other":           "%d kepemimpinan",
+	"noun.message.one":                "%d pesan",
+	"noun.message.other":              "%d pesan",
+	"noun.mirrored topic.one":         "%d topik yang dicerminkan",
+	"noun.mirrored topic.other":       "%d topik yang dicerminkan",
+	"noun.more combination.one":       "%d kombinasi lainnya",
+	"noun.more combination.other":     "%d kombinasi lainnya",
+	"noun.move.one":                   "%d perpindahan",
+	"noun.move.other":                 "%d perpindahan",
+	"noun.new replica.one":            "%d replika baru",
+	"noun.new replica.other":          "%d replika baru",
+	"noun.new warning.one":            "%d peringatan baru",
+	"noun.new warning.other":          "%d peringatan baru",
+	"noun.offline partition.one":      "%d partisi offline",
+	"noun.offline partition.other":    "%d partisi offline",
+	"noun.older snapshot.one":         "%d snapshot yang lebih lama",
+	"noun.older snapshot.other":       "%d snapshot yang lebih lama",
+	"noun.partition.one":              "%d partisi",
+	"noun.partition.other":            "%d partisi",
+	"noun.rack.one":                   "%d rack",
+	"noun.rack.other":                 "%d rack",
+	"noun.replica.one":                "%d replika",
+	"noun.replica.other":              "%d replika",
+	"noun.rule.one":                   "%d aturan",
+	"noun.rule.other":                 "%d aturan",
+	"noun.step.one":                   "%d langkah",
+	"noun.step.other":                 "%d langkah",
+	"noun.tenant.one":                 "%d tenant",
+	"noun.tenant.other":               "%d tenant",
+	"noun.topic.one":                  "%d topik",
+	"noun.topic.other":                "%d topik",
+	"noun.wave.one":                   "%d gelombang",
+	"noun.wave.other":                 "%d gelombang",
+	"list.brokers.one":                "broker %s",
+	"list.brokers.other":              "broker %s",
+	"list.more":                       "...dan %d lainnya",
+	"ago.now":                         "baru saja",
+	"ago.minutes":                     "%d menit lalu",
+	"ago.hours":                       "%d jam lalu",
+	"ago.days":                        "%d hari lalu",
+
+	// --- Controllers and metadata log ---
+	"controllers.dedicated":      "node khusus %s",
+	"controllers.zookeeper":      "node ZooKeeper %s",
+	"controllers.combined":       "digabung dengan broker %s",
+	"controllers.status":         "Controller: %s, perlu %d dari %d",
+	"controllers.metadataLeader": ", %s dipimpin node %d",
+	"controllers.survives":       "bertahan saat kehilangan satu DC mana pun",
+	"controllers.lost":           "kehilangan %s berarti kehilangan quorum",
+	"controllers.or":             " atau ",
+	"controllers.risk":           "⚠ %s menampung %d dari %d voter: kehilangan DC ini menghentikan semua pemilihan leader meskipun setiap partisi masih punya replika di tempat lain",
+	"controllers.suggested":      "  Saran: %s",
+	"controllers.addDCs":         "tambah data center untuk para voter",
+	"controllers.tiebreaker":     "situs tiebreaker",
+	"controllers.votersIn":       "%d di %s",
+	"controllers.voters":         "%d voter, %s",
+	"controllers.tiebreakerNode": " (tiebreaker sebagai controller khusus)",
+	"metadata.title":             "Log metadata (%s), dipimpin controller aktif, node %d di %s:",
+	"metadata.voters":            "  Replika voter: %s; commit perlu %d dari %d",
+	"metadata.observers":         "  Replika observer: broker %s, mengambilnya untuk cache metadata mereka",
+	"metadata.losing":            "  Kehilangan %s: ",
+	"metadata.losing.broker":     "broker %d",
+	"metadata.readOnly":          "tersisa %d dari %d voter, log hanya bisa dibaca; tidak ada pemilihan, perubahan ISR, perubahan topik atau registrasi broker sampai %d kembali",
+	"metadata.leaderMoved":       "node %d memimpin log setelah failover controller (%s); setiap pemilihan menunggunya",
+	"metadata.writable":          "masih bisa ditulis, tersisa %d dari %d voter",
+	"metadata.help":              "Observer dan broker lain tetap melayani metadata dari cache mereka selama kegagalan ini. Atur controller aktif dengan --active-controller.",
+
+	// --- Throughput capacity ---
+	"capacity.over":                   "✗ Throughput: melebihi kapasitas pada %s (S untuk sisa kapasitas)",
+	"capacity.near":                   "⚠ Throughput: di atas %.0f%% kapasitas pada %s",
+	"capacity.title":                  "Sisa kapasitas throughput per broker:",
+	"capacity.none":                   "Tidak ada data kapasitas atau throughput (atur --network-capacity/--disk-capacity atau --broker-capacity, dengan bytes_in/bytes_out di --weights).",
+	"capacity.col.broker":             "Broker",
+	"capacity.col.in":                 "Masuk",
+	"capacity.col.out":                "Keluar",
+	"capacity.col.diskWrite":          "Tulis disk",
+	"capacity.col.network":            "Jaringan",
+	"capacity.col.disk":               "Disk",
+	"capacity.col.headroom":           "Sisa",
+	"capacity.unknown":                "kapasitas tidak diketahui",
+	"capacity.headroom":               "%s (%s)",
+	"capacity.overBy":                 "lebih %s (%s)",
+	"capacity.bottleneck.disk":        "disk",
+	"capacity.bottleneck.network in":  "jaringan masuk",
+	"capacity.bottleneck.network out": "jaringan keluar",
+	"capacity.help":                   "Leader mengirim lalu lintas produce, consume dan replikasi; setiap replika menerima dan menulis byte yang diproduksi.",
+
+	// --- Reassignment, drift and snapshots ---
+	"reassign.failed":     "Gagal memuat ulang penempatan: %v",
+	"reassign.progress":   "Reassignment berjalan: %s berpindah",
+	"reassign.bar":        "%s %d%% (%d dari %d %s)",
+	"reassign.inSync":     "replika baru sinkron",
+	"reassign.approved":   "partisi sesuai penempatan yang disetujui",
+	"reassign.catchingUp": "mengejar",
+	"reassign.caughtUp":   "sinkron",
+	"reassign.adding":     "menambah %s",
+	"reassign.removing":   "menghapus %s",
+	"drift.none":          "✓ Tidak ada drift: penempatan live sesuai penempatan yang disetujui",
+	"drift.found":         "⚠ Drift: %s menyimpang dari penempatan yang disetujui",
+	"snapshots.title":     "Snapshot %s (%d di %s):",
+	"snapshots.every":     "Snapshot disimpan setiap %s selama penempatan berubah.",
+	"snapshots.none":      "Belum ada snapshot.",
+	"snapshots.older":     "...%s sebelum ini",
+	"snapshots.same":      "✓ Penempatan live sama dengan di snapshot ini",
+	"snapshots.changed":   "%s berubah sejak snapshot ini:",
+	"snapshots.help":      "(< dan > untuk membandingkan dengan snapshot yang lebih lama atau lebih baru)",
+	"snapshots.saved":     "Snapshot penempatan %s disimpan ke %s",
+	"snapshots.loadError": "memuat snapshot %s",
+	"snapshots.notSaving": "Tidak menyimpan snapshot: %v",
+
+	// --- Log ---
+	"log.title":        "Log:",
+	"log.none":         "Belum ada yang dicatat.",
+	"log.older":        "...%s sebelum ini",
+	"log.badge":        "⚠ %s di log (J untuk menampilkan)",
+	"log.session":      "Mengabaikan sesi tersimpan: %v",
+	"log.history":      "Mengabaikan riwayat: %v",
+	"log.reloadFailed": "Gagal memuat ulang penempatan %s: %v",
+	"log.reassignDone": "Reassignment selesai: tidak ada partisi %s yang berpindah",
+	"log.reassigning":  "Reassignment: %s dari %s berpindah",
+	"log.reloaded":     "Penempatan %s dimuat ulang",
+	"log.driftNone":    "Penempatan live sesuai penempatan yang disetujui",
+	"log.drift":        "Drift dari penempatan yang disetujui: %s",
+
+	// --- Import ---
+	"import.title":         "Mengimpor",
+	"import.strimziFiles":  "Mengimpor cluster Strimzi dari %s",
+	"import.strimzi":       "Mengimpor cluster Strimzi %s",
+	"import.loading":       "Memuat %s",
+	"import.bundle":        "Membuka bundle %s",
+	"import.progress":      "%s %d%% (%d dari %d %s)",
+	"import.unit.files":    "berkas",
+	"import.unit.topics":   "topik",
+	"import.unit.requests": "permintaan",
+	"import.starting":      "Memulai...",
+	"import.help":          "(Esc untuk membatalkan. Ctrl+C untuk keluar)",
+	"import.cancelled":     "%s dibatalkan",
+	"import.failed":        "%s gagal: %v",
+	"import.finished":      "%s selesai",
+
+	// --- Strategies and client affinity ---
+	"strategy.random":         "Leader bergiliran (round-robin), follower diacak",
+	"strategy.sizeAware":      "Menyeimbangkan byte partisi, bukan jumlah replika",
+	"strategy.goals":          "Mengoptimalkan goal gaya Cruise Control sesuai urutan prioritas",
+	"strategy.clientAffinity": "Leader partisi di DC yang paling banyak memproduksi (--client-traffic)",
+	"strategy.kafkaDefault":   "Penempatan Kafka untuk topik baru, tanpa memperhatikan rack (start index + shift)",
+	"strategy.rackAware":      "Penempatan Kafka untuk topik baru dengan broker.rack diatur, DC sebagai rack",
+	"affinity.unknownDC":      "⚠ Tidak ada DC bernama %s; lalu lintas client di sana diabaikan",
+	"affinity.produce":        "produce %s dari %s (%s)",
+	"affinity.consume":        "consume %s dari %s (%s)",
+	"affinity.crossing":       "Lalu lintas client yang melintasi DC ke leader: %s",
+	"affinity.hint":           "Strategi client-affinity menempatkan leader partisi di DC yang paling banyak memproduksi.",
+	"affinity.fewer":          "✓ %s lebih sedikit lompatan produce antar-DC dibanding leader round-robin (%s)",
+	"affinity.notFewer":       "Tidak ada pengurangan lompatan produce antar-DC dibanding leader round-robin (%s)",
+
+	// --- Rolling restart ---
+	"rolling.title":                    "Rolling restart %s (mati %s, %s untuk mengejar, controller aktif terakhir):",
+	"rolling.col.shutdown":             "Shutdown",
+	"rolling.col.worst":                "Tanpa leader paling lama",
+	"rolling.col.unavailable":          "Waktu-partisi tanpa leader",
+	"rolling.col.takes":                "Durasi",
+	"rolling.mode.controlled shutdown": "controlled shutdown",
+	"rolling.mode.hard kill":           "hard kill",
+	"rolling.saves":                    "✓ Controlled shutdown menghemat %s waktu-partisi tanpa leader: leader berpindah sebelum setiap broker berhenti, bukan setelah session timeout %s",
+	"rolling.offlineBroker":            "broker %d: %s",
+	"rolling.offline":                  "✗ Offline selama broker-nya restart, di kedua mode (tidak ada replika in-sync lain): %s",
+	"rolling.help":                     "Hentikan broker dengan SIGTERM dan controlled.shutdown.enable=true (bawaan); SIGKILL atau crash berperilaku seperti hard kill. Setiap restart mengasumsikan leadership sudah dikembalikan ke replika pilihan (preferred) sebelumnya.",
+
+	// --- Live cluster ---
+	"kafka.title":          "Hubungkan ke cluster Kafka live:",
+	"kafka.bootstrap":      "Server bootstrap:",
+	"kafka.topic":          "Topik:",
+	"kafka.topicHint":      "kosongkan untuk memilih dari cluster",
+	"kafka.tls":            "Terhubung melalui TLS.",
+	"kafka.plaintext":      "Terhubung tanpa enkripsi (--kafka-tls untuk TLS).",
+	"kafka.sasl":           "Autentikasi sebagai %s dengan %s.",
+	"kafka.noSASL":         "Tanpa autentikasi SASL (--kafka-sasl untuk autentikasi).",
+	"kafka.help":           "(Tab untuk berpindah isian. Enter untuk terhubung. Esc untuk kembali. Ctrl+C untuk keluar)",
+	"kafka.noBootstrap":    "masukkan server bootstrap, mis. localhost:9092",
+	"kafka.noTopics":       "cluster di balik %s tidak punya topik",
+	"kafka.readAssignment": "membaca penempatan %s",
+	"kafka.connecting":     "Menghubungkan ke %s",
+	"kafka.reading":        "Membaca %s dari %s",
+	"kafka.controller":     "broker %d",
+	"kafka.topics":         "Topik di %s (%s, %s):",
+	"kafka.above":          "...%d lagi di atas",
+	"kafka.below":          "...%d lagi di bawah",
+	"kafka.topicsHelp":     "(↑/↓ untuk memilih. Enter untuk menampilkan penempatannya. Esc untuk kembali. Ctrl+C untuk keluar)",
+
+	// --- Racks, cluster metadata and constraints ---
+	"group.dc":               "Data Center %d:",
+	"group.dcNamed":          "Data Center %d (%s):",
+	"group.rack":             "Rack %s:",
+	"broker.header.dc":       "Broker %d — Data Center %d",
+	"broker.header.rack":     "Broker %d — Rack %s",
+	"cluster.title":          "Cluster %s",
+	"cluster.details":        "  ID: %s  Kafka: %s  Controller: %s",
+	"cluster.racks":          "Rack: %s",
+	"cluster.noRack":         "tanpa rack",
+	"cluster.unknown":        "tidak diketahui",
+	"constraints.violations": "%s melanggar anti-affinity rack/DC (%s)",
+	"constraints.unracked":   "%s tanpa broker.rack",
+	"constraints.ok":         "✓ Batasan: setiap partisi tersebar ke sebanyak mungkin DC",
+	"constraints.failed":     "✗ Batasan: %s",
+
+	// --- Placement warnings ---
+	"placement.rackFallback": "Strategi %s memerlukan rack pada cluster tunggal (--racks); ditempatkan sebagai %s",
+
+	// --- Condensed view ---
+	"condensed.warning": "⚠ Diringkas untuk %dx%d, kotak memerlukan %dx%d",
+	"condensed.health":  "Kesehatan %d/100",
+	"condensed.more":    "...%d baris lagi (A untuk ringkasan teks)",
+	"condensed.help":    "(A ringkasan. Enter mulai ulang. Ctrl+C keluar)",
+	"condensed.empty":   "(kosong)",
+
+	// --- Min ISR survivability ---
+	"survivability.title":       "Ketahanan min ISR menurut data center yang gagal:",
+	"survivability.oneDC":       "Memerlukan lebih dari satu data center.",
+	"survivability.failedDCs":   "DC gagal",
+	"survivability.controllers": "Controller",
+	"survivability.topic":       "topik",
+	"survivability.column":      "%s (min ISR %d)",
+	"survivability.noMajority":  "✗ tanpa mayoritas",
+	"survivability.rejecting":   "✗ %d dari %d",
+	"survivability.more":        "...dan %s",
+	"survivability.help":        "✓ setiap partisi mempertahankan min ISR replika in-sync. ✗ n dari N: partisi yang menolak penulisan acks=all (offline atau di bawah min ISR). Observer tidak dipromosikan.",
+
+	// --- DR design comparison ---
+	"dr.title":              "Perbandingan desain DR, saat kehilangan satu data center:",
+	"dr.needsMRC":           "Memerlukan penempatan MRC dengan lebih dari satu data center.",
+	"dr.row.brokers":        "Broker",
+	"dr.row.storage":        "Penyimpanan",
+	"dr.row.crossDC":        "Tulisan antar-DC",
+	"dr.help":               "Lag MM2 %s (--mm2-lag), cutover %s (waktu promosi observer). Penyimpanan dan lalu lintas memerlukan --weights.",
+	"dr.mrc":                "MRC (penempatan ini)",
+	"dr.mrc.sizing":         "%d DC, satu cluster",
+	"dr.mrc.rpoLost":        "%d partisi punya semua replika di satu DC",
+	"dr.mrc.rpoObservers":   "lag observer, saat dipromosikan",
+	"dr.mrc.rpoInSync":      "replika in-sync tetap hidup",
+	"dr.mrc.rtoQuorum":      "sampai quorum controller kembali",
+	"dr.mrc.rtoPromoted":    "observer dipromosikan oleh operator",
+	"dr.mrc.rtoElection":    "pemilihan leader otomatis",
+	"dr.mrc.oneCluster":     "Satu cluster: client tetap memakai server bootstrap, nama topik dan offset consumer yang sama saat kehilangan DC.",
+	"dr.mrc.crossFollowers": "acks=all menunggu follower di DC lain, sehingga setiap tulisan menanggung round trip antar-DC.",
+	"dr.mrc.observers":      "Observer hanya mengambil alih setelah dipromosikan, satu langkah operator di runbook.",
+	"dr.mrc.quorumLost":     "Quorum controller tidak bertahan saat kehilangan %s; voter di situs ketiga memperbaikinya.",
+	"dr.mrc.or":             " atau ",
+	"dr.mrc.links":          "Memerlukan koneksi latensi rendah antar-DC untuk replikasi dan quorum controller.",
+	"dr.mm2":                "2 cluster + MM2",
+	"dr.mm2.sizing":         "%d per cluster",
+	"dr.mm2.sizingUneven":   "%v per cluster",
+	"dr.mm2.rpo":            "lag MM2; asinkron",
+	"dr.mm2.rto":            "client diarahkan ulang oleh operator",
+	"dr.mm2.local":          "Tulisan tetap lokal: acks=all tidak pernah menunggu DC lain.",
+	"dr.mm2.offsets":        "Consumer melanjutkan dari offset yang diterjemahkan MM2, memproses ulang hingga %s pesan.",
+	"dr.mm2.renames":        "Replication policy bawaan mengganti nama topik di target (mis. primary.orders); IdentityReplicationPolicy mempertahankan namanya.",
+	"dr.mm2.operate":        "Dua cluster untuk dioperasikan, di-upgrade dan diamankan, ditambah worker MM2 Connect.",
+	"dr.mm2.failback":       "Failback memerlukan aliran balik dan cutover kedua.",
+	"dr.mm2.unused":         "Hanya %s dan %s yang menampung cluster; DC lainnya tidak terpakai.",
+
+	// --- Topic naming ---
+	"naming.title":     "Penamaan topik (%d/%d topik lolos",
+	"naming.ignored":   ", %d diabaikan",
+	"naming.pass":      "✓ Setiap topik mengikuti aturan penamaan",
+	"naming.more":      "… %d lagi di log (J) dan ringkasan (A)",
+	"naming.log":       "Topik %s melanggar aturan penamaan %q: %s",
+	"naming.summary":   "Penamaan topik.",
+	"naming.passCount": "%d dari %s lolos aturan penamaan.",
+	"naming.failed":    "Gagal: %s melanggar %s (%s).",
+
+	// --- Client capabilities during a failure ---
+	"capability.title":       "Yang masih berfungsi selama kegagalan:",
+	"capability.none":        "Tidak ada kegagalan untuk disimulasikan.",
+	"capability.selected":    "broker %d mati (dipilih)",
+	"capability.worst":       "broker %d mati (terburuk)",
+	"capability.dcLost":      "%s hilang",
+	"capability.topic":       "topik %d",
+	"capability.topicHeader": "%s (min ISR %d):",
+	"capability.col.failure": "Kegagalan",
+	"capability.col.leader":  "Baca leader",
+	"capability.col.local":   "Baca follower lokal",
+	"capability.failing":     "✗ %d dari %d",
+	"capability.more":        "...dan %s lainnya",
+	"capability.help":        "✓ setiap partisi. ✗ n dari N: partisi yang gagal. acks=1 dan baca leader memerlukan leader, acks=all juga memerlukan min ISR replika in-sync.",
+	"capability.help.local":  " Baca follower lokal (KIP-392) memerlukan replika di setiap DC yang masih berjalan.",
+	"capability.help.select": " Pilih broker untuk menambahkan kegagalannya.",
+
+	// --- Replay ---
+	"replay.start":    "▶ Memutar ulang %s, direkam %s: %s. Tombol apa pun memutar langkah pertama, Ctrl+C untuk keluar.",
+	"replay.step":     "▶ Langkah %d dari %d: menekan %s pada %s. Tombol apa pun memutar langkah berikutnya, Ctrl+C untuk keluar.",
+	"replay.finished": "■ Pemutaran selesai: menekan %s, yang terakhir dari %s. Tombol kembali mengendalikan sesi.",
+
+	// --- Screenshots ---
+	"screenshot.saved":            "✓ Tangkapan layar disimpan ke %s",
+	"screenshot.logSaved":         "Tangkapan layar disimpan ke %s",
+	"screenshot.logFailed":        "Tangkapan layar tidak disimpan: %v",
+	"screenshot.topic":            "topik",
+	"screenshot.topicNamed":       "topik %s",
+	"screenshot.topicOf":          "%s dari %d",
+	"screenshot.scenario":         "%s: %s, %s, RF %d, min ISR %d",
+	"screenshot.health":           "%d/100 (%s)",
+	"screenshot.broker":           "broker %d mati (panel failover)",
+	"screenshot.eachBroker":       "setiap broker mati satu per satu (panel failover)",
+	"screenshot.dcLost":           "%s hilang, observer dipromosikan",
+	"screenshot.defaults":         "kejadian bawaan",
+	"screenshot.isr":              "linimasa ISR: %s",
+	"screenshot.timeline":         "linimasa kejadian: %s",
+	"screenshot.matrix":           "setiap kombinasi DC yang gagal (matriks min ISR)",
+	"screenshot.caption.scenario": "Skenario: %s",
+	"screenshot.caption.taken":    "Diambil: %s",
+	"screenshot.caption.health":   "Kesehatan: %s",
+	"screenshot.caption.failure":  "Kegagalan yang disimulasikan: %s",
+	"screenshot.caption.none":     "tidak ada",
+
+	// --- Topic editor ---
+	"topics.title":          "Ubah Topik:",
+	"topics.col.name":       "Nama",
+	"topics.col.partitions": "Partisi",
+	"topics.col.rf":         "RF",
+	"topics.col.minISR":     "Min ISR",
+	"topics.col.tenant":     "Tenant",
+	"topics.emptyName":      "baris %d: nama topik tidak boleh kosong",
+	"topics.duplicate":      "baris %d: topik %q duplikat",
+	"topics.notPositive":    "baris %d: %s harus berupa angka positif",
+	"topics.row":            "baris %d",
+	"topics.topic":          "topik %s",
+	"topics.help":           "(Tab/Shift+Tab untuk berpindah sel. Atas/Bawah untuk berganti baris. Ctrl+N untuk menambah baris. Ctrl+D untuk menghapus baris. Enter untuk menempatkan semua topik. Esc untuk batal)",
+	// --- Roles and lists ---
+	"role.Leader":   "Leader",
+	"role.Follower": "Follower",
+	"role.Observer": "Observer",
+	"list.and":      "%s dan %s",
+	"list.none":     "tidak ada",
+
+	// --- Accessible summary ---
+	"summary.title":          "Ringkasan penempatan.",
+	"summary.cluster":        "Cluster %s, ID %s, Kafka %s, controller %s.",
+	"summary.racks":          "Rack: %s.",
+	"summary.scenario":       "Skenario %s: %s",
+	"summary.notePartition":  "Catatan pada partisi %d: %s",
+	"summary.noteBroker":     "Catatan pada broker %d: %s",
+	"summary.note":           "Catatan: %s",
+	"summary.theTopic":       "topik",
+	"summary.topic":          "topik %s",
+	"summary.placement":      "Penempatan %s: %s di %s, %s, replication factor %d, minimum replika in-sync %d, strategi %s.",
+	"summary.recommendation": "Rekomendasi: %s",
+	"summary.warning":        "Peringatan: %s %s",
+	"summary.brokers":        "Broker.",
+	"summary.dc":             "Data center %d",
+	"summary.rack":           "Rack %s",
+	"summary.dcBrokers":      "%s memiliki %s: %s.",
+	"summary.broker":         "Broker %d menyimpan %s",
+	"summary.brokerBytes":    " sebesar total %s",
+	"summary.brokerTags":     ", bertag %s",
+	"summary.role":           "%s untuk partisi %s.",
+	"summary.partitions":     "Partisi.",
+	"summary.partition":      "Partisi %d: broker leader %s",
+	"summary.followers":      "; follower di broker %s",
+	"summary.observers":      "; observer di broker %s",
+	"summary.lag":            "; consumer lag %d",
+	"summary.rules":          "Aturan.",
+	"summary.rulePassed":     "Lulus: %s",
+	"summary.ruleFailed":     "Gagal: %s",
+
+	// --- Tutorial ---
+	"tutorial.title":               "Tutorial %d/%d: %s",
+	"tutorial.welcome.title":       "Selamat datang",
+	"tutorial.welcome.text":        "Tutorial ini menelusuri sebuah cluster Kafka kecil: 3 broker dan satu topik dengan 3 partisi, replication factor 3 dan min ISR 2. Setiap halaman menjelaskan satu konsep pada cluster itu, dan halaman terakhir menyimulasikan kegagalan broker.",
+	"tutorial.brokers.title":       "Broker",
+	"tutorial.brokers.text":        "Di bawah ini ada tiga broker, masing-masing mencantumkan replika partisi yang disimpannya.",
+	"tutorial.leaders.title":       "Partisi dan leader",
+	"tutorial.leaders.text":        "Setiap partisi memiliki tepat satu leader, ditampilkan dengan warna hijau. Leader disebar sehingga setiap broker memimpin satu partisi dan berbagi beban tulis.",
+	"tutorial.replication.title":   "Replication factor dan follower",
+	"tutorial.replication.text":    "Dengan RF 3 pada 3 broker, setiap broker menyimpan satu replika dari setiap partisi: leader dan dua follower, ditampilkan dengan warna kuning. Tidak ada broker yang menyimpan dua replika dari partisi yang sama.",
+	"tutorial.isr.title":           "Replika in-sync dan min ISR",
+	"tutorial.isr.text":            "Ketiga replika setiap partisi in-sync, satu lebih banyak dari yang dibutuhkan min ISR 2, sehingga cluster punya cadangan satu kegagalan broker untuk penulisan acks=all.",
+	"tutorial.failure.title":       "Sebuah broker gagal",
+	"tutorial.failure.text":        "Broker pertama gagal. Controller menyadarinya setelah sesinya habis waktu dan memilih follower in-sync sebagai leader partisi yang dipimpinnya. Tersisa dua replika in-sync, yang masih memenuhi min ISR 2: producer dan consumer berlanjut setelah jeda singkat.",
+	"tutorial.secondFailure.title": "Broker kedua gagal",
+	"tutorial.secondFailure.text":  "Sekarang broker kedua gagal. Setiap partisi masih memiliki leader di broker terakhir, sehingga consumer tetap membaca dan tidak ada data yang hilang. Namun hanya satu replika yang in-sync, di bawah min ISR 2: producer acks=all mendapat error NotEnoughReplicas sampai sebuah broker kembali.",
+	"tutorial.next.title":          "Langkah berikutnya",
+	"tutorial.next.text":           "Tekan Enter untuk memodelkan cluster Anda sendiri. Di layar penempatan, F menyimulasikan kegagalan setiap broker dan S membandingkan strategi. Multi-Region Cluster menyebar replika ke beberapa data center dan dapat menambahkan observer.",
+	"tutorial.help":                "(→/Enter berikutnya. ← sebelumnya. Esc untuk meninggalkan tutorial. Ctrl+C untuk keluar)",
+	"tutorial.helpLast":            "(Enter untuk memodelkan cluster Anda sendiri. ← sebelumnya. Ctrl+C untuk keluar)",
+	"tutorial.offline":             "offline, tidak ada replika tersisa",
+	"tutorial.leaderMoves":         "leader pindah dari broker %d ke %d (setelah ~%s)",
+	"tutorial.leaderStays":         "broker %d tetap leader",
+	"tutorial.inSync":              "%d in-sync, penulisan acks=all berhasil",
+	"tutorial.underMinISR":         "%d in-sync < min ISR %d, penulisan acks=all ditolak",
+	"tutorial.dc":                  "Data Center %d:",
+	"tutorial.broker":              "Broker %d:",
+	"tutorial.failed":              "✗ gagal",
+
+	// --- Quiz ---
+	"quiz.title":          "Kuis: pertanyaan %d (skor %d/%d)",
+	"quiz.single":         "Cluster tunggal",
+	"quiz.mrc":            "MRC dengan %d DC",
+	"quiz.cluster":        "%s, %s, RF %d, min ISR %d",
+	"quiz.dc":             "DC %d",
+	"quiz.broker":         "broker %d",
+	"quiz.offline.one":    "Partisi mana yang menjadi tidak tersedia (tidak ada replika in-sync tersisa) jika %s gagal?",
+	"quiz.offline.other":  "Partisi mana yang menjadi tidak tersedia (tidak ada replika in-sync tersisa) jika %s gagal?",
+	"quiz.noWrites.one":   "Partisi mana yang menolak penulisan acks=all (min ISR %d) jika %s gagal?",
+	"quiz.noWrites.other": "Partisi mana yang menolak penulisan acks=all (min ISR %d) jika %s gagal?",
+	"quiz.none":           "kosong",
+	"quiz.placeholder":    "mis. p1 p3, atau kosong",
+	"quiz.notPartition":   "%q bukan partisi; masukkan partisi seperti p1 p3, atau kosong",
+	"quiz.correct":        "✓ Benar: %s",
+	"quiz.wrong":          "✗ Jawabannya: %s",
+	"quiz.observers":      "Hanya leader dan follower yang in-sync; observer tidak mengambil alih tanpa promosi.",
+	"quiz.help":           "(Tekan Enter untuk menjawab. Esc untuk kembali. Ctrl+C untuk keluar)",
+	"quiz.helpNext":       "(Tekan Enter untuk pertanyaan berikutnya. Esc untuk kembali. Ctrl+C untuk keluar)",
+
+	// --- Health and trend ---
+	"health.line":                   "Kesehatan: %d/100 (%s)",
+	"health.title":                  "Skor kesehatan %d/100:",
+	"health.weight":                 "(bobot %d%%)",
+	"health.name.balance":           "keseimbangan",
+	"health.name.failure tolerance": "toleransi kegagalan",
+	"health.name.constraints":       "batasan",
+	"health.metric.replicas":        "replika",
+	"health.metric.leaders":         "leader",
+	"health.metric.disk":            "disk",
+	"health.balance":                "ketidakseimbangan %s",
+	"health.tolerance":              "%d dari %d partisi bertahan saat satu broker mana pun gagal",
+	"health.constraints":            "%d dari %d partisi melanggar anti-affinity rack/DC",
+	"rules.title":                   "Aturan (%d/%d lolos):",
+	"rules.breached":                "✗ %s dilanggar: %s",
+	"trend.title":                   "Tren untuk %s:",
+	"trend.none":                    "Belum ada eksekusi sebelumnya pada cluster ini; setiap keluar menyimpan snapshot.",
+	"trend.health":                  "kesehatan",
+	"trend.replicaImbalance":        "ketidakseimbangan replika",
+	"trend.leaderImbalance":         "ketidakseimbangan leader",
+	"trend.saved":                   "Disimpan",
+	"trend.strategy":                "Strategi",
+	"trend.replicas":                "Replika",
+	"trend.leaders":                 "Leader",
+	"trend.now":                     "sekarang",
+	"trend.footer":                  "%s, ketidakseimbangan adalah stddev/rata-rata per broker (0 berarti merata)",
+
+	// --- Placement analysis ---
+	"placement.broker":          "Broker %d:",
+	"placement.empty":           "(kosong)",
+	"placement.kubernetes":      "Sebaran topologi Kubernetes (Strimzi) untuk tata letak ini:",
+	"column.broker":             "Broker",
+	"column.leaders":            "Leader",
+	"lag.unknownGroup":          "grup tidak dikenal",
+	"lag.noData":                "Lag consumer (%s): tidak ada data untuk topik ini",
+	"lag.summary":               "Lag consumer (%s): total %s, maks %s",
+	"lag.maxOn":                 " di p%d",
+	"lag.hotStuck":              ", %d panas, %d macet",
+	"network.title":             "Perkiraan beban jaringan per broker:",
+	"network.none":              "Tidak ada data throughput (tambahkan kolom bytes_in/bytes_out ke --weights).",
+	"network.current":           "saat ini",
+	"network.random":            "random",
+	"network.legend":            "sebelum = strategi %s, sesudah = strategi %s",
+	"network.in":                "Masuk (sebelum → sesudah)",
+	"network.out":               "Keluar (sebelum → sesudah)",
+	"network.busiest":           "Egress broker tersibuk: %s → %s",
+	"comparison.title":          "Perbandingan strategi (0 = terpenuhi, makin kecil makin baik):",
+	"comparison.calculating":    "Menghitung...",
+	"comparison.scorer":         "Penilai",
+	"comparison.current":        "* strategi saat ini",
+	"failover.title":            "Perkiraan kegagalan broker (session timeout %s, %s per pemilihan):",
+	"failover.noBrokers":        "Tidak ada broker.",
+	"failover.leads":            "Memimpin",
+	"failover.reelected":        "Dipilih ulang",
+	"failover.offline":          "Offline",
+	"failover.leaderless":       "Tanpa leader hingga",
+	"failover.controller":       " (termasuk failover controller %s)",
+	"failover.more":             "... dan %d broker lainnya",
+	"failover.worst.quorum":     "Kasus terburuk: kehilangan broker %d berarti kehilangan quorum controller, sehingga %s yang dipimpinnya tetap offline sampai mayoritas controller kembali",
+	"failover.worst.offline":    "Kasus terburuk: kehilangan broker %d membuat %s tanpa replika in-sync sampai broker itu kembali",
+	"failover.worst.leaderless": "Kasus terburuk: kehilangan broker %d membuat partisi tanpa leader hingga %s",
+	"failover.noLeaders":        "Tidak ada broker yang memimpin partisi.",
+	"failover.untilQuorum":      "sampai quorum kembali",
+	"failover.untilBroker":      "sampai broker kembali",
+	"dcLoss.title":              "Kehilangan data center (lag observer %s, promosi observer %s):",
+	"dcLoss.design":             "Desain",
+	"dcLoss.lostDC":             "DC hilang",
+	"dcLoss.affected":           "Terdampak",
+	"dcLoss.promoted":           "Dipromosi",
+	"dcLoss.lost":               ", %s hilang",
+	"dcLoss.note":               "Sync stretch menghitung observer sebagai follower in-sync; async + observer memakai peran yang ditempatkan. Kehilangan DC controller aktif menambah failover controller.",
+	"dcLoss.hint":               "(D untuk menampilkan perintah promosi observer saat kehilangan data center)",
+	"dcLoss.commands":           "Perintah promosi observer saat kehilangan data center %d (D untuk berikutnya):",
+	"dcLoss.noPromotion":        "Tidak ada observer yang perlu dipromosikan: setiap partisi yang dipimpin di sana failover ke replika in-sync atau tidak punya replika tersisa.",
+
+	// --- Leader rebalance and re-replication ---
+	"rebalance.settings":      "dicek setiap %s, di atas %d%%",
+	"rebalance.title":         "Rebalance leader setelah broker %d gagal dan kembali setelah %s (%s):",
+	"rebalance.notPreferred":  "Broker %d bukan preferred leader partisi mana pun.",
+	"rebalance.fenced":        "di-fence: %s pindah ke replika lain, broker tersibuk memimpin %d",
+	"rebalance.leaderless":    ", %s tanpa leader",
+	"rebalance.restarted":     "di-restart, mengejar sebagai follower",
+	"rebalance.leadsAgain":    "; kembali memimpin %s miliknya",
+	"rebalance.inSync":        "kembali sinkron: %s dipimpin replika non-preferred",
+	"rebalance.checkMoved":    "cek ketidakseimbangan: %d%% > %d%%, %s kembali ke broker %d",
+	"rebalance.checkWithin":   "cek ketidakseimbangan: %d%% masih dalam %d%%, tidak ada yang pindah",
+	"rebalance.stuckDisabled": "Kepemimpinan tidak kembali sendiri dengan auto.leader.rebalance.enable=false; jalankan %s",
+	"rebalance.stuck":         "Kepemimpinan tidak kembali sendiri: ketidakseimbangan masih dalam leader.imbalance.per.broker.percentage=%d; jalankan %s",
+	"rebalance.returned":      "Kepemimpinan kembali ke preferred replica pada t+%s: tidak seimbang selama %s setelah broker %d sinkron (hingga %s, tergantung kapan pengecekan berjalan)",
+	"rebalance.noneMoved":     "Tidak ada kepemimpinan yang pindah, jadi tidak ada yang perlu kembali.",
+	"recovery.rate.broker":    "kapasitas jaringannya",
+	"recovery.rate.default":   "kapasitas jaringan",
+	"recovery.rate.assumed":   "asumsi link 1Gbit/s",
+	"recovery.title":          "Replikasi ulang replika broker %d (mengambil dengan %s, %s):",
+	"recovery.returns":        "Kembali setelah %s",
+	"recovery.rebuilt":        "Dibangun ulang di disk kosong",
+	"recovery.unknown":        "tidak diketahui tanpa %s partisi (--weights)",
+	"recovery.done":           "%s untuk diambil, sinkron setelah %s",
+	"recovery.never":          "%s untuk diambil, tidak pernah sinkron: beberapa partisi menerima data lebih cepat daripada yang boleh diambil broker",
+	"recovery.leftOut":        " (%s tanpa data beban tidak dihitung)",
+	"recovery.more":           "… %d lainnya",
+	"recovery.neverDone":      "tidak pernah",
+	"recovery.from":           "dari broker %-3d",
+	"recovery.catchUp":        "Linimasa rebalance leader mengasumsikan replika sinkron dalam %s; data beban menunjukkan %s (atur --catch-up-time)",
+	"recovery.help":           "Setiap replika mengambil dari leader partisi sambil terus menerima tulisan; replika yang masih tertinggal berbagi laju broker, dan leader juga tidak mengirim lebih cepat dari itu. Batasi dengan leader/follower.replication.throttled.rate untuk melindungi lalu lintas klien.",
+
+	// --- Consumer fetch locality ---
+	"locality.title":            "Lokalitas fetch consumer (%s: %s):",
+	"locality.unknownDC":        "⚠ Tidak ada DC bernama %s; consumer di sana diabaikan",
+	"locality.none":             "Tidak ada consumer di DC mana pun pada penempatan ini.",
+	"locality.leaderOnly":       "Hanya leader",
+	"locality.followerFetching": "Follower fetching KIP-392",
+	"locality.scenario":         "Skenario",
+	"locality.local":            "Lokal",
+	"locality.crossDC":          "Lintas DC",
+	"locality.unavailable":      "Tidak tersedia",
+	"locality.allUp":            "semua broker hidup",
+	"locality.dcLost":           "%s hilang",
+	"locality.brokerDown":       "broker %d mati",
+	"locality.worst":            " (terburuk)",
+	"locality.stopped":          " (%s berhenti)",
+	"locality.dc":               "%s (%s): %s lokal dari leader, %s dengan KIP-392",
+	"locality.fromObservers":    " (%s dari observer)",
+	"locality.noReplica":        "; %s partisinya tidak punya replika di sini",
+	"locality.traffic":          "Lalu lintas fetch lintas DC: %s hanya dari leader, %s dengan KIP-392",
+	"locality.observerReads":    "Baca dari observer: %s fetch tetap lokal hanya berkat observer",
+	"locality.saving":           ", menghemat %s lintas DC",
+	"locality.replication":      "Replikasi lintas DC: %s ke follower (dalam acks), %s ke observer (async, di luar acks)",
+	"locality.help":             "KIP-392 memerlukan replica.selector.class=org.apache.kafka.common.replica.RackAwareReplicaSelector pada broker dan client.rack diatur ke rack DC pada consumer. Observer juga melayani follower fetch, tanpa dihitung dalam acks. Fetch dibobot dengan bytes out partisi bila diketahui; atur --consumer-dcs untuk menempatkan consumer.",
+
+	// --- Event timeline ---
+	"timeline.title":           "Linimasa kejadian (%s; %s):",
+	"timeline.noBase":          "tidak ada yang di-reassign tanpa penempatan asal",
+	"timeline.base":            "reassign dari %s ke penempatan yang ditampilkan",
+	"timeline.columns":         "satu kolom per %s, nilai terburuk di dalamnya",
+	"timeline.row.down":        "Broker mati",
+	"timeline.row.leaderless":  "Tanpa leader",
+	"timeline.row.underMinISR": "< min ISR",
+	"timeline.row.moving":      "Berpindah",
+	"timeline.more":            "… %d entri lainnya",
+	"timeline.writesOK":        "tulisan acks=all tidak pernah gagal",
+	"timeline.writesFail":      "tulisan acks=all gagal pada sebagian partisi selama total %s",
+	"timeline.done":            "; reassignment selesai pada t+%s (%s)",
+	"timeline.help":            "Atur skrip dengan --timeline (mis. fail:1@0s,recover:1@5m,reassign@10m); linimasa ISR memutar ulang gangguannya. Gelombang menyalin dengan --reassign-throttle bila ukuran partisi diketahui, selain itu masing-masing memakan --wave-time.",
+	"timeline.fails":           "gagal",
+	"timeline.recovers":        "pulih",
+	"timeline.noBroker":        "tidak ada broker %d, %s diabaikan",
+	"timeline.broker":          "broker %d %s",
+	"timeline.noDC":            "tidak ada DC %d, %s diabaikan",
+	"timeline.dc":              "%s %s (%s)",
+	"timeline.reassignNothing": "reassignment dimulai: tidak ada yang dipindah",
+	"timeline.reassignStarts":  "reassignment dimulai: %s dalam %s",
+	"timeline.quorumBack":      "quorum controller kembali punya mayoritas",
+	"timeline.quorumLost":      "quorum controller kehilangan mayoritas: tidak ada fencing atau pemilihan sampai kembali",
+	"timeline.controllerDown":  "controller aktif (node %d) mati; para voter memilih yang lain dalam %s",
+	"timeline.fenced":          "broker %d di-fence setelah session timeout %s",
+	"timeline.moved":           "%s pindah ke replika in-sync lain",
+	"timeline.offline":         "%s offline: tidak ada replika in-sync lain",
+	"timeline.leadsAgain":      "broker %d kembali memimpin %s miliknya",
+	"timeline.caughtUp":        "broker %d sudah sinkron, kembali ke ISR %s",
+	"timeline.wavePauses":      "gelombang %d berhenti sejenak: broker %d mati",
+	"timeline.waveWaits":       "gelombang %d menunggu: broker %d mati",
+	"timeline.waveResumes":     "gelombang %d berlanjut",
+	"timeline.waveStarts":      "gelombang %d dari %d dimulai: %s, %s (sekitar %s)",
+	"timeline.waveDone":        "gelombang %d dari %d selesai",
+	"timeline.reassignDone":    "reassignment selesai setelah %s",
+	"timeline.noWrites":        "tulisan acks=all gagal pada %s (%d tanpa leader, %d di bawah min ISR %d)",
+	"timeline.writesBack":      "setiap partisi kembali menerima tulisan acks=all",
+	"timeline.reassignNotDone": "reassignment belum selesai: tersisa %s dari %d",
+
+	// --- Diff and strategy parameters ---
+	"diff.random":                     "strategi random",
+	"diff.legend":                     "Keterangan (perubahan terhadap %s):",
+	"diff.added":                      "+pX ditambah (%d)",
+	"diff.removed":                    "-pX dihapus (%d)",
+	"diff.changed":                    "~pX berganti peran (%d)",
+	"diff.nothingMoves":               "Migrasi: tidak ada yang berpindah",
+	"diff.perBroker":                  "%s per broker",
+	"diff.perDC":                      "%s per DC",
+	"diff.migration":                  "Migrasi: %s, %s dalam %s",
+	"diff.atMost":                     " (paling banyak %s sekaligus)",
+	"diff.wavesFlag":                  "; --waves menulis satu JSON reassignment per gelombang",
+	"strategy.title":                  "Strategi Penempatan:",
+	"strategy.selector":               "Strategi:",
+	"strategy.param.rackStrictness":   "Ketatnya rack (1 = sebar replika MRC ke semua DC dahulu, 0 = abaikan DC)",
+	"strategy.param.leaderSpread":     "Sebaran leader (1 = seimbangkan lalu lintas leader, 0 = biarkan leader seperti ditempatkan)",
+	"strategy.param.goals":            "Goal (dipisah koma, prioritas tertinggi dahulu; kosong = semua)",
+	"strategy.param.maxMoves":         "Maks perpindahan (0 = satu per replika)",
+	"strategy.param.maxMoves.invalid": "maks perpindahan harus 0 atau bilangan positif",
+	"strategy.param.notDigit":         "%q harus 0 atau 1",
+	"strategy.previous":               "penempatan %s sebelumnya",
+	"strategy.noParams":               "Strategi ini tidak punya parameter.",
+	"strategy.help":                   "(Kiri/Kanan untuk mengganti strategi. Tab/Shift+Tab atau Atas/Bawah untuk berpindah. Ctrl+R untuk nilai bawaan strategi. Enter untuk menempatkan ulang. Esc untuk batal)",
+
+	// --- Color modes ---
+	"colorMode.dc":       "data center",
+	"colorMode.replicas": "kepadatan replika",
+	"colorMode.leaders":  "kepadatan leader",
+	"colorMode.diff":     "perubahan",
+	"colorMode.role":     "peran",
+
+	// --- ISR timeline ---
+	"isr.slow":           "broker %d lambat t+%s…%s",
+	"isr.down":           "broker %d mati t+%s…%s",
+	"isr.title":          "Linimasa ISR (%s; replica.lag.time.max.ms=%d, min ISR %d):",
+	"isr.none":           "Tidak ada partisi dengan leader untuk diikuti.",
+	"isr.columns":        "satu kolom per %s, | setiap %s",
+	"isr.morePartitions": "… %d partisi lainnya (%d dari %d terdampak)",
+	"isr.moreEvents":     "… %d kejadian lainnya",
+	"isr.brokerEvent":    "broker %d %s (%s)",
+	"isr.help":           "█ sinkron, ▒ di ISR tetapi tertinggal, · di luar ISR, ✗ broker mati; L adalah preferred leader. Follower keluar dari ISR setelah %s tanpa mengejar; atur --replica-lag-time-max dan --isr-events.",
+	"isr.quorumBack":     "quorum metadata kembali: perubahan ISR dan pemilihan yang tertunda di-commit",
+	"isr.quorumLost":     "quorum metadata hilang (%s tidak bisa ditulis): ISR dan leader membeku",
+	"isr.fenced":         "di-fence, keluar dari ISR",
+	"isr.elected":        "terpilih sebagai leader",
+	"isr.offline":        "di-fence; tidak ada replika in-sync lain, partisi offline",
+	"isr.leadsAgain":     "kembali, memimpin lagi",
+	"isr.leaves":         "keluar dari ISR: tidak sinkron lebih dari %s",
+	"isr.rejoins":        "sudah sinkron, kembali ke ISR",
+	"isr.noLeader":       "tanpa leader: setiap tulisan gagal",
+	"isr.underMinISR":    "ISR %d < min ISR %d: tulisan acks=all gagal (NotEnoughReplicas)",
+	"isr.writesBack":     "ISR kembali ke %d ≥ min ISR %d: tulisan berhasil lagi",
+
+	// --- Replication, RF change, tenants and MM2 ---
+	"replication.title":           "Partisi under-replicated dan offline (live):",
+	"replication.ok":              "✓ Setiap partisi punya leader dan semua replikanya sinkron",
+	"replication.counts":          "%d offline, %d under-replicated",
+	"replication.underReplicated": "under-replicated",
+	"replication.offline":         "offline",
+	"replication.partition":       "p%d %s: ISR %s dari replika %s",
+	"replication.outOfSync":       ", tidak sinkron: %s",
+	"replication.more":            "...dan %d lainnya",
+	"replication.help":            "Broker dengan replika yang tidak sinkron diberi bingkai ganda merah.",
+	"rf.error":                    "Perubahan RF: %v",
+	"rf.title":                    "Bagaimana jika: RF %d → %d",
+	"rf.help":                     " (+/- untuk mengubahnya lagi; ekspor dengan --export-on-exit --export-format reassignment atau --waves)",
+	"rf.added":                    "Replika baru: %s",
+	"rf.copy":                     "; %s untuk disalin",
+	"rf.copyUnknown":              "; data yang disalin tidak diketahui tanpa ukuran partisi (--weights)",
+	"rf.removed":                  "Replika dihapus: %s",
+	"rf.tolerance":                "Kegagalan broker yang bertahan dengan tulisan acks=all: %d → %d; tanpa kehilangan data: %d → %d",
+	"rf.dcLoss":                   "; kehilangan DC tetap menyimpan data setiap partisi: %s → %s",
+	"rf.yes":                      "ya",
+	"rf.no":                       "tidak",
+	"tenants.none":                "(tanpa tenant)",
+	"tenants.title":               "Jejak tenant (%s pada %s):",
+	"tenants.tenant":              "Tenant",
+	"tenants.topics":              "Topik",
+	"tenants.partitions":          "Partisi",
+	"tenants.size":                "Ukuran",
+	"tenants.replicasLeaders":     "Replika/leader",
+	"tenants.isolated":            "✓ %s terisolasi pada %s miliknya sendiri",
+	"tenants.shares":              "%s berbagi %d dari %s miliknya dengan tenant lain: %s",
+	"tenants.help":                "Replika/leader per DC dan broker; porsi dihitung dari seluruh cluster. Atur tenant di CSV topik atau editor topik (T).",
+	"mirror.status":               "✗ MM2: %s dengan jumlah partisi berbeda dari sumbernya (X untuk pemetaan topik)",
+	"mirror.title":                "Pemetaan topik MirrorMaker 2 (%s, pemisah %q):",
+	"mirror.mirrored":             ": %s di-mirror",
+	"mirror.loop":                 "⚠ %s -> %s juga aktif: IdentityReplicationPolicy tidak bisa membedakan topik hasil mirror, sehingga record berputar di antara cluster",
+	"mirror.source":               "Topik sumber",
+	"mirror.target":               "Topik tujuan",
+	"mirror.more":                 "... dan %d lainnya",
+	"mirror.unknown":              "tidak diketahui",
+	"mirror.present":              "ada",
+	"mirror.missing":              "tidak ada",
+	"mirror.internal":             "%s di %s (%s): %s",
+	"mirror.partitions.unknown":   "%d -> ? (topik tujuan tidak dimuat)",
+	"mirror.partitions.missing":   "%d -> belum dibuat",
+	"mirror.partitions.fewer":     "%d -> %d: lebih sedikit di tujuan sampai MM2 memperbarui topik",
+	"mirror.partitions.more":      "%d -> %d: partisi tujuan tambahan tetap kosong; MM2 tidak pernah menghapusnya",
+	"mirror.purpose.offsetSyncs":  "memetakan offset sumber ke tujuan, ditulis oleh source connector",
+	"mirror.purpose.checkpoints":  "offset consumer group yang diterjemahkan, untuk memindahkan consumer saat failover",
+	"mirror.purpose.heartbeats":   "heartbeat hasil mirror, menunjukkan flow masih hidup",
+
+	// --- Broker details, notes and storage ---
+	"broker.none":          "Tidak ada broker yang dipilih.",
+	"broker.tags":          "Tag: %s",
+	"broker.partition":     "Partisi",
+	"broker.topic":         "Topik",
+	"broker.role":          "Peran",
+	"broker.preferred":     "Preferred leader",
+	"broker.help":          "(Atas/Bawah atau PgUp/PgDn untuk menggulir. Esc atau Enter untuk menutup. Ctrl+C untuk keluar)",
+	"broker.rows":          "Baris %d-%d dari %d. %s",
+	"layout.rows":          "Baris broker %d-%d dari %d (PgUp/PgDn untuk menggulir)",
+	"histogram.partitions": "Partisi per broker (%s):",
+	"histogram.leaders":    "Leader per broker:",
+	"note.placeholder":     "catatan untuk reviewer, atau p3: ... untuk satu partisi",
+	"note.title":           "Catatan",
+	"note.broker":          "Catatan untuk broker %d",
+	"note.help":            "(Enter untuk menambah catatan. Esc untuk membuangnya)",
+	"storage.others":       "%d topik lainnya",
+	"storage.title":        "Disk per topik: %s %s",
+	"storage.ratio":        " (%.1f× rata-rata brokernya)",
+	"storage.hot":          "⚠ Broker panas: %.0f%% di atas rata-rata broker (%s); %s menambah paling banyak, %s di atas porsi rata-ratanya",
+	"tags.filter":          "Tag %s: %s, %s (/ untuk tag berikutnya)",
+	"tags.or":              " atau ",
+	"tags.avoided":         "✓ Leader: tidak ada partisi yang dipimpin broker %s",
+	"tags.notAvoided":      "✗ Leader: %s dipimpin broker %s, tanpa replika in-sync lain (%s)",
+	"form.beforeEdit":      "penempatan sebelum diedit",
+	"form.notNumber":       "harus berupa angka",
+	"library.title":        "Pustaka skenario",
+	"library.help":         "(↑/↓ untuk memilih. Enter untuk menempatkannya. Esc untuk kembali. Ctrl+C untuk keluar)",
+	"snapshots.base":       "snapshot %s",
+
+	// --- Glossary ---
+	"glossary.broker.term":             "Broker",
+	"glossary.broker.text":             "Server Kafka. Setiap broker menyimpan replika dari banyak partisi; makin banyak broker, makin tersebar bebannya dan makin banyak replika yang dapat ditampung cluster.",
+	"glossary.data center.term":        "Data Center",
+	"glossary.data center.text":        "Domain kegagalan seperti situs, region atau availability zone, diatur sebagai broker.rack. MRC (Multi-Region Cluster) membentangkan satu cluster ke beberapa DC agar tetap hidup saat kehilangan satu DC.",
+	"glossary.partition.term":          "Partisi",
+	"glossary.partition.text":          "Topik dibagi menjadi partisi, satuan paralelisme: masing-masing punya satu leader yang menerima tulisan, dan consumer dalam satu grup membaca partisi yang berbeda. Kelipatan jumlah broker menyebarkan leader secara merata.",
+	"glossary.replication factor.term": "Replication Factor",
+	"glossary.replication factor.text": "Jumlah salinan (replika) tiap partisi, di broker yang berbeda. RF 3 bertahan dari kegagalan dua broker tanpa kehilangan data; pada MRC, RF menghitung leader, follower dan observer.",
+	"glossary.min isr.term":            "Min ISR",
+	"glossary.min isr.text":            "min.insync.replicas: berapa replika in-sync (termasuk leader) yang harus menerima tulisan sebelum producer dengan acks=all mendapat konfirmasi sukses. Dengan RF 3 dan min ISR 2 satu broker boleh gagal tanpa menghentikan tulisan; min ISR = RF menghentikan tulisan pada setiap kegagalan.",
+	"glossary.leader.term":             "Leader",
+	"glossary.leader.text":             "Replika partisi yang menangani semua tulisan (dan secara bawaan juga bacaan). Saat broker-nya gagal, follower yang in-sync dipilih menjadi leader.",
+	"glossary.follower.term":           "Follower",
+	"glossary.follower.text":           "Replika yang menyalin leader secara sinkron dan dihitung dalam min ISR, sehingga dapat menggantikan leader tanpa kehilangan data.",
+	"glossary.observer.term":           "Observer",
+	"glossary.observer.text":           "Replika MRC yang menyalin leader secara asinkron dan tidak dihitung dalam min ISR. Di visualisasi ini MRC punya min ISR - 1 follower dan RF - min ISR observer; observer harus dipromosikan untuk mengambil alih setelah kehilangan DC.",
+	"glossary.isr.term":                "ISR",
+	"glossary.isr.text":                "Replika in-sync: leader ditambah follower yang sudah mengejar ketertinggalannya. Hanya anggota ISR yang dapat dipilih menjadi leader dalam pemilihan bersih (clean election).",
+}
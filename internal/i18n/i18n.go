@@ -0,0 +1,136 @@
+// Package i18n holds the strings the UI shows in a message catalog per
+// language, so the visualizer can teach teams in their own language.
+//
+// Messages are looked up by key, e.g. "menu.title", and formatted with
+// fmt.Sprintf. English is the source catalog: a key missing from another
+// language falls back to its English message, so a partial translation
+// still shows every screen.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Lang is a language, named by its ISO 639-1 code.
+type Lang string
+
+const (
+	English    Lang = "en"
+	Indonesian Lang = "id"
+)
+
+// catalogs maps each language to its messages by key.
+var catalogs = map[Lang]map[string]string{
+	English:    english,
+	Indonesian: indonesian,
+}
+
+// current is the language T translates to. The UI sets it once at start.
+var current = English
+
+// Languages returns every language with a catalog, English first.
+func Languages() []Lang {
+	result := []Lang{English}
+	for lang := range catalogs {
+		if lang != English {
+			result = append(result, lang)
+		}
+	}
+	sort.Slice(result[1:], func(i, j int) bool { return result[1+i] < result[1+j] })
+	return result
+}
+
+// Parse returns the language named by a code such as "id", or a locale
+// such as "id_ID.UTF-8".
+func Parse(name string) (Lang, error) {
+	code := strings.ToLower(name)
+	if i := strings.IndexAny(code, "_-."); i >= 0 {
+		code = code[:i]
+	}
+	if _, ok := catalogs[Lang(code)]; ok {
+		return Lang(code), nil
+	}
+	names := make([]string, 0, len(catalogs))
+	for _, lang := range Languages() {
+		names = append(names, string(lang))
+	}
+	return "", fmt.Errorf("unknown language %q (available: %s)", name, strings.Join(names, ", "))
+}
+
+// FromEnv returns the language of the user's locale (LC_ALL, LC_MESSAGES,
+// then LANG), English when it has no catalog.
+func FromEnv() Lang {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			if lang, err := Parse(value); err == nil {
+				return lang
+			}
+			return English // The first locale set wins, as in libc
+		}
+	}
+	return English
+}
+
+// SetLanguage switches the language of every message looked up afterwards.
+func SetLanguage(lang Lang) {
+	if _, ok := catalogs[lang]; ok {
+		current = lang
+	}
+}
+
+// Current returns the language messages are shown in.
+func Current() Lang {
+	return current
+}
+
+// T returns the message for key in the current language, formatted with
+// args. A key without any message returns the key itself, so a typo shows
+// on screen instead of an empty line.
+func T(key string, args ...any) string {
+	msg, ok := catalogs[current][key]
+	if !ok {
+		if msg, ok = english[key]; !ok {
+			return key
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Plural returns a count with its noun in the singular or plural of the
+// current language, from the keys "noun.<noun>.one" and "noun.<noun>.other",
+// e.g. Plural(3, "partition") is "3 partitions".
+func Plural(n int, noun string) string {
+	if n == 1 {
+		return T("noun."+noun+".one", n)
+	}
+	return T("noun."+noun+".other", n)
+}
+
+// Translated returns the message for key in the current language only, for
+// text whose English original lives with its data, like the glossary.
+func Translated(key string) (string, bool) {
+	if current == English {
+		return "", false
+	}
+	msg, ok := catalogs[current][key]
+	return msg, ok
+}
+
+// Missing returns the keys of the English catalog that lang does not
+// translate, sorted, for translators to fill in.
+func Missing(lang Lang) []string {
+	var keys []string
+	for key := range english {
+		if _, ok := catalogs[lang][key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
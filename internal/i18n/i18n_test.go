@@ -0,0 +1,146 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// verb matches a fmt verb, such as %d or %.1f.
+var verb = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z]`)
+
+// verbs returns the fmt verbs of msg in order.
+func verbs(msg string) []string {
+	return verb.FindAllString(strings.ReplaceAll(msg, "%%", ""), -1)
+}
+
+func TestCatalogsTranslateEveryKey(t *testing.T) {
+	for _, lang := range Languages() {
+		if missing := Missing(lang); len(missing) > 0 {
+			t.Errorf("%s misses %d keys: %v", lang, len(missing), missing)
+		}
+	}
+}
+
+func TestCatalogsKeepFormatVerbs(t *testing.T) {
+	for _, lang := range Languages() {
+		for key, msg := range catalogs[lang] {
+			en, ok := english[key]
+			if !ok {
+				continue // Data-owned text, looked up with Translated
+			}
+			if got, want := verbs(msg), verbs(en); !slices.Equal(got, want) {
+				t.Errorf("%s %q has verbs %v, English has %v", lang, key, got, want)
+			}
+		}
+	}
+}
+
+func TestTAndPlural(t *testing.T) {
+	defer SetLanguage(Current())
+	SetLanguage(Indonesian)
+	if got, want := Plural(3, "partition"), "3 partisi"; got != want {
+		t.Errorf("Plural = %q, want %q", got, want)
+	}
+	if got, want := T("no.such.key"), "no.such.key"; got != want {
+		t.Errorf("T of a missing key = %q, want %q", got, want)
+	}
+	SetLanguage(English)
+	if got, want := Plural(1, "partition"), "1 partition"; got != want {
+		t.Errorf("Plural = %q, want %q", got, want)
+	}
+}
+
+// prose matches a literal of at least two words, the text a view shows.
+var prose = regexp.MustCompile(`[A-Za-z]{2,}[,:;]? +[A-Za-z(]{2,}`)
+
+// untranslated matches a comment marking text that stays the same in every
+// language, like a history key.
+var untranslated = regexp.MustCompile(`(?i)not\s+translated`)
+
+// TestViewsUseCatalog fails on a string literal of the tui package that
+// reads like prose instead of a catalog key, or on a key missing from the
+// English catalog. Text that stays the same in
+// every language is marked with a "not translated" comment on its line or
+// the line above, or in the doc comment of its declaration.
+func TestViewsUseCatalog(t *testing.T) {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join("..", "tui", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		exempt := make(map[int]bool)
+		for _, group := range file.Comments {
+			if untranslated.MatchString(group.Text()) {
+				line := fset.Position(group.End()).Line
+				exempt[line], exempt[line+1] = true, true
+			}
+		}
+		for _, decl := range file.Decls {
+			// A declaration documented as not translated is exempt as a whole
+			var doc *ast.CommentGroup
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				doc = d.Doc
+			case *ast.FuncDecl:
+				doc = d.Doc
+			}
+			if doc != nil && untranslated.MatchString(doc.Text()) {
+				for line := fset.Position(decl.Pos()).Line; line <= fset.Position(decl.End()).Line; line++ {
+					exempt[line] = true
+				}
+			}
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ImportSpec:
+				return false
+			case *ast.CallExpr:
+				sel, ok := n.Fun.(*ast.SelectorExpr)
+				if !ok || len(n.Args) == 0 {
+					return true
+				}
+				if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "i18n" {
+					return true
+				}
+				switch sel.Sel.Name {
+				case "Plural":
+					// The nouns of Plural are catalog keys
+					return false
+				case "T":
+					if lit, ok := n.Args[0].(*ast.BasicLit); ok {
+						key, _ := strconv.Unquote(lit.Value)
+						if _, ok := english[key]; !ok {
+							pos := fset.Position(lit.Pos())
+							t.Errorf("%s:%d: key %q is missing from the English catalog", filepath.Base(pos.Filename), pos.Line, key)
+						}
+						return false
+					}
+				}
+			case *ast.BasicLit:
+				if n.Kind != token.STRING {
+					return true
+				}
+				pos := fset.Position(n.Pos())
+				if s, err := strconv.Unquote(n.Value); err == nil && prose.MatchString(s) && !exempt[pos.Line] {
+					t.Errorf("%s:%d: %s is not in the catalog", filepath.Base(pos.Filename), pos.Line, n.Value)
+				}
+			}
+			return true
+		})
+	}
+}
@@ -0,0 +1,344 @@
+// Package kafka reads the brokers, topics and replica assignment of a
+// running Kafka cluster with the franz-go admin client, so the actual
+// placement of a topic can be shown instead of a simulated one. It only
+// reads metadata and topic configurations, over plaintext or TLS listeners,
+// optionally authenticating with SASL.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/assignment"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// DefaultTimeout bounds reading the cluster when the context has no
+// deadline.
+const DefaultTimeout = 10 * time.Second
+
+// SASL mechanisms Options.SASL accepts.
+const (
+	SASLPlain       = "PLAIN"
+	SASLScramSHA256 = "SCRAM-SHA-256"
+	SASLScramSHA512 = "SCRAM-SHA-512"
+)
+
+// Broker is a broker of the cluster as it advertises itself.
+type Broker struct {
+	ID   int
+	Host string
+	Port int
+	Rack string // broker.rack, empty if not set
+}
+
+// Partition is the live assignment of one partition, numbered from 0 as
+// in Kafka.
+type Partition struct {
+	ID       int
+	Leader   int // -1 without a leader
+	Replicas []int
+	Isr      []int
+}
+
+// Topic is a topic of the cluster.
+type Topic struct {
+	Name              string
+	Internal          bool // e.g. __consumer_offsets
+	Partitions        []Partition
+	MinInSyncReplicas int // Only read for the topic given to Fetch, else 0
+}
+
+// Cluster is the metadata of a running cluster.
+type Cluster struct {
+	Bootstrap    string
+	ID           string
+	ControllerID int // -1 if not reported
+	Brokers      []Broker
+	Topics       []Topic // Sorted by name
+}
+
+// Options configure the connection to the cluster.
+type Options struct {
+	// TLS, if not nil, connects to a TLS listener with this configuration.
+	TLS *tls.Config
+	// SASL, if its Mechanism is set, authenticates with these credentials.
+	SASL SASL
+}
+
+// SASL are the credentials of a SASL listener.
+type SASL struct {
+	Mechanism string // SASLPlain, SASLScramSHA256 or SASLScramSHA512
+	User      string
+	Password  string
+}
+
+// mechanism returns the franz-go mechanism of the credentials, nil if no
+// mechanism is set.
+func (s SASL) mechanism() (sasl.Mechanism, error) {
+	switch strings.ToUpper(s.Mechanism) {
+	case "":
+		return nil, nil
+	case SASLPlain:
+		return plain.Auth{User: s.User, Pass: s.Password}.AsMechanism(), nil
+	case SASLScramSHA256:
+		return scram.Auth{User: s.User, Pass: s.Password}.AsSha256Mechanism(), nil
+	case SASLScramSHA512:
+		return scram.Auth{User: s.User, Pass: s.Password}.AsSha512Mechanism(), nil
+	}
+	return nil, fmt.Errorf("unknown SASL mechanism %q (use %s, %s or %s)", s.Mechanism, SASLPlain, SASLScramSHA256, SASLScramSHA512)
+}
+
+// Validate reports options the connection cannot be made with.
+func (o Options) Validate() error {
+	_, err := o.SASL.mechanism()
+	return err
+}
+
+// clientOpts returns the franz-go options for the comma-separated bootstrap
+// servers; a server without a port uses Kafka's default 9092.
+func clientOpts(bootstrap string, opts Options) ([]kgo.Opt, error) {
+	var seeds []string
+	for _, addr := range strings.Split(bootstrap, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			seeds = append(seeds, addr)
+		}
+	}
+	if len(seeds) == 0 {
+		return nil, errors.New("no bootstrap server given")
+	}
+	kopts := []kgo.Opt{
+		kgo.SeedBrokers(seeds...),
+		kgo.ClientID("kafka-viz"),
+		kgo.DialTimeout(DefaultTimeout),
+	}
+	if opts.TLS != nil {
+		kopts = append(kopts, kgo.DialTLSConfig(opts.TLS))
+	}
+	mechanism, err := opts.SASL.mechanism()
+	if err != nil {
+		return nil, err
+	}
+	if mechanism != nil {
+		kopts = append(kopts, kgo.SASL(mechanism))
+	}
+	return kopts, nil
+}
+
+// Fetch reads the brokers and topics of the cluster behind bootstrap, and
+// the min.insync.replicas of topic if it is not "". Internal topics are
+// included. The request versions are negotiated with the brokers.
+func Fetch(ctx context.Context, bootstrap string, topic string, opts Options) (*Cluster, error) {
+	kopts, err := clientOpts(bootstrap, opts)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", bootstrap, err)
+	}
+	client, err := kgo.NewClient(kopts...)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", bootstrap, err)
+	}
+	defer client.Close()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultTimeout)
+		defer cancel()
+	}
+	adm := kadm.NewClient(client)
+
+	metadata, err := adm.Metadata(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading metadata from %s: %w", bootstrap, err)
+	}
+	cluster := clusterOf(metadata)
+	cluster.Bootstrap = bootstrap
+
+	if topic != "" {
+		t := cluster.Topic(topic)
+		if t == nil {
+			return cluster, nil // Reported by PlacementConfig, with the topics there are
+		}
+		configs, err := adm.DescribeTopicConfigs(ctx, topic)
+		if err == nil {
+			t.MinInSyncReplicas, err = minInSyncReplicas(configs, topic)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading the configuration of %s: %w", topic, err)
+		}
+	}
+	return cluster, nil
+}
+
+// clusterOf converts a metadata response. Topics that failed without
+// partitions, e.g. for lack of authorization, are left out.
+func clusterOf(metadata kadm.Metadata) *Cluster {
+	cluster := &Cluster{ID: metadata.Cluster, ControllerID: int(metadata.Controller)}
+	for _, b := range metadata.Brokers {
+		broker := Broker{ID: int(b.NodeID), Host: b.Host, Port: int(b.Port)}
+		if b.Rack != nil {
+			broker.Rack = *b.Rack
+		}
+		cluster.Brokers = append(cluster.Brokers, broker)
+	}
+	for _, td := range metadata.Topics {
+		if td.Err != nil && len(td.Partitions) == 0 {
+			continue // e.g. not authorized to describe it
+		}
+		t := Topic{Name: td.Topic, Internal: td.IsInternal}
+		// A partition error, e.g. LEADER_NOT_AVAILABLE, shows as a missing leader
+		for _, pd := range td.Partitions.Sorted() {
+			t.Partitions = append(t.Partitions, Partition{
+				ID:       int(pd.Partition),
+				Leader:   int(pd.Leader),
+				Replicas: ints(pd.Replicas),
+				Isr:      ints(pd.ISR),
+			})
+		}
+		cluster.Topics = append(cluster.Topics, t)
+	}
+	sort.Slice(cluster.Brokers, func(i, j int) bool { return cluster.Brokers[i].ID < cluster.Brokers[j].ID })
+	sort.Slice(cluster.Topics, func(i, j int) bool { return cluster.Topics[i].Name < cluster.Topics[j].Name })
+	return cluster
+}
+
+// ints converts broker IDs, keeping their order.
+func ints(ids []int32) []int {
+	out := make([]int, len(ids))
+	for i, id := range ids {
+		out[i] = int(id)
+	}
+	return out
+}
+
+// minInSyncReplicas returns the effective min.insync.replicas of topic in
+// a DescribeConfigs result: its own, else the broker default, else 1
+// (Kafka's default).
+func minInSyncReplicas(configs kadm.ResourceConfigs, topic string) (int, error) {
+	rc, err := configs.On(topic, nil)
+	if err != nil {
+		return 0, err
+	}
+	if rc.Err != nil {
+		return 0, rc.Err
+	}
+	for _, c := range rc.Configs {
+		if c.Key == "min.insync.replicas" {
+			if n, err := strconv.Atoi(c.MaybeValue()); err == nil && n > 0 {
+				return n, nil
+			}
+		}
+	}
+	return 1, nil
+}
+
+// Topic returns the named topic, or nil if the cluster has none.
+func (c *Cluster) Topic(name string) *Topic {
+	for i := range c.Topics {
+		if c.Topics[i].Name == name {
+			return &c.Topics[i]
+		}
+	}
+	return nil
+}
+
+// TopicNames returns the names of the topics that are not internal.
+func (c *Cluster) TopicNames() []string {
+	var names []string
+	for _, t := range c.Topics {
+		if !t.Internal {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+// Racks returns the distinct broker.rack values of the brokers, sorted;
+// brokers without one share the rack "".
+func (c *Cluster) Racks() []string {
+	seen := make(map[string]bool)
+	var racks []string
+	for _, b := range c.Brokers {
+		if !seen[b.Rack] {
+			seen[b.Rack] = true
+			racks = append(racks, b.Rack)
+		}
+	}
+	sort.Strings(racks)
+	return racks
+}
+
+// PlacementConfig describes topic on the cluster's brokers, one DC per
+// rack. The replication factor is the most common one of its partitions.
+func (c *Cluster) PlacementConfig(topic string) (config.PlacementConfig, error) {
+	t := c.Topic(topic)
+	if t == nil {
+		names := c.TopicNames()
+		if len(names) > 10 {
+			names = append(names[:10], "...")
+		}
+		return config.PlacementConfig{}, fmt.Errorf("topic %q not found (available: %s)", topic, strings.Join(names, ", "))
+	}
+	if len(t.Partitions) == 0 {
+		return config.PlacementConfig{}, fmt.Errorf("topic %s has no partitions", topic)
+	}
+
+	racks := c.Racks()
+	dcIDs := make(map[string]int, len(racks))
+	dcNames := make(map[int]string, len(racks))
+	for i, rack := range racks {
+		dcIDs[rack] = i + 1 // 1-based DC IDs
+		dcNames[i+1] = rack
+	}
+	brokers := make([]config.BrokerSpec, 0, len(c.Brokers))
+	for _, b := range c.Brokers {
+		brokers = append(brokers, config.BrokerSpec{ID: b.ID, DCID: dcIDs[b.Rack]})
+	}
+
+	counts := make(map[int]int)
+	rf := 0
+	for _, p := range t.Partitions {
+		n := len(p.Replicas)
+		counts[n]++
+		if counts[n] > counts[rf] || (counts[n] == counts[rf] && n > rf) {
+			rf = n
+		}
+	}
+	minISR := max(1, min(t.MinInSyncReplicas, rf))
+	cfg, err := config.NewPlacementConfig(len(t.Partitions), rf, minISR,
+		config.WithBrokerList(brokers, dcNames),
+		config.WithTopicName(t.Name))
+	if err != nil {
+		return config.PlacementConfig{}, fmt.Errorf("topic %s: %w", t.Name, err)
+	}
+	return cfg, nil
+}
+
+// Assignment returns the live assignment of the cluster's topics, as read
+// from `kafka-topics.sh --describe` output.
+func (c *Cluster) Assignment() *assignment.Data {
+	data := &assignment.Data{}
+	for _, t := range c.Topics {
+		for _, p := range t.Partitions {
+			data.Partitions = append(data.Partitions, assignment.Partition{
+				Topic:     t.Name,
+				Partition: p.ID,
+				Leader:    p.Leader,
+				Replicas:  p.Replicas,
+				Isr:       p.Isr,
+			})
+		}
+	}
+	return data
+}
@@ -0,0 +1,107 @@
+package kafka
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// metadata is the metadata of a 3-broker cluster: one broker without
+// broker.rack, a partition without a leader, an internal topic and a topic
+// the client may not describe.
+func metadata() kadm.Metadata {
+	az1, az2 := "az1", "az2"
+	return kadm.Metadata{
+		Controller: 2,
+		Brokers: kadm.BrokerDetails{
+			kgo.BrokerMetadata{NodeID: 2, Host: "kafka-2", Port: 9092, Rack: &az2},
+			kgo.BrokerMetadata{NodeID: 1, Host: "kafka-1", Port: 9093, Rack: &az1},
+			kgo.BrokerMetadata{NodeID: 3, Host: "kafka-3", Port: 9092},
+		},
+		Topics: kadm.TopicDetails{
+			"orders": {Topic: "orders", Partitions: kadm.PartitionDetails{
+				1: {Topic: "orders", Partition: 1, Leader: -1, Replicas: []int32{3, 2}, Err: kerr.LeaderNotAvailable},
+				0: {Topic: "orders", Partition: 0, Leader: 1, Replicas: []int32{1, 3, 2}, ISR: []int32{2, 1}},
+			}},
+			"__consumer_offsets": {Topic: "__consumer_offsets", IsInternal: true, Partitions: kadm.PartitionDetails{
+				0: {Topic: "__consumer_offsets", Partition: 0, Leader: 3, Replicas: []int32{3}, ISR: []int32{3}},
+			}},
+			"secret": {Topic: "secret", Partitions: kadm.PartitionDetails{}, Err: kerr.TopicAuthorizationFailed},
+		},
+	}
+}
+
+func TestClusterOf(t *testing.T) {
+	want := &Cluster{
+		ControllerID: 2,
+		Brokers: []Broker{
+			{ID: 1, Host: "kafka-1", Port: 9093, Rack: "az1"},
+			{ID: 2, Host: "kafka-2", Port: 9092, Rack: "az2"},
+			{ID: 3, Host: "kafka-3", Port: 9092},
+		},
+		Topics: []Topic{
+			{Name: "__consumer_offsets", Internal: true, Partitions: []Partition{{ID: 0, Leader: 3, Replicas: []int{3}, Isr: []int{3}}}},
+			{Name: "orders", Partitions: []Partition{
+				{ID: 0, Leader: 1, Replicas: []int{1, 3, 2}, Isr: []int{2, 1}},
+				{ID: 1, Leader: -1, Replicas: []int{3, 2}, Isr: []int{}},
+			}},
+		},
+	}
+	if got := clusterOf(metadata()); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestMinInSyncReplicas(t *testing.T) {
+	value := func(v string) *string { return &v }
+	configs := func(v *string) kadm.ResourceConfigs {
+		return kadm.ResourceConfigs{{Name: "orders", Configs: []kadm.Config{
+			{Key: "cleanup.policy", Value: value("delete")},
+			{Key: "min.insync.replicas", Value: v},
+		}}}
+	}
+	for _, tc := range []struct {
+		name    string
+		configs kadm.ResourceConfigs
+		want    int
+		wantErr error
+	}{
+		{"set", configs(value("2")), 2, nil},
+		{"null value", configs(nil), 1, nil},
+		{"empty value", configs(value("")), 1, nil},
+		{"not in the result", kadm.ResourceConfigs{{Name: "orders"}}, 1, nil},
+		{"resource error", kadm.ResourceConfigs{{Name: "orders", Err: kerr.TopicAuthorizationFailed}}, 0, kerr.TopicAuthorizationFailed},
+		{"other topic", kadm.ResourceConfigs{{Name: "payments"}}, 0, kerr.UnknownTopicOrPartition},
+	} {
+		got, err := minInSyncReplicas(tc.configs, "orders")
+		switch {
+		case !errors.Is(err, tc.wantErr):
+			t.Errorf("%s: error %v, want %v", tc.name, err, tc.wantErr)
+		case got != tc.want:
+			t.Errorf("%s: %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	for _, tc := range []struct {
+		bootstrap string
+		mechanism string
+		wantErr   bool
+	}{
+		{"kafka-1:9092, kafka-2", "", false},
+		{"kafka-1", SASLPlain, false},
+		{"kafka-1", "scram-sha-512", false},
+		{"kafka-1", "GSSAPI", true},
+		{" , ", "", true},
+	} {
+		_, err := clientOpts(tc.bootstrap, Options{SASL: SASL{Mechanism: tc.mechanism, User: "viz", Password: "secret"}})
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%q with %q: error %v, want error %v", tc.bootstrap, tc.mechanism, err, tc.wantErr)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package lag loads consumer-group lag data so it can be overlaid on the
+// placement view. Two sources are supported: a Burrow consumer lag response
+// (/v3/kafka/<cluster>/consumer/<group>/lag) and the table printed by
+// `kafka-consumer-groups.sh --describe`, which reflects the Admin API's view
+// of committed offsets.
+package lag
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Burrow partition statuses that indicate a consumer is not making progress.
+const (
+	StatusStop  = "STOP"
+	StatusStall = "STALL"
+)
+
+// PartitionLag is the lag of one consumer group on one partition.
+// Partition uses Kafka's 0-based numbering.
+type PartitionLag struct {
+	Topic     string
+	Partition int
+	Lag       int64
+	Status    string // Burrow evaluation status, empty if unknown
+}
+
+// Stuck reports whether the consumer on this partition is stopped or stalled.
+func (p PartitionLag) Stuck() bool {
+	return p.Status == StatusStop || p.Status == StatusStall
+}
+
+// Data holds the lag of a consumer group across its partitions.
+type Data struct {
+	Group      string
+	Partitions []PartitionLag
+}
+
+// burrowResponse is the subset of Burrow's lag endpoint response we need.
+type burrowResponse struct {
+	Status struct {
+		Group      string `json:"group"`
+		Partitions []struct {
+			Topic      string `json:"topic"`
+			Partition  int    `json:"partition"`
+			Status     string `json:"status"`
+			CurrentLag int64  `json:"current_lag"`
+		} `json:"partitions"`
+	} `json:"status"`
+}
+
+// LoadFile reads lag data from a Burrow JSON export or from saved
+// `kafka-consumer-groups.sh --describe` output. The format is detected from
+// the content.
+func LoadFile(path string) (*Data, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading lag file: %w", err)
+	}
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return parseBurrow(trimmed)
+	}
+	return parseDescribe(trimmed)
+}
+
+// Topics returns the distinct topics present in the data, sorted.
+func (d *Data) Topics() []string {
+	seen := make(map[string]bool)
+	var topics []string
+	for _, p := range d.Partitions {
+		if !seen[p.Topic] {
+			seen[p.Topic] = true
+			topics = append(topics, p.Topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// ForTopic returns the lag of each partition of a topic keyed by Kafka
+// partition ID. If topic is empty and the data covers exactly one topic,
+// that topic is used.
+func (d *Data) ForTopic(topic string) map[int]PartitionLag {
+	if topic == "" {
+		if topics := d.Topics(); len(topics) == 1 {
+			topic = topics[0]
+		}
+	}
+	result := make(map[int]PartitionLag)
+	for _, p := range d.Partitions {
+		if p.Topic == topic {
+			result[p.Partition] = p
+		}
+	}
+	return result
+}
+
+func parseBurrow(content []byte) (*Data, error) {
+	var resp burrowResponse
+	if err := json.Unmarshal(content, &resp); err != nil {
+		return nil, fmt.Errorf("parsing Burrow lag response: %w", err)
+	}
+	data := &Data{Group: resp.Status.Group}
+	for _, p := range resp.Status.Partitions {
+		data.Partitions = append(data.Partitions, PartitionLag{
+			Topic:     p.Topic,
+			Partition: p.Partition,
+			Lag:       p.CurrentLag,
+			Status:    p.Status,
+		})
+	}
+	if len(data.Partitions) == 0 {
+		return nil, fmt.Errorf("burrow lag response contains no partitions")
+	}
+	return data, nil
+}
+
+// parseDescribe parses the whitespace-aligned table printed by
+// kafka-consumer-groups.sh. Columns are located by header name so both
+// older and newer tool versions work; a "-" lag (no committed offset) is
+// treated as zero.
+func parseDescribe(content []byte) (*Data, error) {
+	data := &Data{}
+	cols := map[string]int{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "GROUP" || fields[0] == "TOPIC" {
+			// (Re)read the header; multiple groups print multiple tables
+			cols = map[string]int{}
+			for i, name := range fields {
+				cols[name] = i
+			}
+			continue
+		}
+		topicCol, okT := cols["TOPIC"]
+		partCol, okP := cols["PARTITION"]
+		lagCol, okL := cols["LAG"]
+		if !okT || !okP || !okL || len(fields) <= lagCol {
+			continue // Not a data row (e.g. "Consumer group 'x' has no active members.")
+		}
+		partition, err := strconv.Atoi(fields[partCol])
+		if err != nil {
+			continue
+		}
+		var lagValue int64
+		if fields[lagCol] != "-" {
+			lagValue, err = strconv.ParseInt(fields[lagCol], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid lag %q for %s-%d", fields[lagCol], fields[topicCol], partition)
+			}
+		}
+		if groupCol, ok := cols["GROUP"]; ok && data.Group == "" {
+			data.Group = fields[groupCol]
+		}
+		data.Partitions = append(data.Partitions, PartitionLag{
+			Topic:     fields[topicCol],
+			Partition: partition,
+			Lag:       lagValue,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(data.Partitions) == 0 {
+		return nil, fmt.Errorf("no partition lag rows found (expected Burrow JSON or kafka-consumer-groups --describe output)")
+	}
+	return data, nil
+}
@@ -0,0 +1,362 @@
+// Package locality reports where a consumer group fetches from: how much of
+// its fetch traffic stays in the consumers' own DC and how much crosses DCs,
+// both when every fetch goes to the partition leader and with KIP-392
+// follower fetching (replica.selector.class=RackAwareReplicaSelector and
+// client.rack set on the consumers), and how that shifts when brokers or
+// whole DCs fail.
+//
+// Observers in Confluent MRC serve follower fetches like any replica while
+// staying out of acks, so a DC with only an observer of a partition still
+// reads it locally. The share they serve is reported apart, along with the
+// cross-DC replication traffic they cost.
+package locality
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/capacity"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// Consumers maps DCs, by ID or rack name as given on the command line, to
+// the number of consumer instances of the group running there.
+type Consumers map[string]int
+
+// ParseConsumers parses a comma-separated list of dc=count pairs, where dc
+// is a DC ID or rack name, e.g. "1=4,2=2" or "us-east-1a=3".
+func ParseConsumers(spec string) (Consumers, error) {
+	c := make(Consumers)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		dc, count, ok := strings.Cut(part, "=")
+		if !ok || strings.TrimSpace(dc) == "" {
+			return nil, fmt.Errorf("invalid consumers %q, expected dc=count", part)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid consumers %q: count must be a non-negative number", part)
+		}
+		c[strings.TrimSpace(dc)] += n
+	}
+	if len(c) == 0 {
+		return nil, fmt.Errorf("no consumers given")
+	}
+	return c, nil
+}
+
+// ParseClientTraffic parses a comma-separated list of dc=produce[:consume]
+// pairs of rates, where dc is a DC ID or rack name, e.g.
+// "1=200MB/s:50MB/s,2=20MB/s" for the client-affinity strategy.
+func ParseClientTraffic(spec string) (config.ClientTraffic, error) {
+	c := make(config.ClientTraffic)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		dc, rates, ok := strings.Cut(part, "=")
+		if !ok || strings.TrimSpace(dc) == "" {
+			return nil, fmt.Errorf("invalid client traffic %q, expected dc=produce[:consume]", part)
+		}
+		produce, consume, _ := strings.Cut(rates, ":")
+		var t config.DCTraffic
+		var err error
+		if t.ProduceBytesPerSec, err = capacity.ParseRate(produce); err != nil || t.ProduceBytesPerSec < 0 {
+			return nil, fmt.Errorf("invalid client traffic %q: produce must be a rate such as 200MB/s", part)
+		}
+		if consume != "" {
+			if t.ConsumeBytesPerSec, err = capacity.ParseRate(consume); err != nil || t.ConsumeBytesPerSec < 0 {
+				return nil, fmt.Errorf("invalid client traffic %q: consume must be a rate such as 50MB/s", part)
+			}
+		}
+		sum := c[strings.TrimSpace(dc)]
+		sum.ProduceBytesPerSec += t.ProduceBytesPerSec
+		sum.ConsumeBytesPerSec += t.ConsumeBytesPerSec
+		c[strings.TrimSpace(dc)] = sum
+	}
+	if len(c) == 0 {
+		return nil, fmt.Errorf("no client traffic given")
+	}
+	return c, nil
+}
+
+// PerDC resolves the consumers against the DCs of a placement and returns
+// the count per DC ID, with the names that match no DC. Without consumers
+// every DC runs one.
+func (c Consumers) PerDC(dcs map[int]*config.DCInfo) (perDC map[int]int, unknown []string) {
+	perDC = make(map[int]int)
+	if len(c) == 0 {
+		for id := range dcs {
+			perDC[id] = 1
+		}
+		return perDC, nil
+	}
+	for name, n := range c {
+		found := false
+		for id, dc := range dcs {
+			if name == strconv.Itoa(id) || name == dc.Rack() {
+				perDC[id] += n
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return perDC, unknown
+}
+
+// Share splits fetch traffic into fractions that add up to 1.
+type Share struct {
+	Local       float64 // Served by a broker in the consumer's DC
+	Remote      float64 // Served across DCs
+	Unavailable float64 // The partition has no leader, so fetches fail
+	// Observer is the part of Local served by an observer, the only replica
+	// in the consumer's DC; without observer reads it would cross DCs.
+	Observer float64
+}
+
+// DCShare is the fetch traffic of the consumers in one DC.
+type DCShare struct {
+	DCID      int
+	Consumers int
+	Leader    Share // Fetching from the leader only
+	RackAware Share // With KIP-392 follower fetching
+}
+
+// Scenario is a set of brokers and DCs that are down together.
+type Scenario struct {
+	Name          string
+	FailedBrokers map[int]bool
+	FailedDCs     map[int]bool
+}
+
+// Result is the fetch locality of a consumer group in one scenario.
+type Result struct {
+	Scenario  Scenario
+	Leader    Share // Fetching from the leader only
+	RackAware Share // With KIP-392 follower fetching
+	PerDC     []DCShare
+	// Stopped counts the consumers that went down with their DC; the group
+	// rebalances their partitions onto the consumers left.
+	Stopped int
+	// BytesPerSec is the group's fetch traffic, 0 when the partitions have
+	// no bytes-out estimate and fetches are weighted per partition instead.
+	BytesPerSec float64
+	// Replication is the cross-DC traffic of replicas fetching from a
+	// leader in another DC, 0 without bytes-in estimates.
+	Replication Replication
+}
+
+// Replication is cross-DC replication traffic in bytes/sec, split by the
+// role of the fetching replica.
+type Replication struct {
+	Followers float64 // In sync, part of acks=all
+	Observers float64 // Asynchronous, outside acks
+}
+
+// Evaluate computes the fetch locality of the consumers in scenario s. The
+// group's partitions are spread over its consumers evenly, so each DC
+// fetches a share of every partition proportional to its consumers, and
+// partitions are weighted by their bytes out when known. A failed leader is
+// replaced by its lowest-ID surviving follower while the controller quorum
+// holds; observers don't take over, but do serve follower fetches.
+func Evaluate(dcs map[int]*config.DCInfo, q failover.Quorum, consumers map[int]int, loads map[int]config.PartitionLoad, s Scenario) Result {
+	r := Result{Scenario: s}
+	down := func(dcID, brokerID int) bool { return s.FailedDCs[dcID] || s.FailedBrokers[brokerID] }
+
+	// Where each partition's replicas live and which of them are up
+	type replicas struct {
+		leader, leaderDC int // -1 when the partition has no leader
+		liveDCs          map[int]bool
+		syncDCs          map[int]bool // DCs with a surviving leader or follower
+		followers        [][2]int     // Surviving followers as {broker, DC}
+		observerDCs      []int        // DC of each surviving observer
+		leaderDown       bool
+	}
+	parts := make(map[int]*replicas)
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			for _, rep := range b.Replicas {
+				p, ok := parts[rep.PartitionID]
+				if !ok {
+					p = &replicas{leader: -1, leaderDC: -1, liveDCs: make(map[int]bool), syncDCs: make(map[int]bool)}
+					parts[rep.PartitionID] = p
+				}
+				isDown := down(dc.ID, b.ID)
+				if !isDown {
+					p.liveDCs[dc.ID] = true
+					if rep.Role != config.Observer {
+						p.syncDCs[dc.ID] = true
+					}
+				}
+				switch {
+				case rep.Role == config.Leader && isDown:
+					p.leaderDown = true
+				case rep.Role == config.Leader:
+					p.leader, p.leaderDC = b.ID, dc.ID
+				case rep.Role == config.Follower && !isDown:
+					p.followers = append(p.followers, [2]int{b.ID, dc.ID})
+				case rep.Role == config.Observer && !isDown:
+					p.observerDCs = append(p.observerDCs, dc.ID)
+				}
+			}
+		}
+	}
+	quorum := q.Survives(s.FailedBrokers, s.FailedDCs)
+	for _, p := range parts {
+		if !p.leaderDown || !quorum || len(p.followers) == 0 {
+			continue
+		}
+		sort.Slice(p.followers, func(i, j int) bool { return p.followers[i][0] < p.followers[j][0] })
+		p.leader, p.leaderDC = p.followers[0][0], p.followers[0][1]
+		p.leaderDown = false
+	}
+
+	// Replicas outside the leader's DC copy every byte written across DCs
+	for id, p := range parts {
+		in := loads[id].BytesInPerSec
+		if p.leaderDown || p.leader < 0 || in == 0 {
+			continue
+		}
+		for _, f := range p.followers {
+			if f[0] != p.leader && f[1] != p.leaderDC {
+				r.Replication.Followers += in
+			}
+		}
+		for _, dcID := range p.observerDCs {
+			if dcID != p.leaderDC {
+				r.Replication.Observers += in
+			}
+		}
+	}
+
+	// Fetch weights: bytes out when every partition's is known, else equal
+	weights := make(map[int]float64, len(parts))
+	var total float64
+	for id := range parts {
+		weights[id] = loads[id].BytesOutPerSec
+		total += weights[id]
+	}
+	byBytes := total > 0
+	for id := range parts {
+		if weights[id] == 0 {
+			byBytes = false
+		}
+	}
+	if !byBytes {
+		total = float64(len(parts))
+		for id := range parts {
+			weights[id] = 1
+		}
+	} else {
+		r.BytesPerSec = total
+	}
+
+	alive := 0
+	for id, n := range consumers {
+		if s.FailedDCs[id] {
+			r.Stopped += n
+		} else {
+			alive += n
+		}
+	}
+	if alive == 0 || total == 0 {
+		return r
+	}
+	ids := make([]int, 0, len(consumers))
+	for id := range consumers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, dcID := range ids {
+		n := consumers[dcID]
+		if n == 0 || s.FailedDCs[dcID] {
+			continue
+		}
+		d := DCShare{DCID: dcID, Consumers: n}
+		for id, p := range parts {
+			w := weights[id] / total
+			switch {
+			case p.leaderDown || p.leader < 0:
+				d.Leader.Unavailable += w
+				d.RackAware.Unavailable += w
+				continue
+			case p.leaderDC == dcID:
+				d.Leader.Local += w
+			default:
+				d.Leader.Remote += w
+			}
+			if p.liveDCs[dcID] {
+				d.RackAware.Local += w
+				if !p.syncDCs[dcID] {
+					d.RackAware.Observer += w
+				}
+			} else {
+				d.RackAware.Remote += w
+			}
+		}
+		r.PerDC = append(r.PerDC, d)
+		f := float64(n) / float64(alive)
+		r.Leader.add(d.Leader, f)
+		r.RackAware.add(d.RackAware, f)
+	}
+	return r
+}
+
+func (s *Share) add(o Share, f float64) {
+	s.Local += o.Local * f
+	s.Remote += o.Remote * f
+	s.Unavailable += o.Unavailable * f
+	s.Observer += o.Observer * f
+}
+
+// Analyze evaluates the consumers with every broker up, with each DC lost
+// in turn (for more than one DC), and with the single broker whose loss
+// costs follower fetching the most local traffic.
+func Analyze(dcs map[int]*config.DCInfo, q failover.Quorum, consumers map[int]int, loads map[int]config.PartitionLoad) []Result {
+	results := []Result{Evaluate(dcs, q, consumers, loads, Scenario{Name: i18n.T("locality.allUp")})}
+	dcIDs := make([]int, 0, len(dcs))
+	for id := range dcs {
+		dcIDs = append(dcIDs, id)
+	}
+	sort.Ints(dcIDs)
+	if len(dcIDs) > 1 {
+		for _, id := range dcIDs {
+			s := Scenario{Name: i18n.T("locality.dcLost", dcs[id].Rack()), FailedDCs: map[int]bool{id: true}}
+			results = append(results, Evaluate(dcs, q, consumers, loads, s))
+		}
+	}
+
+	var worst *Result
+	for _, id := range dcIDs {
+		brokers := make([]int, 0, len(dcs[id].Brokers))
+		for b := range dcs[id].Brokers {
+			brokers = append(brokers, b)
+		}
+		sort.Ints(brokers)
+		for _, b := range brokers {
+			s := Scenario{Name: i18n.T("locality.brokerDown", b), FailedBrokers: map[int]bool{b: true}}
+			r := Evaluate(dcs, q, consumers, loads, s)
+			if worst == nil || r.RackAware.Unavailable > worst.RackAware.Unavailable ||
+				(r.RackAware.Unavailable == worst.RackAware.Unavailable && r.RackAware.Local < worst.RackAware.Local) {
+				worst = &r
+			}
+		}
+	}
+	if worst != nil {
+		results = append(results, *worst)
+	}
+	return results
+}
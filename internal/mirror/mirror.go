@@ -0,0 +1,311 @@
+// Package mirror maps topics across the clusters of a MirrorMaker 2
+// topology: which source topics each replication flow mirrors, what the
+// replication policy names them on the target, whether the partition counts
+// of both sides match, and which internal topics MM2 keeps for offset
+// translation.
+package mirror
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// Policy is the MM2 replication policy, which names remote topics.
+type Policy int
+
+const (
+	PolicyDefault  Policy = iota // DefaultReplicationPolicy: prefixed with the source alias, e.g. A.orders
+	PolicyIdentity               // IdentityReplicationPolicy: the source name, without cycle detection
+)
+
+// String returns the policy's class name.
+func (p Policy) String() string {
+	if p == PolicyIdentity {
+		return "IdentityReplicationPolicy"
+	}
+	return "DefaultReplicationPolicy"
+}
+
+// MM2's topics.exclude default: internal, replica and double-underscore
+// topics are not mirrored.
+const defaultExclude = `.*[\-\.]internal, .*\.replica, __.*`
+
+// Flow is one enabled replication flow, source->target.
+type Flow struct {
+	Source  string
+	Target  string
+	Topics  []*regexp.Regexp // Mirrored topics, matched against the whole name
+	Exclude []*regexp.Regexp
+}
+
+// Config is the part of an MM2 configuration (connect-mirror-maker
+// properties) that decides topic names.
+type Config struct {
+	Clusters  []string
+	Flows     []Flow // In the order of the cluster list, by source then target
+	Policy    Policy
+	Separator string // replication.policy.separator, "." by default
+	// OffsetSyncsOnTarget is set by offset-syncs.topic.location=target;
+	// by default the offset-syncs topic lives on the source cluster.
+	OffsetSyncsOnTarget bool
+}
+
+// LoadProperties reads an MM2 configuration file in Java properties
+// format: clusters, the enabled A->B flows and their topics and
+// topics.exclude lists, the replication policy and its separator.
+func LoadProperties(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading MM2 config: %w", err)
+	}
+	defer f.Close()
+	props := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			props[line] = ""
+			continue
+		}
+		props[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading MM2 config: %w", err)
+	}
+	return parse(props)
+}
+
+func parse(props map[string]string) (*Config, error) {
+	c := &Config{Separator: "."}
+	for _, alias := range strings.Split(props["clusters"], ",") {
+		if alias = strings.TrimSpace(alias); alias != "" {
+			c.Clusters = append(c.Clusters, alias)
+		}
+	}
+	if len(c.Clusters) < 2 {
+		return nil, fmt.Errorf("MM2 config: clusters must list at least two cluster aliases")
+	}
+	if sep, ok := props["replication.policy.separator"]; ok && sep != "" {
+		c.Separator = sep
+	}
+	if strings.HasSuffix(props["replication.policy.class"], "IdentityReplicationPolicy") {
+		c.Policy = PolicyIdentity
+	}
+	c.OffsetSyncsOnTarget = props["offset-syncs.topic.location"] == "target"
+
+	for _, source := range c.Clusters {
+		for _, target := range c.Clusters {
+			prefix := source + "->" + target + "."
+			if source == target || props[prefix+"enabled"] != "true" {
+				continue
+			}
+			flow := Flow{Source: source, Target: target}
+			var err error
+			if flow.Topics, err = patterns(first(props, prefix+"topics", "topics"), ".*"); err != nil {
+				return nil, err
+			}
+			exclude := first(props, prefix+"topics.exclude", prefix+"topics.blacklist", "topics.exclude", "topics.blacklist")
+			if flow.Exclude, err = patterns(exclude, defaultExclude); err != nil {
+				return nil, err
+			}
+			c.Flows = append(c.Flows, flow)
+		}
+	}
+	if len(c.Flows) == 0 {
+		return nil, fmt.Errorf("MM2 config: no replication flow enabled (e.g. A->B.enabled = true)")
+	}
+	return c, nil
+}
+
+// first returns the value of the first key that is set.
+func first(props map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value, ok := props[key]; ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// patterns compiles a comma-separated regex list, each matching whole
+// topic names; fallback is used when the list is empty.
+func patterns(list, fallback string) ([]*regexp.Regexp, error) {
+	if strings.TrimSpace(list) == "" {
+		list = fallback
+	}
+	var res []*regexp.Regexp
+	for _, p := range strings.Split(list, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		re, err := regexp.Compile("^(?:" + p + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("MM2 config: invalid topic pattern %q: %w", p, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+// RemoteTopic returns the name a flow from source gives a topic on the
+// target cluster.
+func (c *Config) RemoteTopic(source, topic string) string {
+	if c.Policy == PolicyIdentity {
+		return topic
+	}
+	return source + c.Separator + topic
+}
+
+// Mirrors reports whether a flow mirrors a topic of its source cluster.
+// With the default policy, topics mirrored from the target are skipped, so
+// two-way flows do not loop.
+func (c *Config) Mirrors(f Flow, topic string) bool {
+	if c.Policy == PolicyDefault && strings.HasPrefix(topic, f.Target+c.Separator) {
+		return false
+	}
+	if topic == "heartbeats" {
+		return true // Always mirrored, to measure the flow's latency
+	}
+	for _, re := range f.Exclude {
+		if re.MatchString(topic) {
+			return false
+		}
+	}
+	for _, re := range f.Topics {
+		if re.MatchString(topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// Status is how a mirrored topic compares with its source.
+type Status int
+
+const (
+	StatusOK      Status = iota // Same partition count on both sides
+	StatusUnknown               // The target's topics are not known
+	StatusMissing               // Not created on the target yet
+	StatusFewer                 // Fewer partitions on the target; MM2 adds them on its next topic refresh
+	StatusMore                  // More partitions on the target; MM2 never removes them and they stay empty
+)
+
+// TopicMapping is one source topic and its mirror on the target.
+type TopicMapping struct {
+	SourceTopic      string
+	TargetTopic      string
+	SourcePartitions int
+	TargetPartitions int // 0 when missing or unknown
+	Status           Status
+}
+
+// Mismatched reports whether the partition counts of both sides differ.
+func (t TopicMapping) Mismatched() bool {
+	return t.Status == StatusFewer || t.Status == StatusMore
+}
+
+// InternalTopic is a topic MM2 keeps for a flow.
+type InternalTopic struct {
+	Cluster string
+	Name    string
+	Purpose string
+	Known   bool // The cluster's topics are known
+	Present bool
+}
+
+// FlowMapping is the topic mapping of one flow.
+type FlowMapping struct {
+	Flow     Flow
+	Topics   []TopicMapping // Sorted by source topic
+	Internal []InternalTopic
+}
+
+// Mismatches returns the mirrored topics whose partition counts differ.
+func (f FlowMapping) Mismatches() []TopicMapping {
+	var result []TopicMapping
+	for _, t := range f.Topics {
+		if t.Mismatched() {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// Map maps the topics of every flow, given the topics of the clusters by
+// alias. A cluster without topics is unknown: its side of the mapping is
+// not checked.
+func Map(c *Config, topics map[string][]config.TopicSpec) []FlowMapping {
+	partitions := make(map[string]map[string]int)
+	for alias, specs := range topics {
+		partitions[alias] = make(map[string]int)
+		for _, t := range specs {
+			partitions[alias][t.Name] = t.NumPartitions
+		}
+	}
+
+	var result []FlowMapping
+	for _, f := range c.Flows {
+		fm := FlowMapping{Flow: f}
+		targetKnown := partitions[f.Target] != nil
+		names := make([]string, 0, len(partitions[f.Source]))
+		for name := range partitions[f.Source] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !c.Mirrors(f, name) {
+				continue
+			}
+			t := TopicMapping{
+				SourceTopic:      name,
+				TargetTopic:      c.RemoteTopic(f.Source, name),
+				SourcePartitions: partitions[f.Source][name],
+			}
+			n, ok := partitions[f.Target][t.TargetTopic]
+			switch {
+			case !targetKnown:
+				t.Status = StatusUnknown
+			case !ok:
+				t.Status = StatusMissing
+			case n < t.SourcePartitions:
+				t.Status = StatusFewer
+			case n > t.SourcePartitions:
+				t.Status = StatusMore
+			}
+			t.TargetPartitions = n
+			fm.Topics = append(fm.Topics, t)
+		}
+
+		syncs := InternalTopic{Cluster: f.Source, Name: "mm2-offset-syncs." + f.Target + ".internal",
+			Purpose: i18n.T("mirror.purpose.offsetSyncs")}
+		if c.OffsetSyncsOnTarget {
+			syncs.Cluster = f.Target
+		}
+		internal := []InternalTopic{
+			syncs,
+			{Cluster: f.Target, Name: f.Source + ".checkpoints.internal",
+				Purpose: i18n.T("mirror.purpose.checkpoints")},
+			{Cluster: f.Target, Name: c.RemoteTopic(f.Source, "heartbeats"),
+				Purpose: i18n.T("mirror.purpose.heartbeats")},
+		}
+		for _, it := range internal {
+			_, it.Present = partitions[it.Cluster][it.Name]
+			it.Known = partitions[it.Cluster] != nil
+			fm.Internal = append(fm.Internal, it)
+		}
+		result = append(result, fm)
+	}
+	return result
+}
@@ -0,0 +1,206 @@
+// Package naming checks the topic names of an imported cluster against the
+// naming conventions in a rules file, for audits of a real cluster.
+//
+// A naming rules file contains one rule per line; blank lines and lines
+// starting with '#' are ignored. Patterns are Go regular expressions and
+// are matched against the whole topic name only when anchored. Supported
+// rules:
+//
+//	allow <regexp>                  (every topic matches at least one allow)
+//	forbid <regexp>                 (no topic matches)
+//	ignore <regexp>                 (topics not checked, e.g. ^__ for internal ones)
+//	max length <N>                  (characters; Kafka's own limit is 249)
+//	no dot underscore collisions    (names equal once '.' and '_' are swapped)
+//
+// Kafka warns about the last one as both characters map to '_' in metric
+// names, so two such topics share their metrics.
+package naming
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Rules is a parsed naming rules file.
+type Rules struct {
+	allow      []*regexp.Regexp
+	ignore     []*regexp.Regexp
+	checks     []check
+	allowTexts []string // The allow rules as written, for the violation
+}
+
+// check is a rule evaluated on one topic name at a time.
+type check struct {
+	text string
+	test func(name string) (bool, string)
+}
+
+// Violation is a topic breaking one naming rule.
+type Violation struct {
+	Topic  string
+	Rule   string // The rule as written in the file
+	Detail string // Human readable explanation, e.g. the clashing topic
+}
+
+// Report is the outcome of checking a cluster's topics.
+type Report struct {
+	Checked    int // Topics checked, without the ignored ones
+	Ignored    int
+	Violations []Violation // Sorted by topic, then rule
+}
+
+// Failing returns how many topics break at least one rule.
+func (r Report) Failing() int {
+	seen := make(map[string]bool)
+	for _, v := range r.Violations {
+		seen[v.Topic] = true
+	}
+	return len(seen)
+}
+
+// LoadFile parses a naming rules file.
+func LoadFile(path string) (*Rules, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading naming rules file: %w", err)
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse reads naming rules, one per line.
+func Parse(r io.Reader) (*Rules, error) {
+	rules := &Rules{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if err := rules.add(text); err != nil {
+			return nil, fmt.Errorf("naming rules line %d: %w", line, err)
+		}
+	}
+	return rules, scanner.Err()
+}
+
+// add parses a single rule into r. Keywords are case-insensitive, patterns
+// are not.
+func (r *Rules) add(text string) error {
+	keyword, rest, _ := strings.Cut(text, " ")
+	rest = strings.TrimSpace(rest)
+	switch strings.ToLower(keyword) {
+	case "allow", "forbid", "ignore":
+		if rest == "" {
+			return fmt.Errorf("%s needs a pattern", strings.ToLower(keyword))
+		}
+		re, err := regexp.Compile(rest)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", rest, err)
+		}
+		switch strings.ToLower(keyword) {
+		case "allow":
+			r.allow = append(r.allow, re)
+			r.allowTexts = append(r.allowTexts, text)
+		case "ignore":
+			r.ignore = append(r.ignore, re)
+		default:
+			r.checks = append(r.checks, check{text: text, test: func(name string) (bool, string) {
+				if m := re.FindString(name); m != "" {
+					return false, fmt.Sprintf("contains %q", m)
+				}
+				return true, ""
+			}})
+		}
+		return nil
+	}
+
+	normalized := strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	if m := regexp.MustCompile(`^max length (\d+)$`).FindStringSubmatch(normalized); m != nil {
+		limit, _ := strconv.Atoi(m[1])
+		if limit == 0 {
+			return fmt.Errorf("max length must be positive")
+		}
+		r.checks = append(r.checks, check{text: text, test: func(name string) (bool, string) {
+			if n := utf8.RuneCountInString(name); n > limit {
+				return false, fmt.Sprintf("%d characters", n)
+			}
+			return true, ""
+		}})
+		return nil
+	}
+	if normalized == "no dot underscore collisions" {
+		// Checked across topics in Check
+		r.checks = append(r.checks, check{text: text})
+		return nil
+	}
+	return fmt.Errorf("unrecognized naming rule %q", text)
+}
+
+// Check evaluates the rules against the topic names of a cluster.
+func (r *Rules) Check(topics []string) Report {
+	var report Report
+	var checked []string
+	for _, name := range topics {
+		if matchesAny(r.ignore, name) {
+			report.Ignored++
+			continue
+		}
+		checked = append(checked, name)
+	}
+	report.Checked = len(checked)
+
+	// Topics whose metric names collide: '.' and '_' both become '_'
+	collisions := make(map[string][]string)
+	for _, name := range checked {
+		key := strings.ReplaceAll(name, ".", "_")
+		collisions[key] = append(collisions[key], name)
+	}
+
+	for _, name := range checked {
+		if len(r.allow) > 0 && !matchesAny(r.allow, name) {
+			report.Violations = append(report.Violations, Violation{
+				Topic:  name,
+				Rule:   strings.Join(r.allowTexts, " | "),
+				Detail: "matches no allowed pattern",
+			})
+		}
+		for _, c := range r.checks {
+			if c.test == nil {
+				var others []string
+				for _, other := range collisions[strings.ReplaceAll(name, ".", "_")] {
+					if other != name {
+						others = append(others, other)
+					}
+				}
+				if len(others) > 0 {
+					report.Violations = append(report.Violations, Violation{Topic: name, Rule: c.text, Detail: "collides with " + strings.Join(others, ", ")})
+				}
+				continue
+			}
+			if ok, detail := c.test(name); !ok {
+				report.Violations = append(report.Violations, Violation{Topic: name, Rule: c.text, Detail: detail})
+			}
+		}
+	}
+	sort.SliceStable(report.Violations, func(i, j int) bool {
+		return report.Violations[i].Topic < report.Violations[j].Topic
+	})
+	return report
+}
+
+func matchesAny(patterns []*regexp.Regexp, name string) bool {
+	for _, re := range patterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+// Package pipeline runs named review workflows from a config file: a
+// placement strategy with its goals, the scorers the result is judged by
+// and the exports written for the review, e.g.
+//
+//	{
+//	  "pipelines": {
+//	    "prod-review": {
+//	      "strategy": "goals",
+//	      "goals": ["RackAwareGoal", "ReplicaDistributionGoal", "LeaderReplicaDistributionGoal"],
+//	      "max_moves": 50,
+//	      "exports": [
+//	        {"path": "review/plan.svg"},
+//	        {"path": "review/reassign.json", "format": "reassignment"}
+//	      ]
+//	    }
+//	  }
+//	}
+//
+// A pipeline runs headless on an assignment (e.g. the live one exported
+// earlier), re-placing its topic on the same brokers, printing the scores
+// of the assignment and of the new placement side by side and writing the
+// exports.
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/export"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// Pipeline is one named workflow.
+type Pipeline struct {
+	Name     string          `json:"-"`
+	Strategy config.Strategy `json:"strategy"`
+	// Goals are the scorers the goals strategy optimizes, highest priority
+	// first; empty for Cruise Control's default order.
+	Goals    []string `json:"goals,omitempty"`
+	MaxMoves int      `json:"max_moves,omitempty"` // Goals strategy budget, 0 for one per replica
+	Seed     int64    `json:"seed,omitempty"`      // 0 for a different placement every run
+	// Scorers are the scores printed for the review; empty for the goals,
+	// or every registered scorer without goals.
+	Scorers []string `json:"scorers,omitempty"`
+	Exports []Output `json:"exports"`
+}
+
+// Output is one export of a pipeline.
+type Output struct {
+	Path string `json:"path"`
+	// Format is an export format name; empty picks it from the extension.
+	Format export.Format `json:"format,omitempty"`
+}
+
+// file is the layout of a pipelines file.
+type file struct {
+	Pipelines map[string]*Pipeline `json:"pipelines"`
+}
+
+// DefaultPath returns the pipelines file in the user's config directory.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating config directory: %w", err)
+	}
+	return filepath.Join(dir, "kafka-viz", "pipelines.json"), nil
+}
+
+// LoadFile reads the pipelines of a file, checking their goals, scorers and
+// export formats up front so a typo fails before anything is written.
+func LoadFile(path string) (map[string]Pipeline, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading pipelines: %w", err)
+	}
+	var f file
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	pipelines := make(map[string]Pipeline, len(f.Pipelines))
+	for name, p := range f.Pipelines {
+		if p == nil {
+			return nil, fmt.Errorf("%s: pipeline %q is empty", path, name)
+		}
+		p.Name = name
+		if err := p.validate(); err != nil {
+			return nil, fmt.Errorf("%s: pipeline %q: %w", path, name, err)
+		}
+		pipelines[name] = *p
+	}
+	return pipelines, nil
+}
+
+// Names returns the names of the pipelines, sorted.
+func Names(pipelines map[string]Pipeline) []string {
+	names := make([]string, 0, len(pipelines))
+	for name := range pipelines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validate checks the names a pipeline refers to and fills in the export
+// formats picked by extension.
+func (p *Pipeline) validate() error {
+	if _, err := placement.ResolveScorers(p.Goals); err != nil {
+		return fmt.Errorf("goals: %w", err)
+	}
+	if _, err := p.scorers(); err != nil {
+		return fmt.Errorf("scorers: %w", err)
+	}
+	if p.MaxMoves < 0 {
+		return fmt.Errorf("max_moves cannot be negative")
+	}
+	if len(p.Exports) == 0 {
+		return fmt.Errorf("no exports")
+	}
+	for i, out := range p.Exports {
+		if out.Path == "" {
+			return fmt.Errorf("export %d has no path", i+1)
+		}
+		if out.Format == "" {
+			p.Exports[i].Format = export.FormatForPath(out.Path)
+		} else if _, err := export.ParseFormat(string(out.Format)); err != nil {
+			return fmt.Errorf("export %s: %w", out.Path, err)
+		}
+	}
+	return nil
+}
+
+// scorers resolves the scorers a pipeline's review prints.
+func (p Pipeline) scorers() ([]placement.Scorer, error) {
+	if len(p.Scorers) > 0 {
+		return placement.ResolveScorers(p.Scorers)
+	}
+	return placement.ResolveScorers(p.Goals)
+}
+
+// Run places the topic of an assignment with the pipeline's strategy on
+// the same brokers, writes the scores of both to w and the new placement
+// to the pipeline's exports, creating their directories.
+func Run(p Pipeline, cfg config.PlacementConfig, current map[int]*config.DCInfo, w io.Writer) error {
+	cfg.Strategy = p.Strategy
+	cfg.Goals = p.Goals
+	cfg.MaxMoves = p.MaxMoves
+	cfg.Seed = p.Seed
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	dcs, _ := placement.CalculatePlacement(cfg)
+
+	scorers, err := p.scorers()
+	if err != nil {
+		return err
+	}
+	before := placement.NewAssignment(current, cfg.PartitionLoads).Scores(scorers)
+	after := placement.NewAssignment(dcs, cfg.PartitionLoads).Scores(scorers)
+	width := len("Scorer")
+	for _, s := range scorers {
+		width = max(width, len(s.Name()))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Pipeline %s: %s placement of %s (lower scores are better)\n", p.Name, p.Strategy, topicName(cfg))
+	fmt.Fprintf(&b, "%-*s  %10s  %10s\n", width, "Scorer", "Current", "Pipeline")
+	for i, s := range scorers {
+		fmt.Fprintf(&b, "%-*s  %10.2f  %10.2f\n", width, s.Name(), before[i], after[i])
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return err
+	}
+
+	for _, out := range p.Exports {
+		if dir := filepath.Dir(out.Path); dir != "." {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return fmt.Errorf("writing export: %w", err)
+			}
+		}
+		if err := export.WriteFile(out.Path, out.Format, cfg, dcs); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Wrote %s (%s)\n", out.Path, out.Format)
+	}
+	return nil
+}
+
+// topicName names the topic in the review header.
+func topicName(cfg config.PlacementConfig) string {
+	if cfg.TopicName == "" {
+		return "the topic"
+	}
+	return cfg.TopicName
+}
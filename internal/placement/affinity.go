@@ -0,0 +1,143 @@
+package placement
+
+import (
+	"sort"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// leaderAffinity picks the leaders of the client-affinity strategy: each DC
+// leads a share of the partitions' produce traffic proportional to the
+// produce traffic of its clients, so most writes reach their leader without
+// crossing DCs.
+type leaderAffinity struct {
+	share    map[int]float64 // DC ID -> fraction of the produce traffic
+	assigned map[int]float64 // DC ID -> partition weight led so far
+	total    float64         // Partition weight led so far
+	dcIDs    []int
+	brokers  map[int][]int // DC ID -> broker IDs, sorted
+	leaders  map[int]int   // Broker ID -> partitions led
+}
+
+// newLeaderAffinity returns the leader picker for cfg, or nil when cfg does
+// not use the client-affinity strategy or no DC of it produces.
+func newLeaderAffinity(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, dcIDs []int) *leaderAffinity {
+	if cfg.Strategy != config.StrategyClientAffinity {
+		return nil
+	}
+	perDC, _ := cfg.ClientTraffic.PerDC(dcs)
+	var produced float64
+	for _, id := range dcIDs {
+		if len(dcs[id].Brokers) > 0 {
+			produced += perDC[id].ProduceBytesPerSec
+		}
+	}
+	if produced == 0 {
+		return nil
+	}
+	a := &leaderAffinity{
+		share:    make(map[int]float64),
+		assigned: make(map[int]float64),
+		dcIDs:    dcIDs,
+		brokers:  make(map[int][]int),
+		leaders:  make(map[int]int),
+	}
+	for _, id := range dcIDs {
+		if len(dcs[id].Brokers) == 0 {
+			continue
+		}
+		a.share[id] = perDC[id].ProduceBytesPerSec / produced
+		for brokerID := range dcs[id].Brokers {
+			a.brokers[id] = append(a.brokers[id], brokerID)
+		}
+		sort.Ints(a.brokers[id])
+	}
+	return a
+}
+
+// next returns the leader of a partition of the given weight: the broker
+// leading the fewest partitions in the DC furthest behind its share.
+func (a *leaderAffinity) next(weight float64) int {
+	a.total += weight
+	best, bestDeficit := -1, 0.0
+	for _, id := range a.dcIDs {
+		if len(a.brokers[id]) == 0 {
+			continue
+		}
+		deficit := a.share[id]*a.total - a.assigned[id]
+		if best < 0 || deficit > bestDeficit {
+			best, bestDeficit = id, deficit
+		}
+	}
+	a.assigned[best] += weight
+	leader := a.brokers[best][0]
+	for _, id := range a.brokers[best][1:] {
+		if a.leaders[id] < a.leaders[leader] {
+			leader = id
+		}
+	}
+	a.leaders[leader]++
+	return leader
+}
+
+// produceWeights returns each partition's share of the topic's writes: its
+// bytes in when every partition's is known, else an equal share.
+func produceWeights(cfg config.PlacementConfig) map[int]float64 {
+	weights := make(map[int]float64, cfg.NumPartitions)
+	known := true
+	for id := 1; id <= cfg.NumPartitions; id++ {
+		weights[id] = cfg.PartitionLoads[id].BytesInPerSec
+		if weights[id] == 0 {
+			known = false
+		}
+	}
+	if !known {
+		for id := range weights {
+			weights[id] = 1
+		}
+	}
+	return weights
+}
+
+// ClientHops is the client traffic of a placement that crosses DCs to
+// reach a partition leader, in bytes/sec.
+type ClientHops struct {
+	Produce float64
+	Consume float64 // Fetching from leaders only, without KIP-392
+}
+
+// CrossDCClientTraffic returns how much of cfg's client traffic crosses DCs
+// in a placement. The clients of each DC spread their writes and reads
+// over the partitions like the topic's bytes in (equally when unknown), and
+// every request goes to the partition leader.
+func CrossDCClientTraffic(cfg config.PlacementConfig, dcs map[int]*config.DCInfo) ClientHops {
+	perDC, _ := cfg.ClientTraffic.PerDC(dcs)
+	weights := produceWeights(cfg)
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	var hops ClientHops
+	if total == 0 {
+		return hops
+	}
+	for _, p := range Partitions(dcs) {
+		leaders := p.Brokers[config.Leader]
+		if len(leaders) == 0 {
+			continue
+		}
+		leaderDC, _ := findBroker(leaders[0], dcs)
+		if leaderDC == nil {
+			continue
+		}
+		share := weights[p.ID] / total
+		for id, t := range perDC {
+			if id != leaderDC.ID {
+				hops.Produce += t.ProduceBytesPerSec * share
+				hops.Consume += t.ConsumeBytesPerSec * share
+			}
+		}
+	}
+	return hops
+}
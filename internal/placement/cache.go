@@ -0,0 +1,105 @@
+package placement
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// DefaultCacheSize is how many placements a Cache keeps by default.
+const DefaultCacheSize = 32
+
+// Cache remembers computed placements keyed by a hash of their config
+// (which includes the strategy and its parameters), so re-entering an
+// identical configuration, or toggling between two scenarios, returns the
+// earlier result instantly. For the random strategy this also means the
+// same config shows the same placement again; restarting the visualizer
+// starts with an empty cache. A Cache is safe for concurrent use.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[[sha256.Size]byte]cacheEntry
+	order   [][sha256.Size]byte // Keys oldest first, for eviction
+}
+
+type cacheEntry struct {
+	dcs            map[int]*config.DCInfo
+	recommendation string
+}
+
+// NewCache creates a cache holding up to size placements (DefaultCacheSize
+// if size <= 0).
+func NewCache(size int) *Cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	return &Cache{size: size, entries: make(map[[sha256.Size]byte]cacheEntry)}
+}
+
+// CalculatePlacement returns the cached placement for cfg, calculating and
+// caching it on a miss. Callers get their own copy and may modify it. A
+// nil cache always calculates.
+func (c *Cache) CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, string) {
+	if c == nil {
+		return CalculatePlacement(cfg)
+	}
+	key, err := cacheKey(cfg)
+	if err != nil {
+		return CalculatePlacement(cfg)
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		return copyDCs(entry.dcs), entry.recommendation
+	}
+
+	dcs, recommendation := CalculatePlacement(cfg)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		if len(c.order) >= c.size {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = cacheEntry{dcs: copyDCs(dcs), recommendation: recommendation}
+	return dcs, recommendation
+}
+
+// Len returns the number of cached placements.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// cacheKey hashes the JSON encoding of cfg; maps encode with sorted keys,
+// so equal configs always hash the same.
+func cacheKey(cfg config.PlacementConfig) ([sha256.Size]byte, error) {
+	encoded, err := json.Marshal(cfg)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(encoded), nil
+}
+
+// copyDCs deep-copies a placement.
+func copyDCs(dcs map[int]*config.DCInfo) map[int]*config.DCInfo {
+	result := make(map[int]*config.DCInfo, len(dcs))
+	for id, dc := range dcs {
+		c := &config.DCInfo{ID: dc.ID, Name: dc.Name, Brokers: make(map[int]*config.BrokerInfo, len(dc.Brokers))}
+		for brokerID, broker := range dc.Brokers {
+			b := *broker
+			b.Replicas = append([]config.ReplicaInfo(nil), broker.Replicas...)
+			c.Brokers[brokerID] = &b
+		}
+		result[id] = c
+	}
+	return result
+}
@@ -0,0 +1,354 @@
+package placement
+
+import (
+	"math"
+	"sort"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// The built-in scorers are simplified versions of Cruise Control goals,
+// registered in Cruise Control's default priority order.
+func init() {
+	builtin := []Scorer{
+		NewScorer("RackAwareGoal", "Replicas of a partition spread over as many DCs as possible",
+			func(a *Assignment) float64 { return float64(a.rackViolations) }),
+		NewScorer("ReplicaDistributionGoal", "Even replica count per broker",
+			func(a *Assignment) float64 { return coefficientOfVariation(a.brokerIDs, a.replicas) }),
+		NewScorer("DiskUsageDistributionGoal", "Even bytes on disk per broker",
+			func(a *Assignment) float64 { return coefficientOfVariation(a.brokerIDs, a.bytes) }),
+		NewScorer("NetworkInboundUsageDistributionGoal", "Even inbound traffic per broker",
+			func(a *Assignment) float64 { return coefficientOfVariation(a.brokerIDs, a.bytesIn) }),
+		NewScorer("NetworkOutboundUsageDistributionGoal", "Even outbound traffic per broker",
+			func(a *Assignment) float64 { return coefficientOfVariation(a.brokerIDs, a.bytesOut) }),
+		NewScorer("LeaderReplicaDistributionGoal", "Even leader count per broker",
+			func(a *Assignment) float64 { return coefficientOfVariation(a.brokerIDs, a.leaders) }),
+	}
+	for _, s := range builtin {
+		if err := RegisterScorer(s); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Replica is one replica in the flat representation used by Assignment.
+type Replica struct {
+	PartitionID int
+	BrokerID    int
+	Role        config.ReplicaRole
+}
+
+// Assignment is an indexed view of a placement with per-broker aggregates,
+// kept up to date incrementally as replicas move so goals can be evaluated
+// cheaply while optimizing.
+type Assignment struct {
+	Replicas []Replica
+	loads    map[int]config.PartitionLoad
+	numDCs   int
+
+	brokerIDs []int       // Sorted
+	brokerDC  map[int]int // BrokerID -> DC ID
+
+	replicas map[int]float64
+	leaders  map[int]float64
+	bytes    map[int]float64
+	bytesIn  map[int]float64
+	bytesOut map[int]float64
+
+	partitionSize  map[int]int         // PartitionID -> replica count
+	byPartition    map[int][]int       // PartitionID -> indexes into Replicas
+	partitionDCs   map[int]map[int]int // PartitionID -> DC ID -> replica count
+	hosted         map[int]map[int]bool
+	rackViolations int
+}
+
+// NewAssignment indexes a placement for scoring.
+func NewAssignment(dcs map[int]*config.DCInfo, loads map[int]config.PartitionLoad) *Assignment {
+	a := &Assignment{
+		loads:         loads,
+		numDCs:        len(dcs),
+		brokerDC:      make(map[int]int),
+		replicas:      make(map[int]float64),
+		leaders:       make(map[int]float64),
+		bytes:         make(map[int]float64),
+		bytesIn:       make(map[int]float64),
+		bytesOut:      make(map[int]float64),
+		partitionSize: make(map[int]int),
+		byPartition:   make(map[int][]int),
+		partitionDCs:  make(map[int]map[int]int),
+		hosted:        make(map[int]map[int]bool),
+	}
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			a.brokerIDs = append(a.brokerIDs, broker.ID)
+			a.brokerDC[broker.ID] = dc.ID
+			a.hosted[broker.ID] = make(map[int]bool)
+			for _, replica := range broker.Replicas {
+				a.Replicas = append(a.Replicas, Replica{PartitionID: replica.PartitionID, BrokerID: broker.ID, Role: replica.Role})
+				a.partitionSize[replica.PartitionID]++
+			}
+		}
+	}
+	sort.Ints(a.brokerIDs)
+	// Deterministic replica order keeps optimization reproducible
+	sort.Slice(a.Replicas, func(i, j int) bool {
+		if a.Replicas[i].PartitionID != a.Replicas[j].PartitionID {
+			return a.Replicas[i].PartitionID < a.Replicas[j].PartitionID
+		}
+		return a.Replicas[i].BrokerID < a.Replicas[j].BrokerID
+	})
+	for i := range a.Replicas {
+		a.byPartition[a.Replicas[i].PartitionID] = append(a.byPartition[a.Replicas[i].PartitionID], i)
+		a.add(a.Replicas[i], 1)
+	}
+	// add() tracks violations incrementally; establish the baseline here
+	a.rackViolations = 0
+	for partitionID := range a.partitionSize {
+		a.rackViolations += a.partitionViolations(partitionID)
+	}
+	return a
+}
+
+// Scores evaluates the given scorers against the assignment.
+func (a *Assignment) Scores(scorers []Scorer) []float64 {
+	scores := make([]float64, len(scorers))
+	for i, s := range scorers {
+		scores[i] = s.Score(a)
+	}
+	return scores
+}
+
+// BrokerIDs returns the IDs of all brokers, sorted.
+func (a *Assignment) BrokerIDs() []int { return a.brokerIDs }
+
+// BrokerDC returns the DC a broker belongs to.
+func (a *Assignment) BrokerDC(brokerID int) int { return a.brokerDC[brokerID] }
+
+// NumDCs returns the number of DCs in the topology.
+func (a *Assignment) NumDCs() int { return a.numDCs }
+
+// Load returns the size/traffic estimate of a partition.
+func (a *Assignment) Load(partitionID int) config.PartitionLoad { return a.loads[partitionID] }
+
+// ReplicaCount returns the number of replicas hosted by a broker.
+func (a *Assignment) ReplicaCount(brokerID int) int { return int(a.replicas[brokerID]) }
+
+// LeaderCount returns the number of leaders hosted by a broker.
+func (a *Assignment) LeaderCount(brokerID int) int { return int(a.leaders[brokerID]) }
+
+// BrokerBytes returns the bytes hosted by a broker.
+func (a *Assignment) BrokerBytes(brokerID int) int64 { return int64(a.bytes[brokerID]) }
+
+// BrokerNetwork returns the estimated network traffic of a broker.
+func (a *Assignment) BrokerNetwork(brokerID int) NetworkLoad {
+	return NetworkLoad{BytesIn: a.bytesIn[brokerID], BytesOut: a.bytesOut[brokerID]}
+}
+
+// Imbalance returns the coefficient of variation (stddev/mean) of a
+// per-broker metric, a scale-free measure that is 0 when perfectly balanced.
+func (a *Assignment) Imbalance(metric func(brokerID int) float64) float64 {
+	values := make(map[int]float64, len(a.brokerIDs))
+	for _, id := range a.brokerIDs {
+		values[id] = metric(id)
+	}
+	return coefficientOfVariation(a.brokerIDs, values)
+}
+
+// add applies (sign=1) or removes (sign=-1) a replica's contribution to the
+// aggregates.
+func (a *Assignment) add(r Replica, sign int) {
+	load := a.loads[r.PartitionID]
+	s := float64(sign)
+	a.replicas[r.BrokerID] += s
+	a.bytes[r.BrokerID] += s * float64(load.SizeBytes)
+	a.bytesIn[r.BrokerID] += s * load.BytesInPerSec
+	if r.Role == config.Leader {
+		a.leaders[r.BrokerID] += s
+		a.bytesOut[r.BrokerID] += s * (load.BytesOutPerSec + load.BytesInPerSec*float64(a.partitionSize[r.PartitionID]-1))
+	}
+	a.hosted[r.BrokerID][r.PartitionID] = sign > 0
+
+	// Rack awareness: count replicas that could have gone to an unused DC
+	dcCounts := a.partitionDCs[r.PartitionID]
+	if dcCounts == nil {
+		dcCounts = make(map[int]int)
+		a.partitionDCs[r.PartitionID] = dcCounts
+	}
+	a.rackViolations -= a.partitionViolations(r.PartitionID)
+	dcCounts[a.brokerDC[r.BrokerID]] += sign
+	if dcCounts[a.brokerDC[r.BrokerID]] == 0 {
+		delete(dcCounts, a.brokerDC[r.BrokerID])
+	}
+	a.rackViolations += a.partitionViolations(r.PartitionID)
+}
+
+// RackViolations returns the partitions whose replicas could be spread over
+// more DCs than they are (rack/DC anti-affinity), sorted.
+func (a *Assignment) RackViolations() []int {
+	var ids []int
+	for partitionID := range a.partitionSize {
+		if a.partitionViolations(partitionID) > 0 {
+			ids = append(ids, partitionID)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// partitionViolations is the number of extra DCs a partition could use.
+func (a *Assignment) partitionViolations(partitionID int) int {
+	achievable := a.partitionSize[partitionID]
+	if a.numDCs < achievable {
+		achievable = a.numDCs
+	}
+	if v := achievable - len(a.partitionDCs[partitionID]); v > 0 {
+		return v
+	}
+	return 0
+}
+
+// move relocates replica i to another broker.
+func (a *Assignment) move(i, brokerID int) {
+	a.add(a.Replicas[i], -1)
+	a.Replicas[i].BrokerID = brokerID
+	a.add(a.Replicas[i], 1)
+}
+
+// swapRoles exchanges the roles of two replicas of the same partition.
+func (a *Assignment) swapRoles(i, j int) {
+	a.add(a.Replicas[i], -1)
+	a.add(a.Replicas[j], -1)
+	a.Replicas[i].Role, a.Replicas[j].Role = a.Replicas[j].Role, a.Replicas[i].Role
+	a.add(a.Replicas[i], 1)
+	a.add(a.Replicas[j], 1)
+}
+
+// Apply writes the assignment back into the placement's broker lists.
+func (a *Assignment) Apply(dcs map[int]*config.DCInfo) {
+	brokers := make(map[int]*config.BrokerInfo)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			broker.Replicas = []config.ReplicaInfo{}
+			brokers[broker.ID] = broker
+		}
+	}
+	for _, r := range a.Replicas {
+		brokers[r.BrokerID].Replicas = append(brokers[r.BrokerID].Replicas, config.ReplicaInfo{PartitionID: r.PartitionID, Role: r.Role})
+	}
+}
+
+// Optimize improves the assignment with single replica moves and leadership
+// swaps, in the spirit of Cruise Control: a change is only accepted if it
+// improves the highest-priority scorer it affects without worsening any
+// scorer of higher priority. Each step applies the best such change; at
+// most maxMoves changes are made. It returns the number of changes applied.
+func (a *Assignment) Optimize(scorers []Scorer, maxMoves int) int {
+	current := a.Scores(scorers)
+	moves := 0
+	for ; moves < maxMoves; moves++ {
+		best := current
+		bestApply := func() {}
+
+		for i := range a.Replicas {
+			r := a.Replicas[i]
+			// Candidate 1: move the replica to a broker not hosting the partition
+			for _, target := range a.brokerIDs {
+				if a.hosted[target][r.PartitionID] {
+					continue
+				}
+				from := r.BrokerID
+				a.move(i, target)
+				if scores, ok := a.improves(scorers, best); ok {
+					best = scores
+					idx, to := i, target
+					bestApply = func() { a.move(idx, to) }
+				}
+				a.move(i, from)
+			}
+			// Candidate 2: hand leadership to a follower of the same partition
+			if r.Role != config.Leader {
+				continue
+			}
+			for _, j := range a.byPartition[r.PartitionID] {
+				if a.Replicas[j].Role != config.Follower {
+					continue
+				}
+				a.swapRoles(i, j)
+				if scores, ok := a.improves(scorers, best); ok {
+					best = scores
+					x, y := i, j
+					bestApply = func() { a.swapRoles(x, y) }
+				}
+				a.swapRoles(i, j)
+			}
+		}
+
+		if !lexLess(best, current) {
+			break // Local optimum for the configured goal priorities
+		}
+		bestApply()
+		current = best
+	}
+	return moves
+}
+
+// improves reports whether the assignment beats the reference scores.
+// Scorers are evaluated lazily in priority order, so most candidates are
+// rejected after the first score; the full scores are returned on success.
+func (a *Assignment) improves(scorers []Scorer, ref []float64) ([]float64, bool) {
+	const epsilon = 1e-9
+	for i, s := range scorers {
+		score := s.Score(a)
+		if score > ref[i]+epsilon {
+			return nil, false
+		}
+		if score < ref[i]-epsilon {
+			scores := make([]float64, len(scorers))
+			copy(scores, ref[:i])
+			scores[i] = score
+			for k := i + 1; k < len(scorers); k++ {
+				scores[k] = scorers[k].Score(a)
+			}
+			return scores, true
+		}
+	}
+	return nil, false
+}
+
+// lexLess reports whether score vector a is better than b, comparing scores
+// in priority order with a small tolerance for float noise.
+func lexLess(a, b []float64) bool {
+	const epsilon = 1e-9
+	for i := range a {
+		if a[i] < b[i]-epsilon {
+			return true
+		}
+		if a[i] > b[i]+epsilon {
+			return false
+		}
+	}
+	return false
+}
+
+// coefficientOfVariation returns stddev/mean of the per-broker values, a
+// scale-free imbalance measure (0 when perfectly balanced or all zero).
+func coefficientOfVariation(brokerIDs []int, values map[int]float64) float64 {
+	if len(brokerIDs) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, id := range brokerIDs {
+		sum += values[id]
+	}
+	mean := sum / float64(len(brokerIDs))
+	if mean == 0 {
+		return 0
+	}
+	var variance float64
+	for _, id := range brokerIDs {
+		d := values[id] - mean
+		variance += d * d
+	}
+	return math.Sqrt(variance/float64(len(brokerIDs))) / mean
+}
@@ -0,0 +1,66 @@
+package placement
+
+import (
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// ObserverIssue explains why the observers of an MRC placement cannot do
+// their job with the given inputs, and what to change.
+type ObserverIssue struct {
+	Problem string
+	Fix     string
+}
+
+// ObserverIssues checks the roles an MRC placement of cfg gives its
+// replicas: min ISR - 1 synchronous followers besides the leader, and the
+// remaining replicas observers. Observers only help if there are some,
+// if a follower confirms writes besides the leader, and if a data center
+// holds observers only and so can fail without blocking writes. It returns
+// nil for single clusters and for designs without such problems.
+func ObserverIssues(cfg config.PlacementConfig) []ObserverIssue {
+	if cfg.ClusterType != config.MRC || cfg.ReplicationFactor < 1 || cfg.MinInSyncReplicas < 1 {
+		return nil
+	}
+	rf, minISR, dcs := cfg.ReplicationFactor, cfg.MinInSyncReplicas, cfg.NumDCs
+	followers := minISR - 1
+	observers := rf - 1 - followers
+	var issues []ObserverIssue
+	switch {
+	case observers <= 0:
+		// Every replica has to be in sync, so there is nothing to promote
+		issue := ObserverIssue{Problem: i18n.T("observers.none", rf, minISR)}
+		raised := min(minISR+max(1, dcs-minISR), cfg.TotalBrokers())
+		switch {
+		case raised > rf && rf-1 >= 2: // Min ISR 1 would leave no followers
+			issue.Fix = i18n.T("observers.none.fix", raised, rf-1)
+		case raised > rf:
+			issue.Fix = i18n.T("observers.none.fixRF", raised)
+		default:
+			issue.Fix = i18n.T("observers.none.fixISR", rf-1)
+		}
+		issues = append(issues, issue)
+	case followers == 0:
+		// Observers only: acks=all waits for the leader alone
+		issue := ObserverIssue{Problem: i18n.T("observers.noFollowers", rf)}
+		if rf >= 3 {
+			issue.Fix = i18n.T("observers.noFollowers.fix")
+		} else {
+			issue.Fix = i18n.T("observers.noFollowers.fixRF")
+		}
+		issues = append(issues, issue)
+	}
+	if observers > 0 && dcs >= 2 && minISR >= dcs {
+		// The synchronous replicas span every DC, so no DC holds observers
+		// only and can fail without blocking writes
+		issue := ObserverIssue{Problem: i18n.T("observers.everyDC", minISR, dcs)}
+		if dcs-1 >= 2 {
+			issue.Fix = i18n.T("observers.everyDC.fix", dcs-1, minISR+1)
+		} else {
+			issue.Fix = i18n.T("observers.everyDC.fixDC", minISR+1) // Min ISR 1 has no followers
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
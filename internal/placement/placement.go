@@ -1,12 +1,16 @@
 package placement
 
 import (
-	"fmt"
 	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
 	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
 )
 
 // Package placement contains the logic for simulating Kafka partition placement
@@ -16,79 +20,59 @@ import (
 // It returns a map representing the DCs and brokers with their assigned replicas,
 // and a string containing MRC placement recommendations (if applicable).
 // This is a simplified simulation focusing on distribution.
+// The randomness comes from cfg.Seed (see NewRand).
 func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, string) {
-	// Seed random locally if not already done globally (good practice per package)
-	// Note: If main already seeds, this might be redundant but harmless.
-	// Consider a central seeding strategy if randomness needs strict control.
-	rand.Seed(time.Now().UnixNano())
-
-	dcs := make(map[int]*config.DCInfo)
-	brokerIDCounter := 0
-	totalBrokers := 0
-	mrcRecommendation := ""
+	return Place(cfg, NewRand(cfg.Seed))
+}
 
-	// Initialize DCs and Brokers
-	numDCs := cfg.NumDCs
-	brokersPerDC := cfg.NumBrokers
-	if cfg.ClusterType == config.SingleCluster {
-		numDCs = 1 // Force 1 DC for single cluster type
-		// brokersPerDC remains cfg.NumBrokers (total brokers)
+// NewRand returns the random source for a seed: reproducible for a non-zero
+// seed, seeded from the clock for 0.
+func NewRand(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
 	}
+	return rand.New(rand.NewSource(seed))
+}
 
-	for dcIdx := 0; dcIdx < numDCs; dcIdx++ {
-		dcID := dcIdx + 1 // 1-based DC IDs
-		dcs[dcID] = &config.DCInfo{
-			ID:      dcID,
-			Brokers: make(map[int]*config.BrokerInfo),
-		}
-		// For single cluster, brokersPerDC is the total number of brokers
-		numBrokersInThisDC := brokersPerDC
-		if cfg.ClusterType == config.SingleCluster {
-			numBrokersInThisDC = cfg.NumBrokers // Use the total broker count directly
-		}
-
-		for brokerIdx := 0; brokerIdx < numBrokersInThisDC; brokerIdx++ {
-			// Ensure we don't exceed total brokers if it's a single cluster loop
-			if cfg.ClusterType == config.SingleCluster && brokerIDCounter >= cfg.NumBrokers {
-				break
-			}
-			brokerID := brokerIDCounter
-			dcs[dcID].Brokers[brokerID] = &config.BrokerInfo{
-				ID:       brokerID,
-				Replicas: []config.ReplicaInfo{},
-			}
-			brokerIDCounter++
-		}
-	}
-	totalBrokers = brokerIDCounter
+// Place is CalculatePlacement with an explicit random source, so library
+// users control reproducibility. rng is used by the calling goroutine only;
+// give concurrent placements their own source.
+func Place(cfg config.PlacementConfig, rng *rand.Rand) (map[int]*config.DCInfo, string) {
+	dcs, totalBrokers := buildTopology(cfg)
+	mrcRecommendation := ""
 
 	// --- MRC Recommendation ---
 	if cfg.ClusterType == config.MRC {
-		mrcRecommendation = fmt.Sprintf("Distribute %d replicas across %d DCs for fault tolerance.", cfg.ReplicationFactor, cfg.NumDCs)
+		mrcRecommendation = i18n.T("recommend.distribute", cfg.ReplicationFactor, cfg.NumDCs)
 		if cfg.ReplicationFactor <= cfg.NumDCs {
-			mrcRecommendation += " Aim for at most one replica per DC per partition."
+			mrcRecommendation += i18n.T("recommend.onePerDC")
 		} else {
 			minPerDC := cfg.ReplicationFactor / cfg.NumDCs
 			extra := cfg.ReplicationFactor % cfg.NumDCs
-			mrcRecommendation += fmt.Sprintf(" Aim for ~%d replicas per DC, with %d DCs having an extra replica.", minPerDC, extra)
+			mrcRecommendation += i18n.T("recommend.perDC", minPerDC, extra)
 		}
 	}
 
 	// --- Placement Logic ---
 	allBrokerIDs := make([]int, 0, totalBrokers)
-	for dcID := 1; dcID <= numDCs; dcID++ {
-		// Check if DC exists (important for single cluster case where numDCs=1)
-		if dcInfo, ok := dcs[dcID]; ok {
-			for brokerID := range dcInfo.Brokers {
-				allBrokerIDs = append(allBrokerIDs, brokerID)
-			}
+	dcIDs := make([]int, 0, len(dcs))
+	for dcID := range dcs {
+		dcIDs = append(dcIDs, dcID)
+	}
+	sort.Ints(dcIDs)
+	for _, dcID := range dcIDs {
+		// Sorted within each DC so a seed reproduces the placement
+		first := len(allBrokerIDs)
+		for brokerID := range dcs[dcID].Brokers {
+			allBrokerIDs = append(allBrokerIDs, brokerID)
 		}
+		sort.Ints(allBrokerIDs[first:])
 	}
 	// Ensure allBrokerIDs isn't empty if totalBrokers > 0
 	if totalBrokers > 0 && len(allBrokerIDs) == 0 {
 		// This indicates an issue with DC/Broker initialization logic
-		// For now, return empty results to avoid panic, but log potentially
-		fmt.Println("Warning: No broker IDs collected for placement.")
+		// For now, return empty results to avoid panic, but log it
+		applog.Warnf("No broker IDs collected for placement.")
 		return dcs, mrcRecommendation
 	}
 	if totalBrokers == 0 {
@@ -96,23 +80,65 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 		return dcs, mrcRecommendation
 	}
 
-	for p := 0; p < cfg.NumPartitions; p++ {
+	// Bytes assigned to each broker so far, used by the size-aware strategy
+	brokerBytes := make(map[int]int64, totalBrokers)
+	// Leader DCs by client produce traffic, used by the client-affinity strategy
+	affinity := newLeaderAffinity(cfg, dcs, dcIDs)
+	weights := produceWeights(cfg)
+	// With DCs of different sizes the rack spread fixes how many replicas
+	// each DC gets, so the brokers of a small DC fill up first
+	uneven := cfg.ClusterType == config.MRC && cfg.UnevenRacks()
+	// Racks of a single cluster get the same spread as the DCs of an MRC
+	spread := (cfg.ClusterType == config.MRC && !cfg.LooseRacks) || cfg.RackAware()
+
+	order := partitionOrder(cfg)
+	switch chooseAssigner(cfg) {
+	case assignRackUnaware:
+		placeRackUnaware(cfg, dcs, rng)
+		order = nil
+	case assignRackAware:
+		placeRackAware(cfg, dcs, rng)
+		order = nil
+	}
+	for _, p := range order {
 		partitionID := p + 1 // 1-based partition IDs
+		partitionSize := cfg.PartitionLoads[partitionID].SizeBytes
 
-		// Shuffle brokers for each partition for better distribution simulation
-		shuffledBrokerIDs := make([]int, len(allBrokerIDs))
-		copy(shuffledBrokerIDs, allBrokerIDs)
-		rand.Shuffle(len(shuffledBrokerIDs), func(i, j int) {
-			shuffledBrokerIDs[i], shuffledBrokerIDs[j] = shuffledBrokerIDs[j], shuffledBrokerIDs[i]
-		})
+		var leaderBrokerID int
+		var brokersToTry []int
+		switch cfg.Strategy {
+		case config.StrategySizeAware:
+			// Least-loaded brokers first; the lightest one leads
+			brokersToTry = brokersByBytes(allBrokerIDs, brokerBytes, dcs)
+			leaderBrokerID = brokersToTry[0]
+		default:
+			// Shuffle brokers for each partition for better distribution simulation
+			brokersToTry = make([]int, len(allBrokerIDs))
+			copy(brokersToTry, allBrokerIDs)
+			rng.Shuffle(len(brokersToTry), func(i, j int) {
+				brokersToTry[i], brokersToTry[j] = brokersToTry[j], brokersToTry[i]
+			})
+			if uneven {
+				// Emptiest brokers first: evens out the brokers within each
+				// DC and sends replicas beyond one per DC to the larger DCs
+				sort.SliceStable(brokersToTry, func(i, j int) bool {
+					_, a := findBroker(brokersToTry[i], dcs)
+					_, b := findBroker(brokersToTry[j], dcs)
+					return len(a.Replicas) < len(b.Replicas)
+				})
+			}
 
-		// Determine leader broker (simple modulo for initial placement)
-		leaderBrokerID := allBrokerIDs[p%totalBrokers] // Start leader assignment round-robin
+			// Determine leader broker (simple modulo for initial placement)
+			leaderBrokerID = allBrokerIDs[p%totalBrokers] // Start leader assignment round-robin
+			if affinity != nil {
+				leaderBrokerID = affinity.next(weights[partitionID])
+			}
+		}
 
 		// Find the DC and Broker object for the leader
 		leaderDC, leaderBroker := findBroker(leaderBrokerID, dcs)
 		if leaderBroker == nil {
-			fmt.Printf("Warning: Could not find leader broker %d for partition %d\n", leaderBrokerID, partitionID)
+			applog.Warnf("Could not find leader broker %d for partition %d", leaderBrokerID, partitionID)
 			continue // Skip this partition if leader assignment fails
 		}
 
@@ -121,8 +147,7 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 		assignedBrokerIDs := map[int]bool{leaderBrokerID: true}
 		assignedDCs := map[int]bool{leaderDC.ID: true}
 		replicasPlaced := 1
-
-		brokersToTry := shuffledBrokerIDs // Use shuffled list
+		brokerBytes[leaderBrokerID] += partitionSize
 
 		// Variables only needed for MRC role differentiation
 		var numFollowers, numObservers, targetFollowers, targetObservers int
@@ -142,6 +167,9 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 			if replicasPlaced >= cfg.ReplicationFactor {
 				break
 			} // Stop if RF met
+			if uneven && len(assignedDCs) >= cfg.NumDCs {
+				break // Every DC has one; the second pass starts over from the emptiest broker
+			}
 			if assignedBrokerIDs[brokerID] {
 				continue
 			} // Skip if broker already has a replica for this partition
@@ -153,7 +181,7 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 
 			// MRC Placement Strategy: Try to place in different DCs first
 			placeInThisDC := true
-			if cfg.ClusterType == config.MRC && len(assignedDCs) < cfg.NumDCs {
+			if spread && len(assignedDCs) < len(dcs) {
 				if assignedDCs[dc.ID] {
 					// Check if we can place elsewhere before placing in an already used DC
 					canPlaceElsewhere := false
@@ -195,12 +223,13 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 				broker.Replicas = append(broker.Replicas, config.ReplicaInfo{PartitionID: partitionID, Role: role})
 				assignedBrokerIDs[brokerID] = true
 				assignedDCs[dc.ID] = true // Track used DCs for MRC strategy
+				brokerBytes[brokerID] += partitionSize
 				replicasPlaced++
 			}
 		}
 
-		// Second pass for MRC if needed (allow placing in same DC)
-		if cfg.ClusterType == config.MRC && replicasPlaced < cfg.ReplicationFactor {
+		// Second pass for MRC or racks if needed (allow placing in same DC)
+		if (cfg.ClusterType == config.MRC || cfg.RackAware()) && replicasPlaced < cfg.ReplicationFactor {
 			for _, brokerID := range brokersToTry {
 				if replicasPlaced >= cfg.ReplicationFactor {
 					break
@@ -216,7 +245,9 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 
 				// Assign role based on remaining needs for MRC
 				var role config.ReplicaRole
-				if numFollowers < targetFollowers {
+				if cfg.ClusterType == config.SingleCluster {
+					role = config.Follower
+				} else if numFollowers < targetFollowers {
 					role = config.Follower
 					numFollowers++
 				} else if numObservers < targetObservers {
@@ -230,14 +261,437 @@ func CalculatePlacement(cfg config.PlacementConfig) (map[int]*config.DCInfo, str
 				broker.Replicas = append(broker.Replicas, config.ReplicaInfo{PartitionID: partitionID, Role: role})
 				assignedBrokerIDs[brokerID] = true
 				// assignedDCs doesn't need update here
+				brokerBytes[brokerID] += partitionSize
 				replicasPlaced++
 			}
 		}
 	}
 
+	switch cfg.Strategy {
+	case config.StrategySizeAware:
+		// Size-aware placement also spreads leader traffic across brokers
+		if !cfg.NoLeaderSpread {
+			balanceLeaders(dcs, cfg.PartitionLoads)
+		}
+	case config.StrategyGoals:
+		// Start from the random placement, like Cruise Control rebalancing
+		// an existing cluster, and optimize the goals in priority order
+		scorers, err := ResolveScorers(cfg.Goals)
+		if err != nil {
+			scorers = Scorers()
+		}
+		maxMoves := cfg.MaxMoves
+		if maxMoves <= 0 {
+			maxMoves = cfg.NumPartitions * cfg.ReplicationFactor
+		}
+		assignment := NewAssignment(dcs, cfg.PartitionLoads)
+		assignment.Optimize(scorers, maxMoves)
+		assignment.Apply(dcs)
+	}
+	// Tag constraints apply to every strategy, last so nothing undoes them
+	if len(cfg.AvoidLeaders) > 0 {
+		avoidTaggedLeaders(cfg, dcs)
+	}
+
+	if uneven {
+		mrcRecommendation += unevenRacksNote(dcs, dcIDs)
+	}
+
 	return dcs, mrcRecommendation
 }
 
+// assigner is the algorithm that assigns the replicas of a placement.
+type assigner int
+
+const (
+	assignPerPartition assigner = iota // The strategy picks brokers partition by partition
+	assignRackUnaware                  // Kafka's assignment without broker.rack
+	assignRackAware                    // Kafka's assignment with broker.rack
+)
+
+// chooseAssigner returns the algorithm that places cfg. The rack-aware
+// strategy needs racks: on a single cluster without them it falls back to
+// Kafka's rack-unaware assignment, as Kafka does, and logs a warning.
+func chooseAssigner(cfg config.PlacementConfig) assigner {
+	switch cfg.Strategy {
+	case config.StrategyKafkaDefault:
+		return assignRackUnaware
+	case config.StrategyRackAware:
+		if cfg.ClusterType == config.SingleCluster && !cfg.RackAware() {
+			applog.Warnf("%s", i18n.T("placement.rackFallback", config.StrategyRackAware, config.StrategyKafkaDefault))
+			return assignRackUnaware
+		}
+		return assignRackAware
+	}
+	return assignPerPartition
+}
+
+// unevenRacksNote describes how the replicas load the brokers of DCs with
+// different numbers of brokers: spreading every partition over the DCs gives
+// a small DC as many replicas as a large one, on fewer brokers.
+func unevenRacksNote(dcs map[int]*config.DCInfo, dcIDs []int) string {
+	sizes := make([]string, 0, len(dcs))
+	var hottest *config.DCInfo
+	var hottestLoad float64
+	replicas, brokers := 0, 0
+	for _, id := range dcIDs {
+		dc := dcs[id]
+		sizes = append(sizes, strconv.Itoa(len(dc.Brokers)))
+		n := 0
+		for _, b := range dc.Brokers {
+			n += len(b.Replicas)
+		}
+		replicas += n
+		brokers += len(dc.Brokers)
+		if len(dc.Brokers) == 0 {
+			continue
+		}
+		if load := float64(n) / float64(len(dc.Brokers)); hottest == nil || load > hottestLoad {
+			hottest, hottestLoad = dc, load
+		}
+	}
+	if hottest == nil || replicas == 0 {
+		return ""
+	}
+	ratio := hottestLoad / (float64(replicas) / float64(brokers))
+	if ratio < 1.1 {
+		return i18n.T("recommend.unevenBalanced", strings.Join(sizes, "/"))
+	}
+	return i18n.T("recommend.uneven", strings.Join(sizes, "/"), hottest.Rack(), hottestLoad, ratio)
+}
+
+// Topology returns the DCs and brokers of cfg without any replicas, e.g.
+// to show an assignment that was not calculated by the simulator.
+func Topology(cfg config.PlacementConfig) map[int]*config.DCInfo {
+	dcs, _ := buildTopology(cfg)
+	return dcs
+}
+
+// buildTopology initializes the DCs and brokers of cfg and returns them with
+// the total broker count.
+func buildTopology(cfg config.PlacementConfig) (map[int]*config.DCInfo, int) {
+	dcs := make(map[int]*config.DCInfo)
+	brokerIDCounter := 0
+
+	// Initialize DCs and Brokers
+	numDCs := cfg.NumDCs
+	brokersPerDC := cfg.NumBrokers
+	if cfg.ClusterType == config.SingleCluster {
+		numDCs = 1 // Force 1 DC for single cluster type
+		// brokersPerDC remains cfg.NumBrokers (total brokers)
+	}
+
+	// An explicit broker list (e.g. from an import) replaces the generated topology
+	if len(cfg.Brokers) > 0 {
+		for _, spec := range cfg.Brokers {
+			dc, ok := dcs[spec.DCID]
+			if !ok {
+				dc = &config.DCInfo{
+					ID:      spec.DCID,
+					Name:    cfg.DCNames[spec.DCID],
+					Brokers: make(map[int]*config.BrokerInfo),
+				}
+				dcs[spec.DCID] = dc
+			}
+			dc.Brokers[spec.ID] = &config.BrokerInfo{
+				ID:       spec.ID,
+				Replicas: []config.ReplicaInfo{},
+			}
+		}
+		numDCs = len(dcs)
+	}
+
+	for dcIdx := 0; dcIdx < numDCs && len(cfg.Brokers) == 0; dcIdx++ {
+		dcID := dcIdx + 1 // 1-based DC IDs
+		dcs[dcID] = &config.DCInfo{
+			ID:      dcID,
+			Brokers: make(map[int]*config.BrokerInfo),
+		}
+		// For single cluster, brokersPerDC is the total number of brokers
+		numBrokersInThisDC := brokersPerDC
+		if cfg.ClusterType == config.SingleCluster {
+			numBrokersInThisDC = cfg.NumBrokers // Use the total broker count directly
+		}
+
+		for brokerIdx := 0; brokerIdx < numBrokersInThisDC; brokerIdx++ {
+			// Ensure we don't exceed total brokers if it's a single cluster loop
+			if cfg.ClusterType == config.SingleCluster && brokerIDCounter >= cfg.NumBrokers {
+				break
+			}
+			brokerID := brokerIDCounter
+			dcs[dcID].Brokers[brokerID] = &config.BrokerInfo{
+				ID:       brokerID,
+				Replicas: []config.ReplicaInfo{},
+			}
+			brokerIDCounter++
+		}
+	}
+	totalBrokers := brokerIDCounter
+	if len(cfg.Brokers) > 0 {
+		totalBrokers = len(cfg.Brokers)
+	}
+	if cfg.RackAware() {
+		dcs = groupByRack(cfg, dcs)
+	}
+	return dcs, totalBrokers
+}
+
+// partitionOrder returns the 0-based partition indexes in the order they
+// should be placed. The size-aware strategy places the largest partitions
+// first (greedy longest-processing-time), which keeps the final byte
+// distribution tighter; other strategies use natural order.
+func partitionOrder(cfg config.PlacementConfig) []int {
+	order := make([]int, cfg.NumPartitions)
+	for i := range order {
+		order[i] = i
+	}
+	if cfg.Strategy == config.StrategySizeAware {
+		sort.SliceStable(order, func(i, j int) bool {
+			return cfg.PartitionLoads[order[i]+1].SizeBytes > cfg.PartitionLoads[order[j]+1].SizeBytes
+		})
+	}
+	return order
+}
+
+// brokersByBytes returns the broker IDs ordered by the bytes assigned so far,
+// breaking ties by replica count and then broker ID so that partitions
+// without size information still spread evenly.
+func brokersByBytes(brokerIDs []int, brokerBytes map[int]int64, dcs map[int]*config.DCInfo) []int {
+	replicaCount := make(map[int]int, len(brokerIDs))
+	for _, id := range brokerIDs {
+		if _, broker := findBroker(id, dcs); broker != nil {
+			replicaCount[id] = len(broker.Replicas)
+		}
+	}
+	ordered := make([]int, len(brokerIDs))
+	copy(ordered, brokerIDs)
+	sort.Slice(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if brokerBytes[a] != brokerBytes[b] {
+			return brokerBytes[a] < brokerBytes[b]
+		}
+		if replicaCount[a] != replicaCount[b] {
+			return replicaCount[a] < replicaCount[b]
+		}
+		return a < b
+	})
+	return ordered
+}
+
+// BrokerBytes sums the partition sizes hosted by each broker of a placement.
+func BrokerBytes(dcs map[int]*config.DCInfo, loads map[int]config.PartitionLoad) map[int]int64 {
+	totals := make(map[int]int64)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			totals[broker.ID] = 0
+			for _, replica := range broker.Replicas {
+				totals[broker.ID] += loads[replica.PartitionID].SizeBytes
+			}
+		}
+	}
+	return totals
+}
+
+// NetworkLoad is the estimated network traffic of a broker in bytes/sec.
+type NetworkLoad struct {
+	BytesIn  float64
+	BytesOut float64
+}
+
+// BrokerNetworkLoad estimates each broker's network traffic. A leader
+// receives the produce traffic and serves consumers plus one replication
+// stream per other replica; every other replica fetches the produce traffic.
+func BrokerNetworkLoad(dcs map[int]*config.DCInfo, loads map[int]config.PartitionLoad) map[int]NetworkLoad {
+	// Count replicas per partition to size the replication fan-out
+	replicaCount := make(map[int]int)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, replica := range broker.Replicas {
+				replicaCount[replica.PartitionID]++
+			}
+		}
+	}
+	result := make(map[int]NetworkLoad)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			var nl NetworkLoad
+			for _, replica := range broker.Replicas {
+				load := loads[replica.PartitionID]
+				nl.BytesIn += load.BytesInPerSec
+				if replica.Role == config.Leader {
+					nl.BytesOut += load.BytesOutPerSec + load.BytesInPerSec*float64(replicaCount[replica.PartitionID]-1)
+				}
+			}
+			result[broker.ID] = nl
+		}
+	}
+	return result
+}
+
+// balanceLeaders moves leadership of each partition to whichever of its
+// leader/follower replicas currently carries the least leader traffic
+// (bytes in + out), heaviest partitions first. Observers never lead, so MRC
+// role layouts are preserved.
+func balanceLeaders(dcs map[int]*config.DCInfo, loads map[int]config.PartitionLoad) {
+	type replicaRef struct {
+		brokerID int
+		replica  *config.ReplicaInfo
+	}
+	eligible := make(map[int][]replicaRef) // PartitionID -> replicas able to lead
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for i := range broker.Replicas {
+				replica := &broker.Replicas[i]
+				if replica.Role == config.Leader || replica.Role == config.Follower {
+					eligible[replica.PartitionID] = append(eligible[replica.PartitionID], replicaRef{broker.ID, replica})
+				}
+			}
+		}
+	}
+
+	traffic := func(partitionID int) float64 {
+		return loads[partitionID].BytesInPerSec + loads[partitionID].BytesOutPerSec
+	}
+	partitionIDs := make([]int, 0, len(eligible))
+	for id, refs := range eligible {
+		partitionIDs = append(partitionIDs, id)
+		sort.Slice(refs, func(i, j int) bool { return refs[i].brokerID < refs[j].brokerID })
+	}
+	sort.Slice(partitionIDs, func(i, j int) bool {
+		a, b := partitionIDs[i], partitionIDs[j]
+		if traffic(a) != traffic(b) {
+			return traffic(a) > traffic(b)
+		}
+		return a < b
+	})
+
+	leaderTraffic := make(map[int]float64)
+	leaderCount := make(map[int]int)
+	for _, id := range partitionIDs {
+		var current, best *replicaRef
+		for i := range eligible[id] {
+			ref := &eligible[id][i]
+			if ref.replica.Role == config.Leader {
+				current = ref
+			}
+			if best == nil || leaderTraffic[ref.brokerID] < leaderTraffic[best.brokerID] ||
+				(leaderTraffic[ref.brokerID] == leaderTraffic[best.brokerID] && leaderCount[ref.brokerID] < leaderCount[best.brokerID]) {
+				best = ref
+			}
+		}
+		if current == nil || best == nil {
+			continue
+		}
+		// Keep the current leader unless another replica is strictly better
+		if leaderTraffic[current.brokerID] == leaderTraffic[best.brokerID] && leaderCount[current.brokerID] == leaderCount[best.brokerID] {
+			best = current
+		}
+		if best != current {
+			current.replica.Role, best.replica.Role = best.replica.Role, config.Leader
+		}
+		leaderTraffic[best.brokerID] += traffic(id)
+		leaderCount[best.brokerID]++
+	}
+}
+
+// avoidTaggedLeaders hands the leadership of each partition led by a broker
+// matching cfg.AvoidLeaders to one of its followers that does not match,
+// whichever leads the fewest partitions so far. Partitions whose in-sync
+// replicas all match keep their leader; observers never lead.
+func avoidTaggedLeaders(cfg config.PlacementConfig, dcs map[int]*config.DCInfo) {
+	type replicaRef struct {
+		brokerID int
+		replica  *config.ReplicaInfo
+	}
+	eligible := make(map[int][]replicaRef) // PartitionID -> replicas able to lead
+	leaderCount := make(map[int]int)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for i := range broker.Replicas {
+				replica := &broker.Replicas[i]
+				switch replica.Role {
+				case config.Leader:
+					leaderCount[broker.ID]++
+					fallthrough
+				case config.Follower:
+					eligible[replica.PartitionID] = append(eligible[replica.PartitionID], replicaRef{broker.ID, replica})
+				}
+			}
+		}
+	}
+	partitionIDs := make([]int, 0, len(eligible))
+	for id, refs := range eligible {
+		partitionIDs = append(partitionIDs, id)
+		sort.Slice(refs, func(i, j int) bool { return refs[i].brokerID < refs[j].brokerID })
+	}
+	sort.Ints(partitionIDs)
+
+	for _, id := range partitionIDs {
+		var current, best *replicaRef
+		for i := range eligible[id] {
+			ref := &eligible[id][i]
+			if ref.replica.Role == config.Leader {
+				current = ref
+			} else if !cfg.LeaderAvoided(ref.brokerID) && (best == nil || leaderCount[ref.brokerID] < leaderCount[best.brokerID]) {
+				best = ref
+			}
+		}
+		if current == nil || best == nil || !cfg.LeaderAvoided(current.brokerID) {
+			continue
+		}
+		current.replica.Role, best.replica.Role = config.Follower, config.Leader
+		leaderCount[current.brokerID]--
+		leaderCount[best.brokerID]++
+	}
+}
+
+// AvoidedLeaders returns the partitions, sorted, still led by a broker
+// matching cfg.AvoidLeaders, i.e. without another in-sync replica to lead.
+func AvoidedLeaders(cfg config.PlacementConfig, dcs map[int]*config.DCInfo) []int {
+	var ids []int
+	for _, p := range Partitions(dcs) {
+		for _, id := range p.Brokers[config.Leader] {
+			if cfg.LeaderAvoided(id) {
+				ids = append(ids, p.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// PartitionReplicas lists the brokers holding each role for one partition.
+type PartitionReplicas struct {
+	ID      int
+	Brokers map[config.ReplicaRole][]int // Broker IDs by role, sorted
+}
+
+// Partitions inverts a placement into a per-partition view, sorted by
+// partition ID.
+func Partitions(dcs map[int]*config.DCInfo) []PartitionReplicas {
+	byID := make(map[int]*PartitionReplicas)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, r := range broker.Replicas {
+				p, ok := byID[r.PartitionID]
+				if !ok {
+					p = &PartitionReplicas{ID: r.PartitionID, Brokers: make(map[config.ReplicaRole][]int)}
+					byID[r.PartitionID] = p
+				}
+				p.Brokers[r.Role] = append(p.Brokers[r.Role], broker.ID)
+			}
+		}
+	}
+	result := make([]PartitionReplicas, 0, len(byID))
+	for _, p := range byID {
+		for _, ids := range p.Brokers {
+			sort.Ints(ids)
+		}
+		result = append(result, *p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
 // findBroker searches all DCs to find the broker with the given ID.
 // Kept unexported as it's internal to the placement logic.
 func findBroker(brokerID int, dcs map[int]*config.DCInfo) (*config.DCInfo, *config.BrokerInfo) {
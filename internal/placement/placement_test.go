@@ -0,0 +1,57 @@
+package placement
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// rackConfig is a single cluster of 6 brokers on 3 racks.
+func rackConfig(t *testing.T, strategy config.Strategy, seed int64) config.PlacementConfig {
+	t.Helper()
+	cfg, err := config.NewPlacementConfig(12, 3, 2, config.WithBrokers(6), config.WithAutoRacks(3), config.WithStrategy(strategy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Seed = seed
+	return cfg
+}
+
+// rackOf maps every broker of a placement to its DC, the rack of a single
+// cluster with racks.
+func rackOf(dcs map[int]*config.DCInfo) map[int]int {
+	racks := make(map[int]int)
+	for id, dc := range dcs {
+		for brokerID := range dc.Brokers {
+			racks[brokerID] = id
+		}
+	}
+	return racks
+}
+
+func TestRandomWithRacksSpreadsOverRacks(t *testing.T) {
+	differs := false
+	for seed := int64(1); seed <= 10; seed++ {
+		dcs, _ := CalculatePlacement(rackConfig(t, config.StrategyRandom, seed))
+		racks := rackOf(dcs)
+		for _, p := range Partitions(dcs) {
+			used := make(map[int]bool)
+			for _, ids := range p.Brokers {
+				for _, id := range ids {
+					if used[racks[id]] {
+						t.Fatalf("seed %d: partition %d has two replicas on rack %d", seed, p.ID, racks[id])
+					}
+					used[racks[id]] = true
+				}
+			}
+		}
+		kafka, _ := CalculatePlacement(rackConfig(t, config.StrategyRackAware, seed))
+		if fmt.Sprint(Partitions(dcs)) != fmt.Sprint(Partitions(kafka)) {
+			differs = true
+		}
+	}
+	if !differs {
+		t.Error("random placements with racks are all Kafka's rack-aware assignment")
+	}
+}
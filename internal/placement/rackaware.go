@@ -0,0 +1,208 @@
+package placement
+
+import (
+	"math/rand"
+	"sort"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// groupByRack regroups the brokers of a single cluster into one DC per
+// broker.rack, numbered from 1 in rack name order and named after the rack,
+// so racks show and fail like the DCs of an MRC.
+func groupByRack(cfg config.PlacementConfig, dcs map[int]*config.DCInfo) map[int]*config.DCInfo {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, rack := range cfg.BrokerRacks {
+		if !seen[rack] {
+			seen[rack] = true
+			names = append(names, rack)
+		}
+	}
+	sort.Strings(names)
+	ids := make(map[string]int, len(names))
+	racks := make(map[int]*config.DCInfo, len(names))
+	for i, name := range names {
+		ids[name] = i + 1 // 1-based like DC IDs
+		racks[i+1] = &config.DCInfo{ID: i + 1, Name: name, Brokers: make(map[int]*config.BrokerInfo)}
+	}
+	for _, dc := range dcs {
+		for id, broker := range dc.Brokers {
+			rack, ok := cfg.BrokerRacks[id]
+			if !ok {
+				return dcs // Rejected by Validate; keep the plain topology
+			}
+			racks[ids[rack]].Brokers[id] = broker
+		}
+	}
+	return racks
+}
+
+// placeRackAware assigns the replicas the way Kafka assigns those of a new
+// topic when every broker has a broker.rack: the brokers are listed
+// alternating between racks, leaders go round-robin from a start index,
+// and each further replica takes the next broker at a shifting distance
+// whose rack, and then broker, has no replica of the partition yet. The
+// racks are those of a single cluster's brokers, or the DCs of an MRC.
+func placeRackAware(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, rng *rand.Rand) {
+	brokers, rackOf := rackAlternatedBrokers(dcs)
+	n := len(brokers)
+	if n == 0 {
+		return
+	}
+	startIndex, nextReplicaShift := kafkaStart(cfg, rng, n)
+	assignment := rackAwareAssignment(brokers, rackOf, len(dcs), cfg.NumPartitions, cfg.ReplicationFactor, startIndex, nextReplicaShift)
+	for p, replicas := range assignment {
+		addReplicas(cfg, dcs, p+1, replicas)
+	}
+}
+
+// kafkaStart returns the start index and shift of Kafka's assignment over n
+// brokers: 0 and 0, as with an explicit start index, so the same
+// configuration always gives the same assignment, or drawn from rng as
+// Kafka does when a seed is set.
+func kafkaStart(cfg config.PlacementConfig, rng *rand.Rand, n int) (startIndex, nextReplicaShift int) {
+	if cfg.Seed == 0 {
+		return 0, 0
+	}
+	return rng.Intn(n), rng.Intn(n)
+}
+
+// rackAwareAssignment returns the replicas of every partition, leader
+// first, as Kafka's assignReplicasToBrokersRackAware picks them from the
+// rack-alternated broker list for the given start index and shift.
+func rackAwareAssignment(brokers []int, rackOf map[int]int, numRacks, partitions, replicationFactor, startIndex, nextReplicaShift int) [][]int {
+	n := len(brokers)
+	assignment := make([][]int, partitions)
+	for p := range partitions {
+		if p > 0 && p%n == 0 {
+			nextReplicaShift++
+		}
+		first := (p + startIndex) % n
+		leader := brokers[first]
+		replicas := []int{leader}
+		racksUsed := map[int]bool{rackOf[leader]: true}
+		brokersUsed := map[int]bool{leader: true}
+		for k := 0; len(replicas) < replicationFactor && k < n*n; k++ {
+			broker := brokers[replicaIndex(first, nextReplicaShift*numRacks, k, n)]
+			rack := rackOf[broker]
+			if (!racksUsed[rack] || len(racksUsed) == numRacks) && (!brokersUsed[broker] || len(brokersUsed) == n) {
+				replicas = append(replicas, broker)
+				racksUsed[rack] = true
+				brokersUsed[broker] = true
+			}
+		}
+		assignment[p] = replicas
+	}
+	return assignment
+}
+
+// placeRackUnaware assigns the replicas the way Kafka assigns those of a
+// new topic when the brokers have no broker.rack (or with
+// --disable-rack-aware): the brokers in ID order, leaders round-robin from
+// a start index, and the followers of each leader at a distance that
+// shifts by one after every round of the brokers.
+func placeRackUnaware(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, rng *rand.Rand) {
+	var brokers []int
+	for _, dc := range dcs {
+		for id := range dc.Brokers {
+			brokers = append(brokers, id)
+		}
+	}
+	sort.Ints(brokers)
+	n := len(brokers)
+	if n == 0 {
+		return
+	}
+	startIndex, nextReplicaShift := kafkaStart(cfg, rng, n)
+	assignment := rackUnawareAssignment(brokers, cfg.NumPartitions, cfg.ReplicationFactor, startIndex, nextReplicaShift)
+	for p, replicas := range assignment {
+		addReplicas(cfg, dcs, p+1, replicas)
+	}
+}
+
+// rackUnawareAssignment returns the replicas of every partition, leader
+// first, as Kafka's assignReplicasToBrokersRackUnaware picks them from the
+// sorted broker IDs for the given start index and shift.
+func rackUnawareAssignment(brokers []int, partitions, replicationFactor, startIndex, nextReplicaShift int) [][]int {
+	n := len(brokers)
+	assignment := make([][]int, partitions)
+	for p := range partitions {
+		if p > 0 && p%n == 0 {
+			nextReplicaShift++
+		}
+		first := (p + startIndex) % n
+		replicas := []int{brokers[first]}
+		for j := 0; j < replicationFactor-1; j++ {
+			replicas = append(replicas, brokers[replicaIndex(first, nextReplicaShift, j, n)])
+		}
+		assignment[p] = replicas
+	}
+	return assignment
+}
+
+// addReplicas adds the replicas of a partition to their brokers, the first
+// as the leader. In an MRC the next min ISR - 1 are followers and the rest
+// observers, as the other strategies assign them; in a single cluster
+// every non-leader is a follower.
+func addReplicas(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, partitionID int, replicas []int) {
+	for i, id := range replicas {
+		role := config.Follower
+		switch {
+		case i == 0:
+			role = config.Leader
+		case cfg.ClusterType == config.MRC && i > max(cfg.MinInSyncReplicas-1, 0):
+			role = config.Observer
+		}
+		_, b := findBroker(id, dcs)
+		b.Replicas = append(b.Replicas, config.ReplicaInfo{PartitionID: partitionID, Role: role})
+	}
+}
+
+// rackAlternatedBrokers lists the broker IDs taking one from each rack in
+// turn, lowest ID first within a rack, as Kafka's
+// getRackAlternatedBrokerList does, with the rack (DC ID) of each.
+func rackAlternatedBrokers(dcs map[int]*config.DCInfo) ([]int, map[int]int) {
+	byRack := make([][]int, 0, len(dcs))
+	rackOf := make(map[int]int)
+	for _, id := range sortedIDs(dcs) {
+		var ids []int
+		for brokerID := range dcs[id].Brokers {
+			ids = append(ids, brokerID)
+			rackOf[brokerID] = id
+		}
+		sort.Ints(ids)
+		byRack = append(byRack, ids)
+	}
+	var brokers []int
+	for i := 0; len(brokers) < len(rackOf); i++ {
+		for _, ids := range byRack {
+			if i < len(ids) {
+				brokers = append(brokers, ids[i])
+			}
+		}
+	}
+	return brokers, rackOf
+}
+
+// replicaIndex is the index in the broker list of a partition's next
+// replica candidate: never the leader's, and at a distance that varies
+// with the shift so followers of consecutive leaders spread out.
+func replicaIndex(first, secondReplicaShift, k, n int) int {
+	if n == 1 {
+		return first
+	}
+	shift := 1 + (secondReplicaShift+k)%(n-1)
+	return (first + shift) % n
+}
+
+// sortedIDs returns the DC IDs in order.
+func sortedIDs(dcs map[int]*config.DCInfo) []int {
+	ids := make([]int, 0, len(dcs))
+	for id := range dcs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,90 @@
+package placement
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+func TestChooseAssigner(t *testing.T) {
+	racks := map[int]string{0: "a", 1: "b", 2: "c"}
+	for _, tc := range []struct {
+		strategy config.Strategy
+		cluster  config.ClusterType
+		racks    map[int]string
+		want     assigner
+		warns    bool
+	}{
+		{config.StrategyRandom, config.SingleCluster, nil, assignPerPartition, false},
+		{config.StrategyRandom, config.SingleCluster, racks, assignPerPartition, false},
+		{config.StrategyRandom, config.MRC, nil, assignPerPartition, false},
+		{config.StrategyGoals, config.SingleCluster, racks, assignPerPartition, false},
+		{config.StrategySizeAware, config.SingleCluster, racks, assignPerPartition, false},
+		{config.StrategyClientAffinity, config.MRC, nil, assignPerPartition, false},
+		{config.StrategyKafkaDefault, config.SingleCluster, nil, assignRackUnaware, false},
+		{config.StrategyKafkaDefault, config.SingleCluster, racks, assignRackUnaware, false},
+		{config.StrategyKafkaDefault, config.MRC, nil, assignRackUnaware, false},
+		{config.StrategyRackAware, config.SingleCluster, racks, assignRackAware, false},
+		{config.StrategyRackAware, config.MRC, nil, assignRackAware, false},
+		{config.StrategyRackAware, config.SingleCluster, nil, assignRackUnaware, true},
+	} {
+		cfg := config.PlacementConfig{Strategy: tc.strategy, ClusterType: tc.cluster, BrokerRacks: tc.racks}
+		logged := applog.Count()
+		if got := chooseAssigner(cfg); got != tc.want {
+			t.Errorf("%s, cluster %v, racks %v: assigner %d, want %d", tc.strategy, tc.cluster, tc.racks, got, tc.want)
+		}
+		if warned := applog.Count() > logged; warned != tc.warns {
+			t.Errorf("%s, cluster %v, racks %v: warned %v, want %v", tc.strategy, tc.cluster, tc.racks, warned, tc.warns)
+		}
+	}
+}
+
+// TestRackUnawareAssignment checks the assignment of Kafka's
+// AdminUtilsTest.testReplicaAssignment: 5 brokers, 10 partitions, RF 3,
+// start index and shift 0.
+func TestRackUnawareAssignment(t *testing.T) {
+	want := [][]int{
+		{0, 1, 2}, {1, 2, 3}, {2, 3, 4}, {3, 4, 0}, {4, 0, 1},
+		{0, 2, 3}, {1, 3, 4}, {2, 4, 0}, {3, 0, 1}, {4, 1, 2},
+	}
+	if got := rackUnawareAssignment([]int{0, 1, 2, 3, 4}, 10, 3, 0, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("assignment %v, want %v", got, want)
+	}
+}
+
+// TestRackAwareAssignment checks the example of Kafka's AdminUtils
+// documentation: brokers 0 and 5 on rack1, 3 and 4 on rack2, 1 and 2 on
+// rack3, 6 partitions, RF 3, start index and shift 0.
+func TestRackAwareAssignment(t *testing.T) {
+	cfg, err := config.NewPlacementConfig(6, 3, 2, config.WithBrokers(6), config.WithBrokerRacks(map[int]string{
+		0: "rack1", 1: "rack3", 2: "rack3", 3: "rack2", 4: "rack2", 5: "rack1",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dcs, _ := buildTopology(cfg)
+	brokers, rackOf := rackAlternatedBrokers(dcs)
+	if want := []int{0, 3, 1, 5, 4, 2}; !reflect.DeepEqual(brokers, want) {
+		t.Fatalf("rack-alternated brokers %v, want %v", brokers, want)
+	}
+	want := [][]int{{0, 3, 1}, {3, 1, 5}, {1, 5, 4}, {5, 4, 2}, {4, 2, 0}, {2, 0, 3}}
+	if got := rackAwareAssignment(brokers, rackOf, len(dcs), 6, 3, 0, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("assignment %v, want %v", got, want)
+	}
+}
+
+// TestKafkaStrategiesAreDeterministic checks that without a seed Kafka's
+// assignments don't change from one placement to the next.
+func TestKafkaStrategiesAreDeterministic(t *testing.T) {
+	for _, strategy := range []config.Strategy{config.StrategyKafkaDefault, config.StrategyRackAware} {
+		cfg := rackConfig(t, strategy, 0)
+		first, _ := Place(cfg, NewRand(0))
+		for range 5 {
+			if again, _ := Place(cfg, NewRand(0)); !reflect.DeepEqual(Partitions(again), Partitions(first)) {
+				t.Fatalf("%s: placements %v and %v differ without a seed", strategy, Partitions(first), Partitions(again))
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package placement
+
+import (
+	"fmt"
+	"sort"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// ChangeReplicationFactor returns a copy of the placement with every
+// partition at rf replicas, moving as little as possible, like a
+// kafka-reassign-partitions RF change: existing replicas and leaders stay
+// where they are. New replicas go to a broker not hosting the partition,
+// preferring a DC the partition is not in yet, then the broker with the
+// fewest replicas and bytes. Removed replicas are never the leader and are
+// taken from the DC holding the most replicas of the partition, then from
+// the busiest broker. For MRC the first min ISR - 1 non-leader replicas are
+// synchronous followers and the rest observers, as in Place.
+func ChangeReplicationFactor(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, rf int) (map[int]*config.DCInfo, error) {
+	total := 0
+	for _, dc := range dcs {
+		total += len(dc.Brokers)
+	}
+	if rf < 1 || rf > total {
+		return nil, fmt.Errorf("replication factor must be between 1 and %d brokers", total)
+	}
+	if rf < cfg.MinInSyncReplicas {
+		return nil, fmt.Errorf("replication factor %d is below min ISR %d; acks=all writes would always fail", rf, cfg.MinInSyncReplicas)
+	}
+
+	result := copyDCs(dcs)
+	dcOf := make(map[int]int)
+	brokers := make(map[int]*config.BrokerInfo)
+	var brokerIDs []int
+	for _, dc := range result {
+		for id, b := range dc.Brokers {
+			dcOf[id] = dc.ID
+			brokers[id] = b
+			brokerIDs = append(brokerIDs, id)
+		}
+	}
+	sort.Ints(brokerIDs)
+	bytes := BrokerBytes(result, cfg.PartitionLoads)
+
+	for _, p := range Partitions(result) {
+		hosted := make(map[int]bool)
+		inDC := make(map[int]int)
+		for _, ids := range p.Brokers {
+			for _, id := range ids {
+				hosted[id] = true
+				inDC[dcOf[id]]++
+			}
+		}
+		count := len(hosted)
+
+		for ; count < rf; count++ {
+			best := -1
+			for _, id := range brokerIDs {
+				if hosted[id] {
+					continue
+				}
+				if best < 0 || addBetter(id, best, inDC, dcOf, brokers, bytes) {
+					best = id
+				}
+			}
+			hosted[best] = true
+			inDC[dcOf[best]]++
+			role := config.Follower
+			if cfg.ClusterType == config.MRC {
+				role = config.Observer // Promoted below if the partition lacks followers
+			}
+			brokers[best].Replicas = append(brokers[best].Replicas, config.ReplicaInfo{PartitionID: p.ID, Role: role})
+			bytes[best] += cfg.PartitionLoads[p.ID].SizeBytes
+		}
+
+		for ; count > rf; count-- {
+			best := -1
+			for id := range hosted {
+				if brokerRole(brokers[id], p.ID) == config.Leader {
+					continue
+				}
+				if best < 0 || removeBetter(id, best, p.ID, inDC, dcOf, brokers) {
+					best = id
+				}
+			}
+			delete(hosted, best)
+			inDC[dcOf[best]]--
+			b := brokers[best]
+			for i, r := range b.Replicas {
+				if r.PartitionID == p.ID {
+					b.Replicas = append(b.Replicas[:i], b.Replicas[i+1:]...)
+					break
+				}
+			}
+			bytes[best] -= cfg.PartitionLoads[p.ID].SizeBytes
+		}
+
+		if cfg.ClusterType == config.MRC {
+			assignMRCRoles(cfg, brokers, hosted, p.ID)
+		}
+	}
+	return result, nil
+}
+
+// addBetter reports whether broker a is a better home for a new replica
+// than broker b: a DC with fewer replicas of the partition, then fewer
+// replicas, then fewer bytes, then the lower ID.
+func addBetter(a, b int, inDC, dcOf map[int]int, brokers map[int]*config.BrokerInfo, bytes map[int]int64) bool {
+	if inDC[dcOf[a]] != inDC[dcOf[b]] {
+		return inDC[dcOf[a]] < inDC[dcOf[b]]
+	}
+	if len(brokers[a].Replicas) != len(brokers[b].Replicas) {
+		return len(brokers[a].Replicas) < len(brokers[b].Replicas)
+	}
+	if bytes[a] != bytes[b] {
+		return bytes[a] < bytes[b]
+	}
+	return a < b
+}
+
+// removeBetter reports whether the replica on broker a should go before the
+// one on broker b: a DC with more replicas of the partition, then an
+// observer before a follower, then more replicas, then the higher ID.
+func removeBetter(a, b, partitionID int, inDC, dcOf map[int]int, brokers map[int]*config.BrokerInfo) bool {
+	if inDC[dcOf[a]] != inDC[dcOf[b]] {
+		return inDC[dcOf[a]] > inDC[dcOf[b]]
+	}
+	if ra, rb := brokerRole(brokers[a], partitionID), brokerRole(brokers[b], partitionID); ra != rb {
+		return ra == config.Observer
+	}
+	if len(brokers[a].Replicas) != len(brokers[b].Replicas) {
+		return len(brokers[a].Replicas) > len(brokers[b].Replicas)
+	}
+	return a > b
+}
+
+// brokerRole returns the role of the broker's replica of a partition.
+func brokerRole(b *config.BrokerInfo, partitionID int) config.ReplicaRole {
+	for _, r := range b.Replicas {
+		if r.PartitionID == partitionID {
+			return r.Role
+		}
+	}
+	return config.Follower
+}
+
+// assignMRCRoles keeps min ISR - 1 synchronous followers per partition,
+// promoting observers or demoting followers with the lowest broker IDs
+// first, and makes the other non-leader replicas observers.
+func assignMRCRoles(cfg config.PlacementConfig, brokers map[int]*config.BrokerInfo, hosted map[int]bool, partitionID int) {
+	followers := max(cfg.MinInSyncReplicas-1, 0)
+	ids := make([]int, 0, len(hosted))
+	for id := range hosted {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	// Keep the current followers where possible, so fewer roles change
+	sort.SliceStable(ids, func(i, j int) bool {
+		return brokerRole(brokers[ids[i]], partitionID) == config.Follower && brokerRole(brokers[ids[j]], partitionID) != config.Follower
+	})
+	for _, id := range ids {
+		b := brokers[id]
+		for i := range b.Replicas {
+			r := &b.Replicas[i]
+			if r.PartitionID != partitionID || r.Role == config.Leader {
+				continue
+			}
+			if followers > 0 {
+				r.Role = config.Follower
+				followers--
+			} else {
+				r.Role = config.Observer
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package placement
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Scorer evaluates an assignment. Score reports how far the assignment is
+// from the scorer's objective: 0 means fully satisfied and lower is always
+// better. Scorers are composed by listing them in priority order; the
+// optimizer never trades a higher-priority score for a lower-priority one.
+type Scorer interface {
+	Name() string
+	Score(a *Assignment) float64
+}
+
+// funcScorer adapts a plain function to the Scorer interface.
+type funcScorer struct {
+	name        string
+	description string
+	score       func(a *Assignment) float64
+}
+
+func (s funcScorer) Name() string                { return s.name }
+func (s funcScorer) Description() string         { return s.description }
+func (s funcScorer) Score(a *Assignment) float64 { return s.score(a) }
+
+// NewScorer wraps a scoring function as a Scorer.
+func NewScorer(name, description string, score func(a *Assignment) float64) Scorer {
+	return funcScorer{name: name, description: description, score: score}
+}
+
+// Describe returns a scorer's one-line description, if it provides one.
+func Describe(s Scorer) string {
+	if d, ok := s.(interface{ Description() string }); ok {
+		return d.Description()
+	}
+	return ""
+}
+
+// registry holds all registered scorers in registration order.
+var registry struct {
+	sync.RWMutex
+	scorers []Scorer
+}
+
+// RegisterScorer makes a scorer available by name to the goals strategy,
+// the optimizer and the comparison report. Names are case-insensitive and
+// must be unique.
+func RegisterScorer(s Scorer) error {
+	registry.Lock()
+	defer registry.Unlock()
+	for _, existing := range registry.scorers {
+		if strings.EqualFold(existing.Name(), s.Name()) {
+			return fmt.Errorf("scorer %q already registered", s.Name())
+		}
+	}
+	registry.scorers = append(registry.scorers, s)
+	return nil
+}
+
+// Scorers returns all registered scorers in registration order. The
+// built-in goals come first, in Cruise Control's default priority order.
+func Scorers() []Scorer {
+	registry.RLock()
+	defer registry.RUnlock()
+	result := make([]Scorer, len(registry.scorers))
+	copy(result, registry.scorers)
+	return result
+}
+
+// ScorerNames returns the names of all registered scorers.
+func ScorerNames() []string {
+	scorers := Scorers()
+	names := make([]string, len(scorers))
+	for i, s := range scorers {
+		names[i] = s.Name()
+	}
+	return names
+}
+
+// ResolveScorers looks up registered scorers by name, keeping the given
+// priority order (highest first). An empty list selects all scorers.
+func ResolveScorers(names []string) ([]Scorer, error) {
+	if len(names) == 0 {
+		return Scorers(), nil
+	}
+	all := Scorers()
+	result := make([]Scorer, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, s := range all {
+			if strings.EqualFold(s.Name(), strings.TrimSpace(name)) {
+				result = append(result, s)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown scorer %q (registered: %s)", name, strings.Join(ScorerNames(), ", "))
+		}
+	}
+	return result, nil
+}
+
+// Comparison holds the scores of several strategies on the same config.
+type Comparison struct {
+	Strategies []config.Strategy
+	Scorers    []Scorer
+	Scores     [][]float64 // Scores[strategy][scorer]
+}
+
+// Compare places cfg with every strategy and scores each result with the
+// given scorers. current, if non-nil, is used as the result for
+// cfg.Strategy so the report matches the placement on screen.
+func Compare(cfg config.PlacementConfig, scorers []Scorer, current map[int]*config.DCInfo) Comparison {
+	c := Comparison{Strategies: config.Strategies(), Scorers: scorers}
+	for _, strategy := range c.Strategies {
+		dcs := current
+		if strategy != cfg.Strategy || dcs == nil {
+			variant := cfg
+			variant.Strategy = strategy
+			dcs, _ = CalculatePlacement(variant)
+		}
+		c.Scores = append(c.Scores, NewAssignment(dcs, cfg.PartitionLoads).Scores(scorers))
+	}
+	return c
+}
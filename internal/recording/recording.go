@@ -0,0 +1,77 @@
+// Package recording saves the keys pressed during a TUI session, with the
+// command-line flags and placement seed it started with, and reads them
+// back, so the analysis can be replayed step by step exactly as it was
+// performed, e.g. to demonstrate it to others.
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Recording is a recorded session.
+type Recording struct {
+	RecordedAt time.Time
+	// Args are the command-line flags of the session, without --record and
+	// --replay, so a replay starts from the same configuration.
+	Args []string
+	// Seed is the seed of every placement of the session, so random
+	// placements come back unchanged.
+	Seed  int64
+	Steps []Step
+}
+
+// Step is one key pressed during the session.
+type Step struct {
+	At    time.Duration // Since the recording started
+	Key   string        // As shown to the viewer, e.g. "enter" or "f"
+	Type  int           // Key type, as Bubble Tea numbers them
+	Runes string        `json:",omitempty"` // Typed characters
+	Alt   bool          `json:",omitempty"`
+	Paste bool          `json:",omitempty"`
+}
+
+// New starts a recording of a session started with args, using seed.
+func New(args []string, seed int64) *Recording {
+	return &Recording{RecordedAt: time.Now(), Args: args, Seed: seed}
+}
+
+// Add appends a step, timed from the start of the recording.
+func (r *Recording) Add(s Step) {
+	s.At = time.Since(r.RecordedAt).Round(time.Millisecond)
+	r.Steps = append(r.Steps, s)
+}
+
+// Save writes the recording to path as JSON, creating its directory.
+func Save(path string, r *Recording) error {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding recording: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("saving recording: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("saving recording: %w", err)
+	}
+	return nil
+}
+
+// Load reads a recording saved by Save.
+func Load(path string) (*Recording, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading recording: %w", err)
+	}
+	var r Recording
+	if err := json.Unmarshal(content, &r); err != nil {
+		return nil, fmt.Errorf("parsing recording %s: %w", path, err)
+	}
+	if len(r.Steps) == 0 {
+		return nil, fmt.Errorf("recording %s contains no steps", path)
+	}
+	return &r, nil
+}
@@ -0,0 +1,334 @@
+// Package rules evaluates user-defined validation rules against a placement.
+//
+// A rules file contains one rule per line; blank lines and lines starting
+// with '#' are ignored. Supported rules:
+//
+//	max <N> partitions per broker     (replicas hosted by any broker)
+//	max <N> leaders per broker
+//	max <N> replicas per dc           (replicas of one partition in one DC)
+//	max <SIZE> per broker             (e.g. "max 500GiB per broker", needs sizes)
+//	max leader skew <N>%              ((max - min) / average leaders per broker)
+//	replicas span at least <N> dcs    (every partition)
+//	leaders span at least <N> dcs     (across all partitions)
+//	min failure tolerance <N>         (broker failures every partition survives)
+//	min health score <N>              (0-100, see package health)
+//
+// A failed rule is a breach: it is highlighted above the placement and makes
+// kafka-viz exit non-zero when the final placement still breaches it.
+package rules
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/health"
+)
+
+// Rule is a single parsed validation rule.
+type Rule struct {
+	Text  string // The rule as written in the file
+	check func(p placementView) (bool, string)
+}
+
+// Result is the outcome of evaluating one rule.
+type Result struct {
+	Rule   string
+	Passed bool
+	Detail string // Human readable explanation, e.g. the offending broker
+}
+
+// placementView is the indexed placement the checks operate on.
+type placementView struct {
+	dcs map[int]*config.DCInfo
+	cfg config.PlacementConfig
+}
+
+// parser matches one rule form and builds its check from the captured values.
+type parser struct {
+	pattern *regexp.Regexp
+	build   func(m []string) (func(p placementView) (bool, string), error)
+}
+
+var parsers = []parser{
+	{regexp.MustCompile(`^max (\d+) (?:partitions|replicas) per broker$`), func(m []string) (func(placementView) (bool, string), error) {
+		limit, _ := strconv.Atoi(m[1])
+		return perBrokerMax(limit, "replicas", func(b *config.BrokerInfo, _ placementView) float64 {
+			return float64(len(b.Replicas))
+		}), nil
+	}},
+	{regexp.MustCompile(`^max (\d+) leaders per broker$`), func(m []string) (func(placementView) (bool, string), error) {
+		limit, _ := strconv.Atoi(m[1])
+		return perBrokerMax(limit, "leaders", func(b *config.BrokerInfo, _ placementView) float64 {
+			return float64(countRole(b, config.Leader))
+		}), nil
+	}},
+	{regexp.MustCompile(`^max ([\d.]+)\s*([kmgtp]i?b|b) per broker$`), func(m []string) (func(placementView) (bool, string), error) {
+		limit, err := parseSize(m[1], m[2])
+		if err != nil {
+			return nil, err
+		}
+		return func(p placementView) (bool, string) {
+			for _, b := range sortedBrokers(p.dcs) {
+				var total int64
+				for _, r := range b.Replicas {
+					total += p.cfg.PartitionLoads[r.PartitionID].SizeBytes
+				}
+				if total > limit {
+					return false, fmt.Sprintf("broker %d hosts %d bytes", b.ID, total)
+				}
+			}
+			return true, ""
+		}, nil
+	}},
+	{regexp.MustCompile(`^max (\d+) replicas per dc$`), func(m []string) (func(placementView) (bool, string), error) {
+		limit, _ := strconv.Atoi(m[1])
+		return func(p placementView) (bool, string) {
+			for _, partitionID := range partitionIDs(p.dcs) {
+				counts := partitionDCs(p.dcs, partitionID)
+				dcIDs := make([]int, 0, len(counts))
+				for dcID := range counts {
+					dcIDs = append(dcIDs, dcID)
+				}
+				sort.Ints(dcIDs)
+				for _, dcID := range dcIDs {
+					if count := counts[dcID]; count > limit {
+						return false, fmt.Sprintf("p%d has %d replicas in DC %d", p.cfg.PartitionNumber(partitionID), count, dcID)
+					}
+				}
+			}
+			return true, ""
+		}, nil
+	}},
+	{regexp.MustCompile(`^max leader skew (\d+)%$`), func(m []string) (func(placementView) (bool, string), error) {
+		limit, _ := strconv.Atoi(m[1])
+		return func(p placementView) (bool, string) {
+			brokers := sortedBrokers(p.dcs)
+			if len(brokers) == 0 {
+				return true, ""
+			}
+			minLeaders, maxLeaders, total := -1, 0, 0
+			for _, b := range brokers {
+				n := countRole(b, config.Leader)
+				total += n
+				if n > maxLeaders {
+					maxLeaders = n
+				}
+				if minLeaders < 0 || n < minLeaders {
+					minLeaders = n
+				}
+			}
+			if total == 0 {
+				return true, ""
+			}
+			avg := float64(total) / float64(len(brokers))
+			skew := float64(maxLeaders-minLeaders) / avg * 100
+			if skew > float64(limit) {
+				return false, fmt.Sprintf("leader skew is %.0f%% (min %d, max %d)", skew, minLeaders, maxLeaders)
+			}
+			return true, fmt.Sprintf("leader skew is %.0f%%", skew)
+		}, nil
+	}},
+	{regexp.MustCompile(`^replicas span at least (\d+) dcs?$`), func(m []string) (func(placementView) (bool, string), error) {
+		want, _ := strconv.Atoi(m[1])
+		return func(p placementView) (bool, string) {
+			for _, partitionID := range partitionIDs(p.dcs) {
+				if n := len(partitionDCs(p.dcs, partitionID)); n < want {
+					return false, fmt.Sprintf("p%d spans %d DC(s)", p.cfg.PartitionNumber(partitionID), n)
+				}
+			}
+			return true, ""
+		}, nil
+	}},
+	{regexp.MustCompile(`^leaders span at least (\d+) dcs?$`), func(m []string) (func(placementView) (bool, string), error) {
+		want, _ := strconv.Atoi(m[1])
+		return func(p placementView) (bool, string) {
+			dcsWithLeaders := 0
+			for _, dc := range p.dcs {
+				for _, b := range dc.Brokers {
+					if countRole(b, config.Leader) > 0 {
+						dcsWithLeaders++
+						break
+					}
+				}
+			}
+			if dcsWithLeaders < want {
+				return false, fmt.Sprintf("leaders span %d DC(s)", dcsWithLeaders)
+			}
+			return true, fmt.Sprintf("leaders span %d DC(s)", dcsWithLeaders)
+		}, nil
+	}},
+	{regexp.MustCompile(`^min failure tolerance (\d+)$`), func(m []string) (func(placementView) (bool, string), error) {
+		want, _ := strconv.Atoi(m[1])
+		return func(p placementView) (bool, string) {
+			// A partition keeps a leader as long as one in-sync replica is
+			// left; observers can't take over without a manual promotion.
+			inSync := make(map[int]int)
+			for _, b := range sortedBrokers(p.dcs) {
+				for _, r := range b.Replicas {
+					if r.Role == config.Leader || r.Role == config.Follower {
+						inSync[r.PartitionID]++
+					}
+				}
+			}
+			for _, partitionID := range partitionIDs(p.dcs) {
+				if tolerated := inSync[partitionID] - 1; tolerated < want {
+					return false, fmt.Sprintf("p%d tolerates %d broker failure(s)", p.cfg.PartitionNumber(partitionID), max(tolerated, 0))
+				}
+			}
+			return true, ""
+		}, nil
+	}},
+	{regexp.MustCompile(`^min health score (\d+)$`), func(m []string) (func(placementView) (bool, string), error) {
+		want, _ := strconv.Atoi(m[1])
+		if want > 100 {
+			return nil, fmt.Errorf("health score %d is above 100", want)
+		}
+		return func(p placementView) (bool, string) {
+			report := health.Evaluate(p.dcs, p.cfg.PartitionLoads)
+			detail := fmt.Sprintf("health score is %d (%s)", report.Score, report.Summary())
+			return report.Score >= want, detail
+		}, nil
+	}},
+}
+
+// LoadFile parses a rules file.
+func LoadFile(path string) ([]Rule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading rules file: %w", err)
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse reads rules, one per line.
+func Parse(r io.Reader) ([]Rule, error) {
+	var rules []Rule
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		rule, err := ParseRule(text)
+		if err != nil {
+			return nil, fmt.Errorf("rules line %d: %w", line, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, scanner.Err()
+}
+
+// ParseRule parses a single rule.
+func ParseRule(text string) (Rule, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	for _, p := range parsers {
+		if m := p.pattern.FindStringSubmatch(normalized); m != nil {
+			check, err := p.build(m)
+			if err != nil {
+				return Rule{}, err
+			}
+			return Rule{Text: text, check: check}, nil
+		}
+	}
+	return Rule{}, fmt.Errorf("unrecognized rule %q", text)
+}
+
+// Evaluate checks every rule against a placement of cfg.
+func Evaluate(rules []Rule, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) []Result {
+	view := placementView{dcs: dcs, cfg: cfg}
+	results := make([]Result, 0, len(rules))
+	for _, rule := range rules {
+		passed, detail := rule.check(view)
+		results = append(results, Result{Rule: rule.Text, Passed: passed, Detail: detail})
+	}
+	return results
+}
+
+// perBrokerMax builds a check that fails when any broker's metric exceeds limit.
+func perBrokerMax(limit int, what string, metric func(*config.BrokerInfo, placementView) float64) func(placementView) (bool, string) {
+	return func(p placementView) (bool, string) {
+		for _, b := range sortedBrokers(p.dcs) {
+			if v := metric(b, p); v > float64(limit) {
+				return false, fmt.Sprintf("broker %d has %.0f %s", b.ID, v, what)
+			}
+		}
+		return true, ""
+	}
+}
+
+func sortedBrokers(dcs map[int]*config.DCInfo) []*config.BrokerInfo {
+	var brokers []*config.BrokerInfo
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			brokers = append(brokers, b)
+		}
+	}
+	sort.Slice(brokers, func(i, j int) bool { return brokers[i].ID < brokers[j].ID })
+	return brokers
+}
+
+func countRole(b *config.BrokerInfo, role config.ReplicaRole) int {
+	n := 0
+	for _, r := range b.Replicas {
+		if r.Role == role {
+			n++
+		}
+	}
+	return n
+}
+
+func partitionIDs(dcs map[int]*config.DCInfo) []int {
+	seen := make(map[int]bool)
+	var ids []int
+	for _, b := range sortedBrokers(dcs) {
+		for _, r := range b.Replicas {
+			if !seen[r.PartitionID] {
+				seen[r.PartitionID] = true
+				ids = append(ids, r.PartitionID)
+			}
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// partitionDCs counts a partition's replicas per DC.
+func partitionDCs(dcs map[int]*config.DCInfo, partitionID int) map[int]int {
+	counts := make(map[int]int)
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			for _, r := range b.Replicas {
+				if r.PartitionID == partitionID {
+					counts[dc.ID]++
+				}
+			}
+		}
+	}
+	return counts
+}
+
+// parseSize converts a number and unit (b, kb, kib, ...) to bytes.
+func parseSize(number, unit string) (int64, error) {
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", number)
+	}
+	exp := strings.Index("bkmgtp", unit[:1])
+	base := 1000.0
+	if strings.Contains(unit, "i") {
+		base = 1024
+	}
+	for ; exp > 0; exp-- {
+		value *= base
+	}
+	return int64(value), nil
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// TestFailureToleranceNumbersPartitions checks the breach detail shows the
+// partition as the rest of the UI does, 0-based with ZeroBasedPartitions.
+func TestFailureToleranceNumbersPartitions(t *testing.T) {
+	// Partition 1 has two in-sync replicas, partition 2 only one
+	dcs := map[int]*config.DCInfo{1: {ID: 1, Brokers: map[int]*config.BrokerInfo{
+		1: {ID: 1, Replicas: []config.ReplicaInfo{{PartitionID: 1, Role: config.Leader}, {PartitionID: 2, Role: config.Leader}}},
+		2: {ID: 2, Replicas: []config.ReplicaInfo{{PartitionID: 1, Role: config.Follower}, {PartitionID: 2, Role: config.Observer}}},
+	}}}
+	rule, err := ParseRule("min failure tolerance 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		zeroBased bool
+		want      string
+	}{
+		{false, "p2 tolerates 0 broker failure(s)"},
+		{true, "p1 tolerates 0 broker failure(s)"},
+	} {
+		got := Evaluate([]Rule{rule}, config.PlacementConfig{ZeroBasedPartitions: tc.zeroBased}, dcs)[0]
+		if got.Passed || got.Detail != tc.want {
+			t.Errorf("zero-based %v: passed %v, detail %q, want a breach with %q", tc.zeroBased, got.Passed, got.Detail, tc.want)
+		}
+	}
+}
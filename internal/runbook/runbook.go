@@ -0,0 +1,337 @@
+// Package runbook generates a Markdown disaster recovery runbook for an MRC
+// placement: how to detect the loss of a data center, which observers to
+// promote, which configs to change and what the placement looks like after
+// failover, for each data center that can be lost.
+package runbook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// WriteFile writes the runbook for an MRC placement to path.
+func WriteFile(path string, cfg config.PlacementConfig, dcs map[int]*config.DCInfo, t failover.Timing) error {
+	var buf bytes.Buffer
+	if err := Write(&buf, cfg, dcs, t); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing runbook: %w", err)
+	}
+	return nil
+}
+
+// Write renders the runbook for an MRC placement.
+func Write(w io.Writer, cfg config.PlacementConfig, dcs map[int]*config.DCInfo, t failover.Timing) error {
+	if cfg.ClusterType != config.MRC || len(dcs) < 2 {
+		return fmt.Errorf("a DR runbook needs an MRC placement with at least 2 data centers")
+	}
+	topic := topicName(cfg)
+	dcIDs := make([]int, 0, len(dcs))
+	for id := range dcs {
+		dcIDs = append(dcIDs, id)
+	}
+	sort.Ints(dcIDs)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# DR runbook: %s\n\n", topic)
+	fmt.Fprintf(&b, "Generated from a simulated placement: %d data centers, %d partitions, replication factor %d, min ISR %d, strategy %s.\n\n",
+		len(dcs), cfg.NumPartitions, cfg.ReplicationFactor, cfg.MinInSyncReplicas, cfg.Strategy)
+	b.WriteString("| Data center | Rack | Brokers |\n|---|---|---|\n")
+	for _, id := range dcIDs {
+		fmt.Fprintf(&b, "| %d | %s | %s |\n", id, dcs[id].Rack(), joinInts(brokerIDs(dcs[id])))
+	}
+
+	b.WriteString("\n## Failure detection checks\n\n")
+	b.WriteString("Run these against a broker in a surviving data center:\n\n")
+	fmt.Fprintf(&b, "1. Confirm the controller quorum still has a leader: `kafka-metadata-quorum --bootstrap-server <surviving-broker>:9092 describe --status`.\n")
+	fmt.Fprintf(&b, "2. List partitions without a leader: `kafka-topics --bootstrap-server <surviving-broker>:9092 --describe --topic %s --unavailable-partitions`.\n", topic)
+	fmt.Fprintf(&b, "3. List partitions below min ISR: `kafka-topics --bootstrap-server <surviving-broker>:9092 --describe --topic %s --under-min-isr-partitions`.\n", topic)
+	b.WriteString("4. Check the `OfflinePartitionsCount` and `UnderMinIsrPartitionCount` metrics and that every broker of the suspect data center is unreachable, not just its network link.\n")
+
+	estimates := make(map[int]failover.DCLossEstimate)
+	for _, e := range failover.SimulateDCLoss(dcs, failover.NewQuorum(cfg, dcs), t, failover.AsyncObservers) {
+		estimates[e.DCID] = e
+	}
+	for _, lost := range dcIDs {
+		writeDCLoss(&b, cfg, dcs, lost, estimates[lost])
+	}
+
+	b.WriteString("\n## Failback\n\n")
+	b.WriteString("1. Bring the lost data center's brokers back; they rejoin as followers and catch up.\n")
+	b.WriteString("2. Restore the original `min.insync.replicas` and placement constraints.\n")
+	fmt.Fprintf(&b, "3. Move leadership back to the preferred leaders: `kafka-leader-election --bootstrap-server <broker>:9092 --election-type PREFERRED --topic %s --all-topic-partitions`.\n", topic)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// partitionAfter is the expected state of one partition after failover.
+type partitionAfter struct {
+	partition int
+	leader    int
+	inSync    []int // Followers
+	observers []int
+	promoted  bool // The leader is a promoted observer
+	automatic bool // A follower took over automatically
+	offline   bool // No replica survives
+}
+
+// plan is the expected outcome of losing one data center.
+type plan struct {
+	partitions     []partitionAfter
+	minInSync      int         // Fewest in-sync replicas left on any partition
+	replicasPerDC  map[int]int // Most in-sync replicas of a partition per surviving DC
+	observersPerDC map[int]int // Most observers of a partition per surviving DC
+}
+
+// planDCLoss works out the placement after losing data center lost: a
+// surviving follower takes over leadership, failing that an observer is
+// promoted.
+func planDCLoss(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, lost int) plan {
+	brokerDC := make(map[int]int)
+	for id, dc := range dcs {
+		for brokerID := range dc.Brokers {
+			brokerDC[brokerID] = id
+		}
+	}
+	surviving := func(ids []int) []int {
+		var result []int
+		for _, id := range ids {
+			if brokerDC[id] != lost {
+				result = append(result, id)
+			}
+		}
+		return result
+	}
+
+	pl := plan{minInSync: cfg.ReplicationFactor, replicasPerDC: make(map[int]int), observersPerDC: make(map[int]int)}
+	for _, p := range placement.Partitions(dcs) {
+		leaders := surviving(p.Brokers[config.Leader])
+		followers := surviving(p.Brokers[config.Follower])
+		observers := surviving(p.Brokers[config.Observer])
+		row := partitionAfter{partition: p.ID, observers: observers}
+		switch {
+		case len(leaders) > 0:
+			row.leader = leaders[0]
+		case len(followers) > 0:
+			row.leader, followers = followers[0], followers[1:]
+			row.automatic = true
+		case len(observers) > 0:
+			row.leader, row.observers = observers[0], observers[1:]
+			row.promoted = true
+		default:
+			row.offline = true
+		}
+		row.inSync = followers
+		pl.partitions = append(pl.partitions, row)
+		if row.offline {
+			continue
+		}
+		pl.minInSync = min(pl.minInSync, 1+len(followers))
+		counts := map[int]int{brokerDC[row.leader]: 1}
+		for _, id := range followers {
+			counts[brokerDC[id]]++
+		}
+		for dcID, n := range counts {
+			pl.replicasPerDC[dcID] = max(pl.replicasPerDC[dcID], n)
+		}
+		obs := make(map[int]int)
+		for _, id := range row.observers {
+			obs[brokerDC[id]]++
+		}
+		for dcID, n := range obs {
+			pl.observersPerDC[dcID] = max(pl.observersPerDC[dcID], n)
+		}
+	}
+	return pl
+}
+
+// PromotionCommands returns the shell commands that promote the observers
+// taking over after losing data center lost: new placement constraints that
+// make them in-sync replicas, an unclean election per partition (Kafka
+// numbers partitions from 0) and, if needed, a lower min.insync.replicas.
+// It returns nil when no observer has to be promoted.
+func PromotionCommands(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, lost int) []string {
+	pl := planDCLoss(cfg, dcs, lost)
+	var partitions []int
+	for _, row := range pl.partitions {
+		if row.promoted {
+			partitions = append(partitions, row.partition)
+		}
+	}
+	if len(partitions) == 0 {
+		return nil
+	}
+	topic := topicName(cfg)
+	file := fmt.Sprintf("placement-without-dc%d.json", lost)
+	constraints, _ := json.Marshal(placementConstraints(dcs, pl.replicasPerDC, pl.observersPerDC))
+	commands := []string{
+		"BOOTSTRAP=<surviving-broker>:9092",
+		fmt.Sprintf("cat > %s <<'EOF'\n%s\nEOF", file, constraints),
+		fmt.Sprintf("kafka-configs --bootstrap-server $BOOTSTRAP --alter --entity-type topics --entity-name %s --replica-placement %s", topic, file),
+	}
+	if pl.minInSync < cfg.MinInSyncReplicas {
+		commands = append(commands, fmt.Sprintf("kafka-configs --bootstrap-server $BOOTSTRAP --alter --entity-type topics --entity-name %s --add-config min.insync.replicas=%d", topic, pl.minInSync))
+	}
+	for _, p := range partitions {
+		commands = append(commands, fmt.Sprintf("kafka-leader-election --bootstrap-server $BOOTSTRAP --election-type UNCLEAN --topic %s --partition %d", topic, p-1))
+	}
+	return commands
+}
+
+// writeDCLoss writes the section for losing one data center.
+func writeDCLoss(b *strings.Builder, cfg config.PlacementConfig, dcs map[int]*config.DCInfo, lost int, e failover.DCLossEstimate) {
+	topic := topicName(cfg)
+	fmt.Fprintf(b, "\n## Loss of data center %d (%s)\n\n", lost, dcs[lost].Rack())
+	rpo := "0"
+	if e.RPO > 0 {
+		rpo = "up to " + e.RPO.Round(time.Millisecond).String()
+	}
+	rto := "none"
+	switch {
+	case e.QuorumLost:
+		rto = "unbounded (controller quorum lost)"
+	case e.RTO > 0:
+		rto = e.RTO.Round(time.Millisecond).String()
+	}
+	fmt.Fprintf(b, "%d partitions are led here. Estimated RPO %s, RTO %s.\n", e.Affected, rpo, rto)
+	if e.QuorumLost {
+		b.WriteString("\n**This data center holds a majority of the KRaft controller voters.** Losing it stops all leader elections and observer promotions until the quorum is recovered, so the steps below only apply once a majority of the controllers is back.\n")
+	}
+
+	pl := planDCLoss(cfg, dcs, lost)
+	var automatic, promote, offline []string
+	for _, row := range pl.partitions {
+		switch {
+		case row.automatic:
+			automatic = append(automatic, fmt.Sprintf("p%d → broker %d", cfg.PartitionNumber(row.partition), row.leader))
+		case row.promoted:
+			promote = append(promote, fmt.Sprintf("p%d → observer on broker %d", cfg.PartitionNumber(row.partition), row.leader))
+		case row.offline:
+			offline = append(offline, fmt.Sprintf("p%d", cfg.PartitionNumber(row.partition)))
+		}
+	}
+
+	b.WriteString("\n### Automatic leader elections\n\n")
+	if len(automatic) == 0 {
+		b.WriteString("None: no partition led here has an in-sync replica in another data center.\n")
+	} else {
+		b.WriteString("The controller moves leadership to a surviving in-sync replica; no action is needed:\n\n")
+		for _, line := range automatic {
+			b.WriteString("- " + line + "\n")
+		}
+	}
+
+	b.WriteString("\n### Observers to promote\n\n")
+	if len(promote) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		fmt.Fprintf(b, "These partitions have only observers left. Promoting them loses up to %s of writes:\n\n", e.RPO)
+		for _, line := range promote {
+			b.WriteString("- " + line + "\n")
+		}
+		b.WriteString("\nRun against a surviving broker:\n\n```bash\n")
+		b.WriteString(strings.Join(PromotionCommands(cfg, dcs, lost), "\n"))
+		b.WriteString("\n```\n")
+	}
+	if len(offline) > 0 {
+		fmt.Fprintf(b, "\n**No replica survives for %s.** These stay offline until data center %d returns.\n", strings.Join(offline, ", "), lost)
+	}
+
+	b.WriteString("\n### Configs to change\n\n")
+	if pl.minInSync < cfg.MinInSyncReplicas {
+		fmt.Fprintf(b, "- Lower `min.insync.replicas` from %d to %d, or `acks=all` producers fail: `kafka-configs --bootstrap-server <surviving-broker>:9092 --alter --entity-type topics --entity-name %s --add-config min.insync.replicas=%d`.\n",
+			cfg.MinInSyncReplicas, pl.minInSync, topic, pl.minInSync)
+	} else {
+		fmt.Fprintf(b, "- `min.insync.replicas=%d` can stay: every partition keeps enough in-sync replicas.\n", cfg.MinInSyncReplicas)
+	}
+	constraints, _ := json.Marshal(placementConstraints(dcs, pl.replicasPerDC, pl.observersPerDC))
+	fmt.Fprintf(b, "- Set the placement constraints to the surviving data centers: `kafka-configs --bootstrap-server <surviving-broker>:9092 --alter --entity-type topics --entity-name %s --replica-placement <file>` with:\n\n```json\n%s\n```\n",
+		topic, constraints)
+
+	b.WriteString("\n### Expected placement after failover\n\n")
+	b.WriteString("| Partition | Leader | In-sync followers | Observers | Note |\n|---|---|---|---|---|\n")
+	for _, row := range pl.partitions {
+		if row.offline {
+			fmt.Fprintf(b, "| %d | - | - | - | offline |\n", cfg.PartitionNumber(row.partition))
+			continue
+		}
+		note := ""
+		if row.promoted {
+			note = "promoted observer"
+		}
+		fmt.Fprintf(b, "| %d | %d | %s | %s | %s |\n", cfg.PartitionNumber(row.partition), row.leader, joinInts(row.inSync), joinInts(row.observers), note)
+	}
+}
+
+// constraint is one entry of Confluent's replica placement JSON.
+type constraint struct {
+	Count       int               `json:"count"`
+	Constraints map[string]string `json:"constraints"`
+}
+
+type constraints struct {
+	Version   int          `json:"version"`
+	Replicas  []constraint `json:"replicas"`
+	Observers []constraint `json:"observers,omitempty"`
+}
+
+func placementConstraints(dcs map[int]*config.DCInfo, replicas, observers map[int]int) constraints {
+	c := constraints{Version: 1}
+	for _, id := range sortedKeys(replicas) {
+		c.Replicas = append(c.Replicas, constraint{Count: replicas[id], Constraints: map[string]string{"rack": dcs[id].Rack()}})
+	}
+	for _, id := range sortedKeys(observers) {
+		c.Observers = append(c.Observers, constraint{Count: observers[id], Constraints: map[string]string{"rack": dcs[id].Rack()}})
+	}
+	return c
+}
+
+// topicName returns the topic name used in commands.
+func topicName(cfg config.PlacementConfig) string {
+	if cfg.TopicName == "" {
+		return "topic" // The wizard does not ask for a name
+	}
+	return cfg.TopicName
+}
+
+func brokerIDs(dc *config.DCInfo) []int {
+	ids := make([]int, 0, len(dc.Brokers))
+	for id := range dc.Brokers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func sortedKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func joinInts(ids []int) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ", ")
+}
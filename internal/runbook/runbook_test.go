@@ -0,0 +1,54 @@
+package runbook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+)
+
+// twoDCPlacement leads partitions 1 and 2 from DC 1; DC 2 holds a follower
+// of partition 1 and an observer of partition 2.
+func twoDCPlacement() map[int]*config.DCInfo {
+	return map[int]*config.DCInfo{
+		1: {ID: 1, Name: "east", Brokers: map[int]*config.BrokerInfo{
+			1: {ID: 1, Replicas: []config.ReplicaInfo{{PartitionID: 1, Role: config.Leader}, {PartitionID: 2, Role: config.Leader}}},
+		}},
+		2: {ID: 2, Name: "west", Brokers: map[int]*config.BrokerInfo{
+			2: {ID: 2, Replicas: []config.ReplicaInfo{{PartitionID: 1, Role: config.Follower}, {PartitionID: 2, Role: config.Observer}}},
+		}},
+	}
+}
+
+func TestWriteNumbersFailoverTable(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		zeroBased bool
+		want      []string
+	}{
+		{"one-based", false, []string{"| 1 | 2 | - | - |  |", "| 2 | 2 | - | - | promoted observer |", "p1 → broker 2", "p2 → observer on broker 2"}},
+		{"zero-based", true, []string{"| 0 | 2 | - | - |  |", "| 1 | 2 | - | - | promoted observer |", "p0 → broker 2", "p1 → observer on broker 2"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.PlacementConfig{
+				ClusterType:         config.MRC,
+				NumDCs:              2,
+				NumPartitions:       2,
+				ReplicationFactor:   2,
+				MinInSyncReplicas:   2,
+				TopicName:           "orders",
+				ZeroBasedPartitions: tc.zeroBased,
+			}
+			var b strings.Builder
+			if err := Write(&b, cfg, twoDCPlacement(), failover.Timing{}); err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(b.String(), want) {
+					t.Errorf("runbook lacks %q:\n%s", want, b.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Package scenarios holds the bundled library of example clusters: small,
+// well-known setups that each illustrate one lesson about partition
+// placement, with notes on what to look for.
+package scenarios
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Scenario is one example of the library.
+type Scenario struct {
+	Name    string
+	Summary string // One line for the list
+	Notes   string // What the placement shows and what to try
+	Config  config.PlacementConfig
+}
+
+const gib = 1 << 30
+
+// library is the bundled scenario list, in the order it is browsed.
+var library = []Scenario{
+	{
+		Name:    "Classic 3-broker",
+		Summary: "3 brokers, RF 3, min ISR 2: the textbook production setup",
+		Notes: "Every broker holds a replica of every partition and leads a third of them. " +
+			"One broker may fail without blocking acks=all writes; a second failure keeps the data " +
+			"readable but drops below min ISR. Press F for the failure estimates.",
+		Config: config.PlacementConfig{
+			ClusterType:       config.SingleCluster,
+			NumBrokers:        3,
+			NumDCs:            1,
+			NumPartitions:     6,
+			ReplicationFactor: 3,
+			MinInSyncReplicas: 2,
+			TopicName:         "orders",
+		},
+	},
+	{
+		Name:    "2-DC MRC pitfall",
+		Summary: "2 data centers, RF 4, min ISR 3: a stretch cluster that can't lose a DC",
+		Notes: "With min ISR 3 there are three in-sync replicas (leader and two followers) but only two DCs, " +
+			"so one DC always holds at least two of them. Losing that DC leaves fewer than min ISR in sync: " +
+			"acks=all writes stop until the observer is promoted. Press F, then D to pick the lost DC, for the " +
+			"promotion commands; a third DC, even a small one, avoids this.",
+		Config: config.PlacementConfig{
+			ClusterType:       config.MRC,
+			NumBrokers:        2,
+			NumDCs:            2,
+			NumPartitions:     6,
+			ReplicationFactor: 4,
+			MinInSyncReplicas: 3,
+			TopicName:         "payments",
+		},
+	},
+	{
+		Name:    "3-DC stretch",
+		Summary: "3 data centers, RF 3, min ISR 2: survives the loss of any one DC",
+		Notes: "Each partition has one replica per DC, so losing a whole DC leaves two in-sync replicas, " +
+			"exactly min ISR 2, and writes continue after the leader election. The price is cross-DC " +
+			"latency on every acks=all write.",
+		Config: config.PlacementConfig{
+			ClusterType:       config.MRC,
+			NumBrokers:        2,
+			NumDCs:            3,
+			NumPartitions:     12,
+			ReplicationFactor: 3,
+			MinInSyncReplicas: 2,
+			TopicName:         "events",
+		},
+	},
+	{
+		Name:    "Hot partition",
+		Summary: "6 brokers, one partition 40 times the size of the others",
+		Notes: "p1 holds 200 GiB while the other partitions hold 5 GiB each. Balancing replica counts " +
+			"leaves the brokers of p1 far fuller than the rest: press H for the distribution, then P to " +
+			"switch to the size-aware strategy and compare. No placement fixes the skew on p1's own " +
+			"brokers; more partitions or a better key does.",
+		Config: config.PlacementConfig{
+			ClusterType:       config.SingleCluster,
+			NumBrokers:        6,
+			NumDCs:            1,
+			NumPartitions:     12,
+			ReplicationFactor: 3,
+			MinInSyncReplicas: 2,
+			TopicName:         "clickstream",
+			PartitionLoads:    hotPartitionLoads(12),
+		},
+	},
+	{
+		Name:    "Spot brokers",
+		Summary: "6 brokers, half on spot instances kept from leading, RF 2",
+		Notes: "Brokers 3 to 5 run on spot instances the cloud may reclaim at short notice, so leaders are " +
+			"kept off them (lifecycle=spot). Press / to highlight them. A partition whose two replicas both " +
+			"landed on spot brokers has no other replica to lead and is flagged in the header; with RF 3 " +
+			"every partition would also have an on-demand replica.",
+		Config: config.PlacementConfig{
+			ClusterType:       config.SingleCluster,
+			NumBrokers:        6,
+			NumDCs:            1,
+			NumPartitions:     12,
+			ReplicationFactor: 2,
+			MinInSyncReplicas: 1,
+			TopicName:         "telemetry",
+			BrokerTags: map[int]map[string]string{
+				0: {"instance": "m5.xlarge", "lifecycle": "on-demand"},
+				1: {"instance": "m5.xlarge", "lifecycle": "on-demand"},
+				2: {"instance": "m5.xlarge", "lifecycle": "on-demand"},
+				3: {"instance": "m5.xlarge", "lifecycle": "spot"},
+				4: {"instance": "m5.xlarge", "lifecycle": "spot"},
+				5: {"instance": "m5.xlarge", "lifecycle": "spot"},
+			},
+			AvoidLeaders: []config.TagSelector{{Key: "lifecycle", Value: "spot"}},
+		},
+	},
+}
+
+// hotPartitionLoads sizes partition 1 at 200 GiB and every other of the n
+// partitions at 5 GiB, with traffic in proportion.
+func hotPartitionLoads(n int) map[int]config.PartitionLoad {
+	loads := make(map[int]config.PartitionLoad, n)
+	for p := 1; p <= n; p++ {
+		size := int64(5 * gib)
+		if p == 1 {
+			size = 200 * gib
+		}
+		rate := float64(size) / (7 * 24 * 3600) // A week of retention
+		loads[p] = config.PartitionLoad{SizeBytes: size, BytesInPerSec: rate, BytesOutPerSec: 2 * rate}
+	}
+	return loads
+}
+
+// All returns the scenarios in library order.
+func All() []Scenario {
+	return append([]Scenario(nil), library...)
+}
+
+// Find returns the scenario with the given name, case-insensitively.
+func Find(name string) (Scenario, error) {
+	names := make([]string, len(library))
+	for i, s := range library {
+		if strings.EqualFold(s.Name, name) {
+			return s, nil
+		}
+		names[i] = fmt.Sprintf("%q", s.Name)
+	}
+	return Scenario{}, fmt.Errorf("unknown scenario %q (available: %s)", name, strings.Join(names, ", "))
+}
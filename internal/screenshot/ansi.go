@@ -0,0 +1,170 @@
+package screenshot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// span is a run of text in one style.
+type span struct {
+	text string
+	fg   string // Hex color, "" for the terminal's default
+	bg   string
+	bold bool
+}
+
+// parse splits a rendered view into lines of styled spans, following the
+// SGR escape sequences lipgloss emits; other escape sequences are dropped.
+func parse(view string) [][]span {
+	var lines [][]span
+	var style span
+	for _, raw := range strings.Split(strings.TrimRight(view, "\n"), "\n") {
+		var line []span
+		var text strings.Builder
+		flush := func() {
+			if text.Len() > 0 {
+				s := style
+				s.text = text.String()
+				line = append(line, s)
+				text.Reset()
+			}
+		}
+		for i := 0; i < len(raw); i++ {
+			if raw[i] != 0x1b {
+				text.WriteByte(raw[i])
+				continue
+			}
+			if i+1 >= len(raw) || raw[i+1] != '[' {
+				continue
+			}
+			// A CSI sequence runs until its final byte, 0x40-0x7E
+			end := i + 2
+			for end < len(raw) && (raw[end] < 0x40 || raw[end] > 0x7e) {
+				end++
+			}
+			if end < len(raw) && raw[end] == 'm' {
+				flush()
+				style = applySGR(style, raw[i+2:end])
+			}
+			i = end
+		}
+		flush()
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// wrap breaks the lines wider than width, as the terminal does.
+func wrap(lines [][]span, width int) [][]span {
+	if width <= 0 {
+		return lines
+	}
+	var wrapped [][]span
+	for _, line := range lines {
+		var row []span
+		col := 0
+		for _, s := range line {
+			var text strings.Builder
+			for _, r := range s.text {
+				w := runewidth.RuneWidth(r)
+				if col+w > width {
+					if text.Len() > 0 {
+						row = append(row, span{text.String(), s.fg, s.bg, s.bold})
+						text.Reset()
+					}
+					wrapped = append(wrapped, row)
+					row, col = nil, 0
+				}
+				text.WriteRune(r)
+				col += w
+			}
+			if text.Len() > 0 {
+				row = append(row, span{text.String(), s.fg, s.bg, s.bold})
+			}
+		}
+		wrapped = append(wrapped, row)
+	}
+	return wrapped
+}
+
+// applySGR applies the parameters of a Select Graphic Rendition sequence.
+func applySGR(s span, params string) span {
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		n, _ := strconv.Atoi(codes[i]) // "" is 0, a reset
+		switch {
+		case n == 0:
+			s = span{}
+		case n == 1:
+			s.bold = true
+		case n == 22:
+			s.bold = false
+		case n == 39:
+			s.fg = ""
+		case n == 49:
+			s.bg = ""
+		case n >= 30 && n <= 37:
+			s.fg = ansi256(n - 30)
+		case n >= 90 && n <= 97:
+			s.fg = ansi256(n - 90 + 8)
+		case n >= 40 && n <= 47:
+			s.bg = ansi256(n - 40)
+		case n >= 100 && n <= 107:
+			s.bg = ansi256(n - 100 + 8)
+		case n == 38 || n == 48:
+			color, used := extendedColor(codes[i+1:])
+			i += used
+			if n == 38 {
+				s.fg = color
+			} else {
+				s.bg = color
+			}
+		}
+	}
+	return s
+}
+
+// extendedColor reads a 5;n (256 colors) or 2;r;g;b (true color) color and
+// returns it with the number of parameters it used.
+func extendedColor(codes []string) (string, int) {
+	num := func(i int) int {
+		if i >= len(codes) {
+			return 0
+		}
+		n, _ := strconv.Atoi(codes[i])
+		return n
+	}
+	switch num(0) {
+	case 5:
+		return ansi256(num(1)), 2
+	case 2:
+		return fmt.Sprintf("#%02X%02X%02X", num(1), num(2), num(3)), 4
+	}
+	return "", 1
+}
+
+// ansi16 are the xterm colors of the 16 basic ANSI colors.
+var ansi16 = []string{
+	"#000000", "#CD0000", "#00CD00", "#CDCD00", "#0000EE", "#CD00CD", "#00CDCD", "#E5E5E5",
+	"#7F7F7F", "#FF0000", "#00FF00", "#FFFF00", "#5C5CFF", "#FF00FF", "#00FFFF", "#FFFFFF",
+}
+
+// ansi256 returns the xterm color of a 256-color palette index: the basic
+// colors, a 6x6x6 color cube and a grayscale ramp.
+func ansi256(n int) string {
+	switch {
+	case n < 0 || n > 255:
+		return ""
+	case n < 16:
+		return ansi16[n]
+	case n < 232:
+		levels := []int{0, 95, 135, 175, 215, 255}
+		n -= 16
+		return fmt.Sprintf("#%02X%02X%02X", levels[n/36], levels[n/6%6], levels[n%6])
+	}
+	gray := 8 + 10*(n-232)
+	return fmt.Sprintf("#%02X%02X%02X", gray, gray, gray)
+}
@@ -0,0 +1,139 @@
+// Package screenshot saves what the TUI shows, under a caption block saying
+// what it is, as a text file or an SVG picture for incident reviews and
+// design docs.
+package screenshot
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// Format is how a screenshot is written.
+type Format string
+
+const (
+	FormatSVG  Format = "svg"  // A picture in the terminal's colors
+	FormatText Format = "text" // Plain text, without colors
+)
+
+// ParseFormat returns the format with the given name.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case FormatSVG, FormatText:
+		return Format(name), nil
+	}
+	return "", fmt.Errorf("unknown screenshot format %q (expected svg or text)", name)
+}
+
+// Ext returns the file extension of the format.
+func (f Format) Ext() string {
+	if f == FormatText {
+		return ".txt"
+	}
+	return ".svg"
+}
+
+// Caption says what a screenshot shows.
+type Caption struct {
+	Scenario string // The scenario, imported cluster or topology shown
+	TakenAt  time.Time
+	Health   string // e.g. "87/100 (balance 92, failure tolerance 70, constraints 100)"
+	Failure  string // The failure being simulated, "" for none
+}
+
+// Lines returns the caption block, one field per line.
+func (c Caption) Lines() []string {
+	failure := c.Failure
+	if failure == "" {
+		failure = i18n.T("screenshot.caption.none")
+	}
+	lines := []string{
+		i18n.T("screenshot.caption.scenario", c.Scenario),
+		i18n.T("screenshot.caption.taken", c.TakenAt.Format("2006-01-02 15:04:05 MST")),
+	}
+	if c.Health != "" {
+		lines = append(lines, i18n.T("screenshot.caption.health", c.Health))
+	}
+	return append(lines, i18n.T("screenshot.caption.failure", failure))
+}
+
+// Screen is what the terminal showed.
+type Screen struct {
+	View  string // As rendered, with its escape sequences
+	Width int    // Columns long lines wrap at, as in the terminal; 0 for none
+	Dark  bool   // The terminal background is dark, for the SVG colors
+}
+
+// Write writes the screen under the caption.
+func Write(w io.Writer, format Format, s Screen, c Caption) error {
+	lines := wrap(parse(s.View), s.Width)
+	if format == FormatText {
+		return writeText(w, lines, c)
+	}
+	return writeSVG(w, lines, c, s.Dark)
+}
+
+// WriteFile writes a screenshot into dir, named after the time it was
+// taken, and returns its path. dir is created if needed.
+func WriteFile(dir string, format Format, s Screen, c Caption) (string, error) {
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("creating screenshot directory: %w", err)
+	}
+	name := "kafka-viz-" + c.TakenAt.Format("20060102-150405")
+	path := filepath.Join(dir, name+format.Ext())
+	for i := 2; fileExists(path); i++ {
+		path = filepath.Join(dir, fmt.Sprintf("%s-%d%s", name, i, format.Ext())) // Several in one second
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("writing screenshot: %w", err)
+	}
+	if err := Write(f, format, s, c); err != nil {
+		f.Close()
+		return "", fmt.Errorf("writing screenshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("writing screenshot: %w", err)
+	}
+	return path, nil
+}
+
+// fileExists reports whether path names an existing file.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// writeText writes the caption block, a rule, and the view without its
+// colors.
+func writeText(w io.Writer, lines [][]span, c Caption) error {
+	var b strings.Builder
+	caption := c.Lines()
+	width := 0
+	for _, line := range caption {
+		width = max(width, len([]rune(line)))
+	}
+	for _, line := range caption {
+		b.WriteString(line + "\n")
+	}
+	b.WriteString(strings.Repeat("─", width) + "\n")
+	for _, line := range lines {
+		var text strings.Builder
+		for _, s := range line {
+			text.WriteString(s.text)
+		}
+		b.WriteString(strings.TrimRight(text.String(), " ") + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
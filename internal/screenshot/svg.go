@@ -0,0 +1,92 @@
+package screenshot
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	svgCell     = 8.4 // Width of a terminal cell at the font size
+	svgLine     = 17  // Height of a terminal line
+	svgMargin   = 16  // Space around the caption and the view
+	svgFontSize = 14
+)
+
+// svgTheme are the default colors of the terminal the view was drawn for.
+type svgTheme struct{ bg, fg, captionBg string }
+
+var (
+	darkTheme  = svgTheme{bg: "#1E1E1E", fg: "#D4D4D4", captionBg: "#2D2D30"}
+	lightTheme = svgTheme{bg: "#FFFFFF", fg: "#1E1E1E", captionBg: "#EEEEEE"}
+)
+
+// writeSVG draws the caption block over the view, cell by cell as the
+// terminal showed it, in its colors.
+func writeSVG(w io.Writer, lines [][]span, c Caption, dark bool) error {
+	theme := lightTheme
+	if dark {
+		theme = darkTheme
+	}
+	var body strings.Builder
+	text := func(x float64, y int, color string, bold bool, s string) {
+		weight := "normal"
+		if bold {
+			weight = "bold"
+		}
+		fmt.Fprintf(&body, "<text x=\"%.1f\" y=\"%d\" fill=\"%s\" font-weight=\"%s\">%s</text>\n", x, y, color, weight, html.EscapeString(s))
+	}
+
+	// Caption block, the first line in bold
+	caption := c.Lines()
+	cols := 0
+	for _, line := range caption {
+		cols = max(cols, runewidth.StringWidth(line))
+	}
+	for _, line := range lines {
+		n := 0
+		for _, s := range line {
+			n += runewidth.StringWidth(s.text)
+		}
+		cols = max(cols, n)
+	}
+	width := int(float64(cols)*svgCell) + 2*svgMargin
+	captionHeight := len(caption)*svgLine + svgMargin
+	fmt.Fprintf(&body, "<rect x=\"0\" y=\"0\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", width, captionHeight, theme.captionBg)
+	y := svgMargin / 2
+	for i, line := range caption {
+		y += svgLine
+		text(svgMargin, y-4, theme.fg, i == 0, line)
+	}
+
+	// The view, line by line
+	y = captionHeight + svgMargin/2
+	for _, line := range lines {
+		col := 0
+		for _, s := range line {
+			x := svgMargin + float64(col)*svgCell
+			n := runewidth.StringWidth(s.text)
+			if s.bg != "" {
+				fmt.Fprintf(&body, "<rect x=\"%.1f\" y=\"%d\" width=\"%.1f\" height=\"%d\" fill=\"%s\"/>\n", x, y, float64(n)*svgCell, svgLine, s.bg)
+			}
+			if strings.TrimSpace(s.text) != "" {
+				fg := s.fg
+				if fg == "" {
+					fg = theme.fg
+				}
+				text(x, y+svgLine-4, fg, s.bold, s.text)
+			}
+			col += n
+		}
+		y += svgLine
+	}
+	height := y + svgMargin/2
+
+	_, err := fmt.Fprintf(w, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" "+
+		"style=\"font-family:monospace;font-size:%dpx;white-space:pre\" xml:space=\"preserve\">\n"+
+		"<rect width=\"100%%\" height=\"100%%\" fill=\"%s\"/>\n%s</svg>\n", width, height, svgFontSize, theme.bg, body.String())
+	return err
+}
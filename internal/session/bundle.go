@@ -0,0 +1,63 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// BundleVersion is the bundle format written by SaveBundle. Bundles of a
+// newer version are rejected rather than half understood.
+const BundleVersion = 1
+
+// Bundle is a placement to share for review: the session with the exact
+// placement (so a random or hand-tuned result opens unchanged on another
+// machine), the library scenario it started from and the notes added to it.
+type Bundle struct {
+	Version int
+	Session
+	Scenario    string       `json:",omitempty"` // Name of the library scenario, if any
+	Annotations []Annotation `json:",omitempty"`
+}
+
+// Annotation is a free-text note on the placement, or on one broker or
+// partition of it.
+type Annotation struct {
+	Broker    *int   `json:",omitempty"` // nil for a note on the whole placement
+	Partition *int   `json:",omitempty"` // 1-based partition ID, whatever the numbering shown
+	Topic     string `json:",omitempty"` // Topic of the partition, in multi-topic placements
+	Text      string
+}
+
+// SaveBundle writes the bundle to path as indented JSON, stamping it with
+// the current format version.
+func SaveBundle(path string, b *Bundle) error {
+	b.Version = BundleVersion
+	content, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding bundle: %w", err)
+	}
+	if err := writeFileAtomic(path, content); err != nil {
+		return fmt.Errorf("saving bundle: %w", err)
+	}
+	return nil
+}
+
+// LoadBundle reads a bundle written by SaveBundle.
+func LoadBundle(path string) (*Bundle, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading bundle: %w", err)
+	}
+	var b Bundle
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, fmt.Errorf("parsing bundle %s: %w", path, err)
+	}
+	if b.Version > BundleVersion {
+		return nil, fmt.Errorf("bundle %s has format version %d, this kafka-viz reads up to %d", path, b.Version, BundleVersion)
+	}
+	if len(b.Placement) == 0 {
+		return nil, fmt.Errorf("bundle %s contains no placement", path)
+	}
+	return &b, nil
+}
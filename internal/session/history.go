@@ -0,0 +1,97 @@
+package session
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/health"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// Snapshot records the metrics of one saved placement, so the trend of a
+// cluster can be charted across runs without keeping every placement.
+type Snapshot struct {
+	SavedAt          time.Time
+	Cluster          string // Identifies the cluster, snapshots are compared per cluster
+	Strategy         config.Strategy
+	Health           health.Report
+	ReplicaImbalance float64 // Coefficient of variation of replicas per broker
+	LeaderImbalance  float64 // Coefficient of variation of leaders per broker
+}
+
+// NewSnapshot measures a placement.
+func NewSnapshot(cluster string, cfg config.PlacementConfig, dcs map[int]*config.DCInfo) Snapshot {
+	a := placement.NewAssignment(dcs, cfg.PartitionLoads)
+	return Snapshot{
+		SavedAt:          time.Now(),
+		Cluster:          cluster,
+		Strategy:         cfg.Strategy,
+		Health:           health.Evaluate(dcs, cfg.PartitionLoads),
+		ReplicaImbalance: a.Imbalance(func(id int) float64 { return float64(a.ReplicaCount(id)) }),
+		LeaderImbalance:  a.Imbalance(func(id int) float64 { return float64(a.LeaderCount(id)) }),
+	}
+}
+
+// DefaultHistoryPath returns the history file in the user's config directory.
+func DefaultHistoryPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating config directory: %w", err)
+	}
+	return filepath.Join(dir, "kafka-viz", "history.jsonl"), nil
+}
+
+// AppendHistory adds a snapshot to the history file, one JSON object per
+// line, creating the file and its directory.
+func AppendHistory(path string, s Snapshot) error {
+	line, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("saving history: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("saving history: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("saving history: %w", err)
+	}
+	return f.Close()
+}
+
+// LoadHistory reads all snapshots, oldest first. A missing file returns
+// (nil, nil); a damaged line, e.g. from an interrupted append, is skipped.
+func LoadHistory(path string) ([]Snapshot, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading history: %w", err)
+	}
+	defer f.Close()
+
+	var snapshots []Snapshot
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var s Snapshot
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			continue
+		}
+		snapshots = append(snapshots, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading history: %w", err)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,80 @@
+// Package session persists the last placement shown by the TUI so it can be
+// resumed on the next start, e.g. after an accidental Ctrl+C, keeps a
+// history of the metrics of every run to chart their trend, and reads and
+// writes bundles that share a placement with teammates.
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Session is a saved placement: the config it was calculated from and the
+// exact result, so a random placement comes back unchanged.
+type Session struct {
+	SavedAt           time.Time
+	Source            string // Where the configuration came from, if not the wizard
+	Config            config.PlacementConfig
+	Placement         map[int]*config.DCInfo
+	MRCRecommendation string
+}
+
+// DefaultPath returns the session file in the user's config directory.
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating config directory: %w", err)
+	}
+	return filepath.Join(dir, "kafka-viz", "last-session.json"), nil
+}
+
+// Save writes the session to path, creating its directory. The file is
+// replaced atomically so an interrupted save never corrupts the last one.
+func Save(path string, s *Session) error {
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding session: %w", err)
+	}
+	if err := writeFileAtomic(path, content); err != nil {
+		return fmt.Errorf("saving session: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes content to path via a temporary file and a
+// rename, creating the directory first.
+func writeFileAtomic(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Load reads a saved session. A missing file returns (nil, nil).
+func Load(path string) (*Session, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading session: %w", err)
+	}
+	var s Session
+	if err := json.Unmarshal(content, &s); err != nil {
+		return nil, fmt.Errorf("parsing session %s: %w", path, err)
+	}
+	if len(s.Placement) == 0 {
+		return nil, fmt.Errorf("session %s contains no placement", path)
+	}
+	return &s, nil
+}
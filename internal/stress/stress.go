@@ -0,0 +1,263 @@
+// Package stress generates randomized but realistic clusters (broker
+// counts, DC layouts, topic mixes and skewed partition sizes) and measures
+// how every placement strategy behaves on them, for exploring the
+// strategies at scale without the TUI. Generation is seeded, so a run can
+// be reproduced.
+package stress
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/health"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// Skew is how a topic's bytes are spread over its partitions.
+type Skew int
+
+const (
+	SkewUniform Skew = iota // Every partition the same size
+	SkewZipf                // Partition i holds 1/i of the first one's bytes, as with skewed keys
+	SkewHot                 // One partition holds ten times the others
+)
+
+// String returns the skew's name.
+func (s Skew) String() string {
+	switch s {
+	case SkewZipf:
+		return "zipf"
+	case SkewHot:
+		return "hot-partition"
+	}
+	return "uniform"
+}
+
+// Realistic sizes: broker counts of single clusters and per DC of stretch
+// clusters, and the partition counts and total sizes of topic classes.
+var (
+	singleBrokers = []int{3, 3, 4, 5, 6, 6, 9, 12, 18, 24, 36, 48}
+	dcBrokers     = []int{2, 3, 3, 4, 6, 8}
+	topicClasses  = []struct {
+		name       string
+		partitions []int
+		minBytes   int64
+		maxBytes   int64
+	}{
+		{"small", []int{1, 3, 6}, 1 << 30, 10 << 30},
+		{"medium", []int{12, 24, 32, 48}, 50 << 30, 500 << 30},
+		{"large", []int{64, 128, 256}, 1 << 40, 5 << 40},
+	}
+)
+
+// Topic is one generated topic, placed on its scenario's cluster.
+type Topic struct {
+	Class  string // small, medium or large
+	Skew   Skew
+	Config config.PlacementConfig // The cluster with this topic, its loads and a placement seed
+}
+
+// Scenario is one generated cluster and the topics placed on it.
+type Scenario struct {
+	Name   string // e.g. "s3-mrc-3x4"
+	Topics []Topic
+}
+
+// Generate returns n random clusters, the same ones for the same seed. A
+// third are stretch (MRC) clusters over 2 or 3 DCs. Each hosts one to four
+// topics of mixed sizes, with a replication factor of 3 where the cluster
+// allows it, min ISR one below it, and a throughput proportional to their
+// size.
+func Generate(seed int64, n int) []Scenario {
+	rng := rand.New(rand.NewSource(seed))
+	scenarios := make([]Scenario, 0, n)
+	for i := 1; i <= n; i++ {
+		base := config.PlacementConfig{ClusterType: config.SingleCluster, NumDCs: 1}
+		name := ""
+		if rng.Intn(3) == 0 {
+			base.ClusterType = config.MRC
+			base.NumDCs = 2 + rng.Intn(2)
+			base.NumBrokers = pick(rng, dcBrokers)
+			name = fmt.Sprintf("s%d-mrc-%dx%d", i, base.NumDCs, base.NumBrokers)
+		} else {
+			base.NumBrokers = pick(rng, singleBrokers)
+			name = fmt.Sprintf("s%d-single-%d", i, base.NumBrokers)
+		}
+		total := base.TotalBrokers()
+
+		s := Scenario{Name: name}
+		numTopics := 1 + rng.Intn(4)
+		for t := 1; t <= numTopics; t++ {
+			class := topicClasses[rng.Intn(len(topicClasses))]
+			spec := config.TopicSpec{
+				Name:              fmt.Sprintf("%s-%d", class.name, t),
+				NumPartitions:     pick(rng, class.partitions),
+				ReplicationFactor: min(3, total),
+			}
+			if base.ClusterType == config.MRC && total >= 4 && rng.Intn(3) == 0 {
+				spec.ReplicationFactor = 4 // Two replicas per DC on two DCs
+			}
+			spec.MinInSyncReplicas = max(1, spec.ReplicationFactor-1)
+			cfg := base.WithTopic(spec)
+			skew := Skew(rng.Intn(3))
+			size := class.minBytes + rng.Int63n(class.maxBytes-class.minBytes)
+			cfg.PartitionLoads = loads(rng, spec.NumPartitions, size, skew)
+			cfg.Seed = rng.Int63()
+			s.Topics = append(s.Topics, Topic{Class: class.name, Skew: skew, Config: cfg})
+		}
+		scenarios = append(scenarios, s)
+	}
+	return scenarios
+}
+
+// loads spreads a topic's bytes over its partitions by skew. Produce
+// traffic assumes a week of retention and consumers reading everything
+// twice.
+func loads(rng *rand.Rand, partitions int, size int64, skew Skew) map[int]config.PartitionLoad {
+	weights := make([]float64, partitions)
+	var sum float64
+	hot := rng.Intn(partitions)
+	for i := range weights {
+		switch skew {
+		case SkewZipf:
+			weights[i] = 1 / float64(i+1)
+		case SkewHot:
+			weights[i] = 1
+			if i == hot {
+				weights[i] = 10
+			}
+		default:
+			weights[i] = 1
+		}
+		sum += weights[i]
+	}
+	const retention = 7 * 24 * time.Hour
+	result := make(map[int]config.PartitionLoad, partitions)
+	for i, w := range weights {
+		bytes := int64(float64(size) * w / sum)
+		in := float64(bytes) / retention.Seconds()
+		result[i+1] = config.PartitionLoad{SizeBytes: bytes, BytesInPerSec: in, BytesOutPerSec: 2 * in}
+	}
+	return result
+}
+
+func pick(rng *rand.Rand, values []int) int {
+	return values[rng.Intn(len(values))]
+}
+
+// Result is how one strategy placed one topic of a scenario.
+type Result struct {
+	Scenario         Scenario
+	Topic            Topic
+	Strategy         config.Strategy
+	Health           health.Report
+	ReplicaImbalance float64 // Coefficient of variation of replicas per broker
+	LeaderImbalance  float64 // Coefficient of variation of leaders per broker
+	BytesImbalance   float64 // Coefficient of variation of bytes per broker
+	Scores           []float64
+	Elapsed          time.Duration
+}
+
+// Run places every topic of every scenario with each strategy, using the
+// topic's seed, and measures the placements with the scorers. goals and
+// maxMoves configure the goals strategy as on the command line.
+func Run(scenarios []Scenario, strategies []config.Strategy, scorers []placement.Scorer, goals []string, maxMoves int) []Result {
+	var results []Result
+	for _, s := range scenarios {
+		for _, t := range s.Topics {
+			for _, strategy := range strategies {
+				cfg := t.Config
+				cfg.Strategy = strategy
+				cfg.Goals, cfg.MaxMoves = goals, maxMoves
+				start := time.Now()
+				dcs, _ := placement.CalculatePlacement(cfg)
+				elapsed := time.Since(start)
+				a := placement.NewAssignment(dcs, cfg.PartitionLoads)
+				results = append(results, Result{
+					Scenario:         s,
+					Topic:            t,
+					Strategy:         strategy,
+					Health:           health.Evaluate(dcs, cfg.PartitionLoads),
+					ReplicaImbalance: a.Imbalance(func(id int) float64 { return float64(a.ReplicaCount(id)) }),
+					LeaderImbalance:  a.Imbalance(func(id int) float64 { return float64(a.LeaderCount(id)) }),
+					BytesImbalance:   a.Imbalance(func(id int) float64 { return float64(a.BrokerBytes(id)) }),
+					Scores:           a.Scores(scorers),
+					Elapsed:          elapsed,
+				})
+			}
+		}
+	}
+	return results
+}
+
+// WriteCSV writes the metrics CSV: one row per scenario, topic and
+// strategy with the cluster and topic shape, the health score and its
+// components, the per-broker imbalances, one column per scorer and the
+// placement time in milliseconds, the only column that differs between
+// runs of the same seed.
+func WriteCSV(w io.Writer, results []Result, scorers []placement.Scorer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"scenario", "cluster_type", "brokers", "dcs", "topic", "partitions",
+		"replication_factor", "min_isr", "size_bytes", "skew", "strategy", "health"}
+	if len(results) > 0 {
+		for _, c := range results[0].Health.Components {
+			header = append(header, "health_"+strings.ReplaceAll(c.Name, " ", "_"))
+		}
+	}
+	header = append(header, "replica_imbalance", "leader_imbalance", "bytes_imbalance")
+	for _, s := range scorers {
+		header = append(header, s.Name())
+	}
+	header = append(header, "place_ms")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		cfg := r.Topic.Config
+		var size int64
+		for _, load := range cfg.PartitionLoads {
+			size += load.SizeBytes
+		}
+		row := []string{r.Scenario.Name, clusterType(cfg), strconv.Itoa(cfg.TotalBrokers()), strconv.Itoa(cfg.NumDCs),
+			cfg.TopicName, strconv.Itoa(cfg.NumPartitions), strconv.Itoa(cfg.ReplicationFactor),
+			strconv.Itoa(cfg.MinInSyncReplicas), strconv.FormatInt(size, 10), r.Topic.Skew.String(),
+			r.Strategy.String(), strconv.Itoa(r.Health.Score)}
+		for _, c := range r.Health.Components {
+			row = append(row, strconv.Itoa(c.Score))
+		}
+		row = append(row, ratio(r.ReplicaImbalance), ratio(r.LeaderImbalance), ratio(r.BytesImbalance))
+		for _, score := range r.Scores {
+			row = append(row, ratio(score))
+		}
+		row = append(row, strconv.FormatFloat(float64(r.Elapsed.Microseconds())/1000, 'f', 1, 64))
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func clusterType(cfg config.PlacementConfig) string {
+	if cfg.ClusterType == config.MRC {
+		return "mrc"
+	}
+	return "single"
+}
+
+// ratio formats a metric with 4 decimals, dropping trailing zeros.
+func ratio(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return ""
+	}
+	return strconv.FormatFloat(math.Round(v*1e4)/1e4, 'f', -1, 64)
+}
@@ -0,0 +1,486 @@
+// Package strimzi reconstructs the topology and topic configuration of a
+// Strimzi-managed Kafka cluster from its custom resources (Kafka and
+// KafkaTopic), the broker pods and, optionally, the zone labels of the
+// Kubernetes nodes those pods are scheduled on.
+package strimzi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// DefaultZoneLabel is the node label used to find a broker's zone when the
+// Kafka CR does not configure a rack topology key.
+const DefaultZoneLabel = "topology.kubernetes.io/zone"
+
+// Broker is a single Kafka broker pod of the imported cluster.
+type Broker struct {
+	ID   int
+	Zone string // Empty if the zone could not be determined
+}
+
+// Topic holds the settings of a single KafkaTopic resource.
+type Topic struct {
+	Name              string
+	Partitions        int
+	ReplicationFactor int
+	MinInSyncReplicas int
+}
+
+// Cluster is the reconstructed view of a Strimzi Kafka cluster.
+type Cluster struct {
+	Name         string
+	ID           string   // Kafka cluster ID from the CR status, empty before the first reconcile
+	KafkaVersion string   // From the CR status, else the version requested in the spec
+	MetadataMode string   // "KRaft" or "ZooKeeper", empty if the status does not say
+	Controllers  []Broker // KRaft controller or ZooKeeper nodes, sorted by ID; empty without pods
+	Brokers      []Broker // Sorted by broker ID
+	Topics       []Topic  // Sorted by topic name
+}
+
+// object is the subset of a Kubernetes object the importer cares about.
+// All kinds share this struct; a List carries its members in Items.
+type object struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name   string            `json:"name"`
+		Labels map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Spec   json.RawMessage `json:"spec"`
+	Status json.RawMessage `json:"status"`
+	Items  []object        `json:"items"`
+}
+
+type kafkaSpec struct {
+	Kafka struct {
+		Version  string `json:"version"`
+		Replicas int    `json:"replicas"`
+		Rack     *struct {
+			TopologyKey string `json:"topologyKey"`
+		} `json:"rack"`
+		Config map[string]interface{} `json:"config"`
+	} `json:"kafka"`
+}
+
+// kafkaStatus is the part of the Kafka CR status describing the cluster.
+type kafkaStatus struct {
+	ClusterID          string `json:"clusterId"`
+	KafkaVersion       string `json:"kafkaVersion"`
+	KafkaMetadataState string `json:"kafkaMetadataState"` // e.g. "KRaft", "ZooKeeper", "KRaftMigration"
+}
+
+type kafkaTopicSpec struct {
+	TopicName  string                 `json:"topicName"`
+	Partitions int                    `json:"partitions"`
+	Replicas   int                    `json:"replicas"`
+	Config     map[string]interface{} `json:"config"`
+}
+
+type podSpec struct {
+	NodeName string `json:"nodeName"`
+}
+
+// topicBatch is how many KafkaTopics one kubectl call fetches, so that the
+// import of a cluster with thousands of topics reports progress as it goes.
+const topicBatch = 100
+
+// Progress is told how far an import got: done of total topics fetched, or
+// of files read.
+type Progress func(done, total int)
+
+// FetchCluster reads the resources of the named cluster from the current
+// kubectl context. Node labels are optional: if listing nodes is not
+// permitted the brokers are imported without zone information.
+func FetchCluster(ctx context.Context, clusterName, namespace string) (*Cluster, error) {
+	return FetchClusterProgress(ctx, clusterName, namespace, nil)
+}
+
+// FetchClusterProgress is FetchCluster reporting the KafkaTopics fetched to
+// progress, if not nil. The topics are listed first and then fetched in
+// batches; cancelling ctx stops the kubectl call in flight.
+func FetchClusterProgress(ctx context.Context, clusterName, namespace string, progress Progress) (*Cluster, error) {
+	var ns []string
+	if namespace != "" {
+		ns = []string{"-n", namespace}
+	}
+	resources, err := kubectl(ctx, append([]string{"get", "kafka,pods", "-o", "json"}, ns...)...)
+	if err != nil {
+		return nil, err
+	}
+	docs := [][]byte{resources}
+	if nodes, err := kubectl(ctx, "get", "nodes", "-o", "json"); err == nil {
+		docs = append(docs, nodes)
+	}
+
+	list := []string{"get", "kafkatopic", "-o", "name"}
+	if clusterName != "" {
+		list = append(list, "-l", "strimzi.io/cluster="+clusterName)
+	}
+	names, err := kubectl(ctx, append(list, ns...)...)
+	if err != nil {
+		return nil, err
+	}
+	topics := strings.Fields(string(names)) // e.g. kafkatopic.kafka.strimzi.io/orders
+	if progress != nil {
+		progress(0, len(topics))
+	}
+	for start := 0; start < len(topics); start += topicBatch {
+		batch := topics[start:min(start+topicBatch, len(topics))]
+		data, err := kubectl(ctx, append(append([]string{"get", "-o", "json"}, batch...), ns...)...)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, data)
+		if progress != nil {
+			progress(start+len(batch), len(topics))
+		}
+	}
+	return Load(clusterName, docs...)
+}
+
+// LoadFiles reconstructs the named cluster from files containing the JSON
+// output of `kubectl get ... -o json` (Lists or single objects).
+func LoadFiles(clusterName string, paths ...string) (*Cluster, error) {
+	return LoadFilesProgress(clusterName, nil, paths...)
+}
+
+// LoadFilesProgress is LoadFiles reporting the files read to progress, if
+// not nil.
+func LoadFilesProgress(clusterName string, progress Progress, paths ...string) (*Cluster, error) {
+	docs := make([][]byte, 0, len(paths))
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		docs = append(docs, data)
+		if progress != nil {
+			progress(i+1, len(paths))
+		}
+	}
+	return Load(clusterName, docs...)
+}
+
+// Load reconstructs a cluster from one or more kubectl JSON documents.
+// If clusterName is empty the documents must contain exactly one Kafka CR.
+func Load(clusterName string, docs ...[]byte) (*Cluster, error) {
+	var objects []object
+	for _, doc := range docs {
+		var obj object
+		if err := json.Unmarshal(doc, &obj); err != nil {
+			return nil, fmt.Errorf("parsing kubectl output: %w", err)
+		}
+		objects = append(objects, flatten(obj)...)
+	}
+
+	// Locate the Kafka CR
+	var kafka *object
+	for i := range objects {
+		obj := &objects[i]
+		if obj.Kind != "Kafka" || (clusterName != "" && obj.Metadata.Name != clusterName) {
+			continue
+		}
+		if kafka != nil {
+			return nil, fmt.Errorf("multiple Kafka resources found, select one by name")
+		}
+		kafka = obj
+	}
+	if kafka == nil {
+		if clusterName != "" {
+			return nil, fmt.Errorf("kafka resource %q not found", clusterName)
+		}
+		return nil, fmt.Errorf("no Kafka resource found")
+	}
+	clusterName = kafka.Metadata.Name
+
+	var spec kafkaSpec
+	if err := json.Unmarshal(kafka.Spec, &spec); err != nil {
+		return nil, fmt.Errorf("parsing Kafka %s: %w", clusterName, err)
+	}
+	zoneLabel := DefaultZoneLabel
+	if spec.Kafka.Rack != nil && spec.Kafka.Rack.TopologyKey != "" {
+		zoneLabel = spec.Kafka.Rack.TopologyKey
+	}
+
+	cluster := &Cluster{Name: clusterName, KafkaVersion: spec.Kafka.Version}
+	var status kafkaStatus
+	_ = json.Unmarshal(kafka.Status, &status) // A CR that was never reconciled has no status
+	cluster.ID = status.ClusterID
+	if status.KafkaVersion != "" {
+		cluster.KafkaVersion = status.KafkaVersion // The running version, which may lag the spec during upgrades
+	}
+	switch {
+	case status.KafkaMetadataState == "KRaft":
+		cluster.MetadataMode = "KRaft"
+	case status.KafkaMetadataState != "":
+		cluster.MetadataMode = "ZooKeeper" // Including the migration states, which still have a ZooKeeper controller
+	}
+
+	// Collect node zones and broker pods
+	nodeZones := make(map[string]string)
+	for _, obj := range objects {
+		if obj.Kind == "Node" {
+			nodeZones[obj.Metadata.Name] = obj.Metadata.Labels[zoneLabel]
+		}
+	}
+	var zooKeepers []Broker
+	for _, obj := range objects {
+		if obj.Kind != "Pod" {
+			continue
+		}
+		id, ok := brokerIDFromPodName(obj.Metadata.Name)
+		if !ok {
+			continue
+		}
+		var ps podSpec
+		_ = json.Unmarshal(obj.Spec, &ps) // Missing spec just means no zone
+		node := Broker{ID: id, Zone: nodeZones[ps.NodeName]}
+		switch {
+		case isZooKeeperPod(obj, clusterName):
+			zooKeepers = append(zooKeepers, node)
+			continue
+		case isControllerPod(obj, clusterName):
+			cluster.Controllers = append(cluster.Controllers, node)
+		}
+		if isBrokerPod(obj, clusterName) {
+			cluster.Brokers = append(cluster.Brokers, node)
+		}
+	}
+	// Without pods, fall back to the replica count declared in the CR
+	if len(cluster.Brokers) == 0 {
+		for id := 0; id < spec.Kafka.Replicas; id++ {
+			cluster.Brokers = append(cluster.Brokers, Broker{ID: id})
+		}
+	}
+	if len(cluster.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka %s has no brokers", clusterName)
+	}
+	sort.Slice(cluster.Brokers, func(i, j int) bool { return cluster.Brokers[i].ID < cluster.Brokers[j].ID })
+	if len(cluster.Controllers) > 0 && cluster.MetadataMode == "" {
+		cluster.MetadataMode = "KRaft" // Only node pools in KRaft mode have the controller role
+	}
+	if len(cluster.Controllers) == 0 && len(zooKeepers) > 0 {
+		cluster.Controllers = zooKeepers // The ensemble the ZooKeeper-based controller depends on
+		if cluster.MetadataMode == "" {
+			cluster.MetadataMode = "ZooKeeper"
+		}
+	}
+	sort.Slice(cluster.Controllers, func(i, j int) bool { return cluster.Controllers[i].ID < cluster.Controllers[j].ID })
+
+	// Cluster-wide defaults used when a KafkaTopic leaves a setting unset
+	defaultPartitions := intConfig(spec.Kafka.Config, "num.partitions", 1)
+	defaultRF := intConfig(spec.Kafka.Config, "default.replication.factor", 1)
+	defaultMinISR := intConfig(spec.Kafka.Config, "min.insync.replicas", 1)
+
+	for _, obj := range objects {
+		if obj.Kind != "KafkaTopic" || obj.Metadata.Labels["strimzi.io/cluster"] != clusterName {
+			continue
+		}
+		var ts kafkaTopicSpec
+		if err := json.Unmarshal(obj.Spec, &ts); err != nil {
+			return nil, fmt.Errorf("parsing KafkaTopic %s: %w", obj.Metadata.Name, err)
+		}
+		topic := Topic{
+			Name:              ts.TopicName,
+			Partitions:        ts.Partitions,
+			ReplicationFactor: ts.Replicas,
+			MinInSyncReplicas: intConfig(ts.Config, "min.insync.replicas", defaultMinISR),
+		}
+		if topic.Name == "" {
+			topic.Name = obj.Metadata.Name
+		}
+		if topic.Partitions <= 0 {
+			topic.Partitions = defaultPartitions
+		}
+		if topic.ReplicationFactor <= 0 {
+			topic.ReplicationFactor = defaultRF
+		}
+		cluster.Topics = append(cluster.Topics, topic)
+	}
+	sort.Slice(cluster.Topics, func(i, j int) bool { return cluster.Topics[i].Name < cluster.Topics[j].Name })
+
+	return cluster, nil
+}
+
+// Zones returns the distinct broker zones in sorted order.
+func (c *Cluster) Zones() []string {
+	seen := make(map[string]bool)
+	var zones []string
+	for _, b := range c.Brokers {
+		if !seen[b.Zone] {
+			seen[b.Zone] = true
+			zones = append(zones, b.Zone)
+		}
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// ControllerQuorum returns how the cluster's controllers are deployed: a
+// ZooKeeper ensemble, combined KRaft controllers when every controller node
+// is also a broker, or dedicated ones. ok is false when no controller or
+// ZooKeeper pods were found.
+func (c *Cluster) ControllerQuorum() (mode config.ControllerMode, voters int, ok bool) {
+	if len(c.Controllers) == 0 {
+		return config.ControllersCombined, 0, false
+	}
+	if c.MetadataMode == "ZooKeeper" {
+		return config.ControllersZooKeeper, len(c.Controllers), true
+	}
+	brokers := make(map[int]bool, len(c.Brokers))
+	for _, b := range c.Brokers {
+		brokers[b.ID] = true
+	}
+	for _, n := range c.Controllers {
+		if !brokers[n.ID] {
+			return config.ControllersDedicated, len(c.Controllers), true
+		}
+	}
+	return config.ControllersCombined, len(c.Controllers), true
+}
+
+// PlacementConfig builds the placement configuration for one topic of the
+// cluster. Each zone becomes a DC; a cluster spanning more than one zone is
+// treated as an MRC. An empty topic name selects the first topic.
+func (c *Cluster) PlacementConfig(topicName string) (config.PlacementConfig, error) {
+	if len(c.Topics) == 0 {
+		return config.PlacementConfig{}, fmt.Errorf("kafka %s has no KafkaTopic resources", c.Name)
+	}
+	topic := c.Topics[0]
+	if topicName != "" {
+		found := false
+		names := make([]string, 0, len(c.Topics))
+		for _, t := range c.Topics {
+			names = append(names, t.Name)
+			if t.Name == topicName {
+				topic, found = t, true
+			}
+		}
+		if !found {
+			return config.PlacementConfig{}, fmt.Errorf("topic %q not found (available: %s)", topicName, strings.Join(names, ", "))
+		}
+	}
+	if topic.ReplicationFactor > len(c.Brokers) {
+		return config.PlacementConfig{}, fmt.Errorf("topic %s has replication factor %d but only %d brokers", topic.Name, topic.ReplicationFactor, len(c.Brokers))
+	}
+
+	zones := c.Zones()
+	dcIDs := make(map[string]int, len(zones))
+	dcNames := make(map[int]string, len(zones))
+	for i, zone := range zones {
+		dcIDs[zone] = i + 1 // 1-based DC IDs
+		dcNames[i+1] = zone
+	}
+
+	brokers := make([]config.BrokerSpec, 0, len(c.Brokers))
+	for _, b := range c.Brokers {
+		brokers = append(brokers, config.BrokerSpec{ID: b.ID, DCID: dcIDs[b.Zone]})
+	}
+	cfg, err := config.NewPlacementConfig(topic.Partitions, topic.ReplicationFactor, topic.MinInSyncReplicas,
+		config.WithBrokerList(brokers, dcNames),
+		config.WithTopicName(topic.Name))
+	if err != nil {
+		return config.PlacementConfig{}, fmt.Errorf("topic %s: %w", topic.Name, err)
+	}
+
+	// Controllers may run in zones without brokers, e.g. a tiebreaker site;
+	// those get DC IDs after the brokers' DCs
+	for _, n := range c.Controllers {
+		id, ok := dcIDs[n.Zone]
+		if !ok {
+			id = len(dcIDs) + 1
+			dcIDs[n.Zone] = id
+			cfg.DCNames[id] = n.Zone
+		}
+		cfg.ControllerNodes = append(cfg.ControllerNodes, config.BrokerSpec{ID: n.ID, DCID: id})
+	}
+	return cfg, nil
+}
+
+// flatten expands List objects into their items.
+func flatten(obj object) []object {
+	if obj.Kind != "List" && !strings.HasSuffix(obj.Kind, "List") {
+		return []object{obj}
+	}
+	var out []object
+	for _, item := range obj.Items {
+		out = append(out, flatten(item)...)
+	}
+	return out
+}
+
+// isBrokerPod reports whether a pod is a broker of the given cluster. Node
+// pool pods carry an explicit broker-role label; classic clusters name the
+// broker StatefulSet/StrimziPodSet "<cluster>-kafka".
+func isBrokerPod(obj object, clusterName string) bool {
+	labels := obj.Metadata.Labels
+	if labels["strimzi.io/cluster"] != clusterName || labels["strimzi.io/kind"] != "Kafka" {
+		return false
+	}
+	if role, ok := labels["strimzi.io/broker-role"]; ok {
+		return role == "true"
+	}
+	return labels["strimzi.io/name"] == clusterName+"-kafka"
+}
+
+// isZooKeeperPod reports whether a pod is a ZooKeeper node of the given
+// cluster.
+func isZooKeeperPod(obj object, clusterName string) bool {
+	labels := obj.Metadata.Labels
+	return labels["strimzi.io/cluster"] == clusterName && labels["strimzi.io/name"] == clusterName+"-zookeeper"
+}
+
+// isControllerPod reports whether a pod is a KRaft controller of the given
+// cluster. Only node pool pods carry the controller-role label.
+func isControllerPod(obj object, clusterName string) bool {
+	labels := obj.Metadata.Labels
+	return labels["strimzi.io/cluster"] == clusterName && labels["strimzi.io/kind"] == "Kafka" &&
+		labels["strimzi.io/controller-role"] == "true"
+}
+
+// brokerIDFromPodName extracts the broker ID from the pod ordinal suffix,
+// e.g. "my-cluster-kafka-2" -> 2.
+func brokerIDFromPodName(name string) (int, bool) {
+	idx := strings.LastIndex(name, "-")
+	if idx < 0 {
+		return 0, false
+	}
+	id, err := strconv.Atoi(name[idx+1:])
+	return id, err == nil
+}
+
+// intConfig reads an integer Kafka config value that may be encoded as a
+// JSON number or a string, returning def if unset or invalid.
+func intConfig(cfg map[string]interface{}, key string, def int) int {
+	v, ok := cfg[key]
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(fmt.Sprint(v))
+	if err != nil || n <= 0 {
+		return def
+	}
+	return n
+}
+
+// kubectl runs a kubectl command and returns its standard output.
+func kubectl(ctx context.Context, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("kubectl %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
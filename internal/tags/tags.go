@@ -0,0 +1,71 @@
+// Package tags loads free-form key/value tags of brokers, such as the
+// instance type, disk type or maintenance window, used to filter the view
+// and to constrain where leaders are placed.
+package tags
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LoadFile reads broker tags from a CSV file with one broker per line: its
+// ID followed by any number of key=value tags, e.g.
+//
+//	3,instance=m5.xlarge,disk=gp3,lifecycle=spot
+//
+// A header row is allowed. A broker listed twice gets the tags of both
+// lines, the later line winning for a key set on both.
+func LoadFile(path string) (map[int]map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading tags file: %w", err)
+	}
+	return parseCSV(bytes.TrimSpace(content))
+}
+
+func parseCSV(content []byte) (map[int]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1 // Brokers have any number of tags
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	tags := make(map[int]map[string]string)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing tags CSV: %w", err)
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(record[0]))
+		if err != nil {
+			if line == 1 {
+				continue // Header row
+			}
+			return nil, fmt.Errorf("tags CSV line %d: invalid broker ID %q", line, record[0])
+		}
+		if id < 0 {
+			return nil, fmt.Errorf("tags CSV line %d: broker ID cannot be negative", line)
+		}
+		if tags[id] == nil {
+			tags[id] = make(map[string]string)
+		}
+		for _, field := range record[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			key = strings.TrimSpace(key)
+			if !ok || key == "" {
+				return nil, fmt.Errorf("tags CSV line %d: expected key=value, got %q", line, field)
+			}
+			tags[id][key] = strings.TrimSpace(value)
+		}
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("tags file lists no brokers")
+	}
+	return tags, nil
+}
@@ -0,0 +1,677 @@
+// Package timeline plays a script of cluster events (brokers and DCs
+// failing and recovering, a reassignment starting) on a simulated clock and
+// follows the placement through it second by second: which partitions lose
+// their leader or fall under min ISR, when leaders are elected again, when
+// recovered brokers rejoin the ISR and how the reassignment's waves
+// progress around the outages.
+package timeline
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/export"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+const (
+	// DefaultWaveTime is how long a reassignment wave takes when partition
+	// sizes are unknown.
+	DefaultWaveTime = 2 * time.Minute
+	// DefaultThrottle is the rate a wave copies data into each broker at,
+	// like a leader/follower.replication.throttled.rate of 50 MB/s.
+	DefaultThrottle = 50 << 20
+	// MaxLength caps the simulation, e.g. for a reassignment waiting for a
+	// broker that never recovers.
+	MaxLength = 6 * time.Hour
+	// settle is how long the simulation runs on after the last change.
+	settle = time.Minute
+)
+
+// Kind is what an event does.
+type Kind int
+
+const (
+	BrokerFail    Kind = iota // The broker goes down
+	BrokerRecover             // The broker comes back and catches up as a follower
+	DCFail                    // Every broker of the DC goes down
+	DCRecover                 // The DC's brokers come back
+	ReassignStart             // The reassignment to the planned placement starts
+)
+
+var kindNames = []string{"fail", "recover", "fail-dc", "recover-dc", "reassign"}
+
+// String returns the kind's name as used in event specs.
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Event is one scripted event.
+type Event struct {
+	At     time.Duration // Since the start of the timeline
+	Kind   Kind
+	Target int // Broker ID, or DC ID for DC events; unused for ReassignStart
+}
+
+// String formats the event as parsed by Parse, e.g. "fail:1@0s".
+func (e Event) String() string {
+	if e.Kind == ReassignStart {
+		return fmt.Sprintf("%s@%s", e.Kind, e.At)
+	}
+	return fmt.Sprintf("%s:%d@%s", e.Kind, e.Target, e.At)
+}
+
+// Parse parses a comma-separated event script, each event kind:target@time
+// with kind fail, recover, fail-dc or recover-dc, or reassign@time, e.g.
+// "fail:1@0s,recover:1@5m,reassign@10m". Events are returned by time.
+func Parse(spec string) ([]Event, error) {
+	var events []Event
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		what, at, ok := strings.Cut(part, "@")
+		if !ok {
+			return nil, fmt.Errorf("invalid event %q, expected kind:target@time or reassign@time", part)
+		}
+		name, target, hasTarget := strings.Cut(what, ":")
+		e := Event{Kind: -1}
+		for k, n := range kindNames {
+			if n == name {
+				e.Kind = Kind(k)
+			}
+		}
+		var err error
+		switch {
+		case e.Kind < 0:
+			return nil, fmt.Errorf("invalid event %q: kind must be fail, recover, fail-dc, recover-dc or reassign", part)
+		case e.Kind == ReassignStart && hasTarget:
+			return nil, fmt.Errorf("invalid event %q: reassign takes no target", part)
+		case e.Kind != ReassignStart:
+			e.Target, err = strconv.Atoi(target)
+			if !hasTarget || err != nil || e.Target < 0 || ((e.Kind == DCFail || e.Kind == DCRecover) && e.Target < 1) {
+				return nil, fmt.Errorf("invalid event %q: bad broker or DC ID", part)
+			}
+		}
+		if e.At, err = time.ParseDuration(at); err != nil || e.At < 0 {
+			return nil, fmt.Errorf("invalid event %q: bad time", part)
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no events given")
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At < events[j].At })
+	return events, nil
+}
+
+// Clock is a simulated clock that hands out the scripted events as it
+// advances.
+type Clock struct {
+	now    time.Duration
+	events []Event
+	next   int
+}
+
+// NewClock returns a clock at t=0 for the events.
+func NewClock(events []Event) *Clock {
+	c := &Clock{events: append([]Event(nil), events...)}
+	sort.SliceStable(c.events, func(i, j int) bool { return c.events[i].At < c.events[j].At })
+	return c
+}
+
+// Now returns the current simulated time.
+func (c *Clock) Now() time.Duration {
+	return c.now
+}
+
+// AdvanceTo moves the clock forward to t and returns the events due by
+// then, in order.
+func (c *Clock) AdvanceTo(t time.Duration) []Event {
+	c.now = max(c.now, t)
+	start := c.next
+	for c.next < len(c.events) && c.events[c.next].At <= c.now {
+		c.next++
+	}
+	return c.events[start:c.next]
+}
+
+// Pending reports whether events are still to come.
+func (c *Clock) Pending() bool {
+	return c.next < len(c.events)
+}
+
+// Params sets up a timeline.
+type Params struct {
+	Timing  failover.Timing
+	CatchUp time.Duration // Fetching a recovered broker needs before it rejoins the ISR
+	MinISR  int
+	Quorum  failover.Quorum
+	// Target is the placement the reassignment moves to, in waves that stay
+	// within Limits; nil when there is nothing to reassign.
+	Target map[int]*config.DCInfo
+	Limits export.WaveLimits
+	// Loads gives the partition sizes a wave copies at Throttle bytes/sec
+	// into each broker; without sizes every wave takes WaveTime.
+	Loads    map[int]config.PartitionLoad
+	Throttle float64
+	WaveTime time.Duration
+}
+
+// Sample is the state of the cluster at one second.
+type Sample struct {
+	Down        int // Brokers down
+	Leaderless  int // Partitions without a live leader
+	UnderMinISR int // Partitions with a leader but fewer in-sync replicas than min ISR
+	Moving      int // Partitions of the reassignment wave in progress
+}
+
+// NoWrites reports whether acks=all writes fail on some partition.
+func (s Sample) NoWrites() bool {
+	return s.Leaderless > 0 || s.UnderMinISR > 0
+}
+
+// Entry is a line of the timeline report.
+type Entry struct {
+	At    time.Duration
+	What  string
+	Alert bool // Writes fail or the reassignment is stuck
+}
+
+// Report is a played timeline.
+type Report struct {
+	Length  time.Duration
+	Samples []Sample // One per second
+	Log     []Entry
+	Waves   int
+	// Done is when the reassignment finished, -1 if it never started or did
+	// not finish within the timeline.
+	Done time.Duration
+	// NoWrites is how long some partition rejected acks=all writes.
+	NoWrites time.Duration
+}
+
+// replica is a replica of a partition on the simulated cluster.
+type replica struct {
+	broker int
+	role   config.ReplicaRole
+}
+
+// partitionState is a partition on the simulated cluster.
+type partitionState struct {
+	replicas   []replica
+	leader     int // -1 while offline
+	lastLeader int // The last in-sync replica of an offline partition, -1 if none
+	isr        map[int]bool
+}
+
+// holds reports whether the broker has a replica of the partition.
+func (ps *partitionState) holds(broker int) bool {
+	for _, r := range ps.replicas {
+		if r.broker == broker {
+			return true
+		}
+	}
+	return false
+}
+
+// replicasOf returns the replicas of every partition of dcs, the leader
+// first and then by broker ID, so the first live in-sync one is the next
+// leader.
+func replicasOf(dcs map[int]*config.DCInfo) map[int][]replica {
+	parts := make(map[int][]replica)
+	for _, dc := range dcs {
+		for _, b := range dc.Brokers {
+			for _, r := range b.Replicas {
+				parts[r.PartitionID] = append(parts[r.PartitionID], replica{broker: b.ID, role: r.Role})
+			}
+		}
+	}
+	for _, rs := range parts {
+		sort.Slice(rs, func(i, j int) bool {
+			if (rs[i].role == config.Leader) != (rs[j].role == config.Leader) {
+				return rs[i].role == config.Leader
+			}
+			return rs[i].broker < rs[j].broker
+		})
+	}
+	return parts
+}
+
+// Simulate plays the events on the placement dcs, one second at a time.
+// The controller fences a down broker after the session timeout, which
+// takes it out of every ISR and elects the first other in-sync replica as
+// leader of its partitions; losing the active controller delays fencing by
+// a controller failover. A partition without another in-sync replica stays
+// offline until its last leader returns. A recovered broker rejoins the ISR
+// after catching up. While the quorum has lost its majority nothing is
+// fenced or elected. The reassignment moves partitions from dcs to
+// p.Target wave by wave; a wave waits, and pauses, while the partition's
+// leader or a broker receiving data is down.
+func Simulate(dcs map[int]*config.DCInfo, events []Event, p Params) Report {
+	r := Report{Done: -1}
+	dcOf := make(map[int]int)
+	for _, dc := range dcs {
+		for id := range dc.Brokers {
+			dcOf[id] = dc.ID
+		}
+	}
+	if p.WaveTime <= 0 {
+		p.WaveTime = DefaultWaveTime
+	}
+	if p.Throttle <= 0 {
+		p.Throttle = DefaultThrottle
+	}
+
+	parts := make(map[int]*partitionState)
+	for id, rs := range replicasOf(dcs) {
+		ps := &partitionState{replicas: rs, leader: -1, lastLeader: -1, isr: make(map[int]bool)}
+		for _, rep := range rs {
+			if rep.role == config.Leader {
+				ps.leader = rep.broker
+			}
+			if rep.role != config.Observer {
+				ps.isr[rep.broker] = true
+			}
+		}
+		parts[id] = ps
+	}
+	ids := make([]int, 0, len(parts))
+	for id := range parts {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var waves []export.Wave
+	var targets map[int][]replica
+	if p.Target != nil {
+		waves = export.PlanWaves(dcs, p.Target, p.Limits)
+		targets = replicasOf(p.Target)
+	}
+	r.Waves = len(waves)
+
+	logf := func(t time.Duration, alert bool, key string, args ...any) {
+		r.Log = append(r.Log, Entry{At: t, What: i18n.T(key, args...), Alert: alert})
+	}
+	clock := NewClock(events)
+	brokerDown := make(map[int]bool)
+	dcDown := make(map[int]bool)
+	down := make(map[int]bool)   // Broker -> down now
+	fenced := make(map[int]bool) // Broker -> fenced by the controller
+	downAt := make(map[int]time.Duration)
+	upAt := make(map[int]time.Duration)
+	active, hasActive := p.Quorum.ActiveVoter()
+	activeLost, noControllerUntil := false, time.Duration(0)
+	hadQuorum := true
+
+	reassigning, reassignAt := false, time.Duration(0)
+	wave, waveRunning, waveLeft, stalled := 0, false, time.Duration(0), false
+	lastChange := time.Duration(0)
+	var prev Sample
+	for t := time.Duration(0); t <= MaxLength; t += time.Second {
+		// Scripted events
+		for _, e := range clock.AdvanceTo(t) {
+			lastChange = t
+			switch e.Kind {
+			case BrokerFail, BrokerRecover:
+				if _, ok := dcOf[e.Target]; !ok {
+					logf(t, false, "timeline.noBroker", e.Target, e.Kind)
+					continue
+				}
+				brokerDown[e.Target] = e.Kind == BrokerFail
+				logf(t, e.Kind == BrokerFail, "timeline.broker", e.Target, verb(e.Kind))
+			case DCFail, DCRecover:
+				dc, ok := dcs[e.Target]
+				if !ok {
+					logf(t, false, "timeline.noDC", e.Target, e.Kind)
+					continue
+				}
+				dcDown[e.Target] = e.Kind == DCFail
+				logf(t, e.Kind == DCFail, "timeline.dc", dc.Rack(), verb(e.Kind), i18n.Plural(len(dc.Brokers), "broker"))
+			case ReassignStart:
+				if reassigning {
+					continue
+				}
+				reassigning, reassignAt = true, t
+				moves := 0
+				for _, w := range waves {
+					moves += w.Moves
+				}
+				if len(waves) == 0 {
+					logf(t, false, "timeline.reassignNothing")
+				} else {
+					logf(t, false, "timeline.reassignStarts", i18n.Plural(moves, "new replica"), i18n.Plural(len(waves), "wave"))
+				}
+			}
+		}
+		failed := make(map[int]bool)
+		for id := range dcOf {
+			d := brokerDown[id] || dcDown[dcOf[id]]
+			switch {
+			case d && !down[id]:
+				downAt[id] = t
+			case !d && down[id]:
+				upAt[id], fenced[id] = t, false
+			}
+			down[id] = d
+			if d {
+				failed[id] = true
+			}
+		}
+		quorum := p.Quorum.Survives(failed, dcDown)
+		if quorum != hadQuorum {
+			if quorum {
+				logf(t, false, "timeline.quorumBack")
+			} else {
+				logf(t, true, "timeline.quorumLost")
+			}
+			hadQuorum = quorum
+		}
+		if hasActive && !activeLost && quorum &&
+			(dcDown[active.DCID] || (p.Quorum.Mode == config.ControllersCombined && down[active.NodeID])) {
+			activeLost, noControllerUntil = true, t+p.Timing.ControllerFailover
+			logf(t, false, "timeline.controllerDown", active.NodeID, p.Timing.ControllerFailover)
+		}
+
+		// Fencing, elections and ISR changes, committed by the controller
+		moved, offline, resumed, back := 0, 0, make(map[int]int), make(map[int]int)
+		if quorum && t >= noControllerUntil {
+			for _, b := range sortedBrokers(down) {
+				if down[b] && !fenced[b] && t >= downAt[b]+p.Timing.SessionTimeout {
+					fenced[b] = true
+					logf(t, false, "timeline.fenced", b, p.Timing.SessionTimeout)
+				}
+			}
+			for _, id := range ids {
+				ps := parts[id]
+				for _, rep := range ps.replicas {
+					b := rep.broker
+					switch {
+					case fenced[b] && ps.isr[b] && ps.leader != b:
+						delete(ps.isr, b)
+					case fenced[b] && ps.isr[b]:
+						ps.leader = -1
+						for _, other := range ps.replicas {
+							if other.broker != b && ps.isr[other.broker] && !down[other.broker] {
+								ps.leader = other.broker
+								break
+							}
+						}
+						if ps.leader >= 0 {
+							delete(ps.isr, b)
+							moved++
+						} else if ps.lastLeader != b {
+							ps.lastLeader = b // Kept in the ISR as the only replica that may lead
+							offline++
+						}
+					case !down[b] && ps.leader < 0 && ps.lastLeader == b:
+						ps.leader, ps.lastLeader = b, -1
+						resumed[b]++
+					case !down[b] && rep.role != config.Observer && !ps.isr[b] && ps.leader >= 0 && !down[ps.leader] && t >= upAt[b]+p.CatchUp:
+						ps.isr[b] = true
+						back[b]++
+					}
+				}
+			}
+		}
+		if moved > 0 {
+			logf(t, false, "timeline.moved", i18n.Plural(moved, "leadership"))
+		}
+		if offline > 0 {
+			logf(t, true, "timeline.offline", i18n.Plural(offline, "partition"))
+		}
+		for _, b := range sortedKeys(resumed) {
+			logf(t, false, "timeline.leadsAgain", b, i18n.Plural(resumed[b], "offline partition"))
+		}
+		for _, b := range sortedKeys(back) {
+			logf(t, false, "timeline.caughtUp", b, i18n.Plural(back[b], "partition"))
+		}
+
+		// Reassignment waves
+		moving := 0
+		if reassigning && wave < len(waves) {
+			w := waves[wave]
+			blocked := -1 // A broker the wave needs that is down
+			for _, id := range w.Partitions {
+				ps := parts[id]
+				if ps.leader >= 0 && down[ps.leader] {
+					blocked = ps.leader
+				} else if ps.leader < 0 {
+					blocked = ps.lastLeader
+				}
+				for _, rep := range targets[id] {
+					if down[rep.broker] && !ps.holds(rep.broker) {
+						blocked = rep.broker
+					}
+				}
+			}
+			switch {
+			case blocked >= 0 && !stalled:
+				stalled = true
+				if waveRunning {
+					logf(t, true, "timeline.wavePauses", wave+1, blocked)
+				} else {
+					logf(t, true, "timeline.waveWaits", wave+1, blocked)
+				}
+			case blocked < 0 && stalled && waveRunning:
+				stalled = false
+				logf(t, false, "timeline.waveResumes", wave+1)
+			case blocked < 0 && !waveRunning:
+				stalled, waveRunning, waveLeft = false, true, waveDuration(w, parts, targets, p)
+				logf(t, false, "timeline.waveStarts", wave+1, len(waves),
+					i18n.Plural(len(w.Partitions), "partition"), i18n.Plural(w.Moves, "new replica"), waveLeft)
+			case !stalled:
+				waveLeft -= time.Second // Copying since the last second
+			}
+			if waveRunning {
+				moving = len(w.Partitions)
+				if waveLeft <= 0 {
+					for _, id := range w.Partitions {
+						parts[id].moveTo(targets[id], down)
+					}
+					logf(t, false, "timeline.waveDone", wave+1, len(waves))
+					wave++
+					waveRunning, stalled, moving = false, false, 0
+					if wave == len(waves) {
+						r.Done = t
+						logf(t, false, "timeline.reassignDone", t-reassignAt)
+					}
+				}
+			}
+		}
+
+		// Sample
+		s := Sample{Down: len(failed), Moving: moving}
+		for _, id := range ids {
+			ps := parts[id]
+			switch {
+			case ps.leader < 0 || down[ps.leader]:
+				s.Leaderless++
+			case len(ps.isr) < p.MinISR:
+				s.UnderMinISR++
+			}
+		}
+		if s.Leaderless != prev.Leaderless || s.UnderMinISR != prev.UnderMinISR {
+			if s.NoWrites() {
+				logf(t, true, "timeline.noWrites",
+					i18n.Plural(s.Leaderless+s.UnderMinISR, "partition"), s.Leaderless, s.UnderMinISR, p.MinISR)
+			} else {
+				logf(t, false, "timeline.writesBack")
+			}
+		}
+		if s != prev || (len(r.Log) > 0 && r.Log[len(r.Log)-1].At == t) {
+			lastChange = t
+		}
+		if s.NoWrites() {
+			r.NoWrites += time.Second
+		}
+		r.Samples = append(r.Samples, s)
+		prev = s
+
+		// Stop once nothing is left to happen, e.g. when only brokers that
+		// never recover are left to wait for
+		if !clock.Pending() && t >= lastChange+settle && !(waveRunning && !stalled) && !catchingUp(parts, down) {
+			break
+		}
+	}
+	r.Length = time.Duration(len(r.Samples)) * time.Second
+	if reassigning && wave < len(waves) {
+		logf(r.Length, true, "timeline.reassignNotDone", i18n.Plural(len(waves)-wave, "wave"), len(waves))
+	}
+	return r
+}
+
+// moveTo switches the partition to its new replicas once its wave is done.
+// The new preferred leader takes over if it is up, else the current leader
+// stays if it still holds a replica, else the first live new replica.
+func (ps *partitionState) moveTo(replicas []replica, down map[int]bool) {
+	leader := ps.leader
+	ps.replicas, ps.leader, ps.lastLeader, ps.isr = replicas, -1, -1, make(map[int]bool)
+	for _, rep := range replicas {
+		if rep.role == config.Observer || down[rep.broker] {
+			continue
+		}
+		ps.isr[rep.broker] = true
+		if rep.role == config.Leader {
+			ps.leader = rep.broker
+		}
+	}
+	if ps.leader < 0 && ps.isr[leader] {
+		ps.leader = leader
+	}
+	for _, rep := range replicas {
+		if ps.leader < 0 && ps.isr[rep.broker] {
+			ps.leader = rep.broker
+		}
+	}
+}
+
+// waveDuration estimates how long a wave copies: the bytes the busiest
+// receiving broker takes at the throttle, or the fixed wave time without
+// partition sizes.
+func waveDuration(w export.Wave, parts map[int]*partitionState, targets map[int][]replica, p Params) time.Duration {
+	if w.Moves == 0 {
+		return time.Second // Only roles change
+	}
+	received := make(map[int]int64)
+	sized := false
+	for _, id := range w.Partitions {
+		size := p.Loads[id].SizeBytes
+		sized = sized || size > 0
+		for _, rep := range targets[id] {
+			if !parts[id].holds(rep.broker) {
+				received[rep.broker] += size
+			}
+		}
+	}
+	if !sized {
+		return p.WaveTime
+	}
+	var most int64
+	for _, n := range received {
+		most = max(most, n)
+	}
+	return max(time.Second, time.Duration(float64(most)/p.Throttle*float64(time.Second)).Round(time.Second))
+}
+
+// catchingUp reports whether a live broker is still to rejoin an ISR.
+func catchingUp(parts map[int]*partitionState, down map[int]bool) bool {
+	for _, ps := range parts {
+		if ps.leader < 0 || down[ps.leader] {
+			continue
+		}
+		for _, rep := range ps.replicas {
+			if rep.role != config.Observer && !down[rep.broker] && !ps.isr[rep.broker] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verb describes what a broker or DC event does.
+func verb(k Kind) string {
+	if k == BrokerFail || k == DCFail {
+		return i18n.T("timeline.fails")
+	}
+	return i18n.T("timeline.recovers")
+}
+
+// BrokerRestarts turns the outages of the script into broker restarts for
+// the ISR timeline: each broker or DC failure lasts until its recovery, or
+// until the end of the script.
+func BrokerRestarts(dcs map[int]*config.DCInfo, events []Event) []failover.BrokerEvent {
+	var end time.Duration
+	for _, e := range events {
+		end = max(end, e.At)
+	}
+	var restarts []failover.BrokerEvent
+	failedAt := make(map[[2]int]time.Duration) // {kind, target} -> since
+	for _, e := range events {
+		switch e.Kind {
+		case BrokerFail, DCFail:
+			key := [2]int{int(e.Kind), e.Target}
+			if _, ok := failedAt[key]; !ok {
+				failedAt[key] = e.At
+			}
+		case BrokerRecover, DCRecover:
+			key := [2]int{int(e.Kind) - 1, e.Target}
+			if at, ok := failedAt[key]; ok && e.At > at {
+				restarts = append(restarts, restartsOf(dcs, key, at, e.At)...)
+			}
+			delete(failedAt, key)
+		}
+	}
+	for key, at := range failedAt {
+		restarts = append(restarts, restartsOf(dcs, key, at, max(end, at)+time.Minute)...)
+	}
+	sort.Slice(restarts, func(i, j int) bool {
+		if restarts[i].At != restarts[j].At {
+			return restarts[i].At < restarts[j].At
+		}
+		return restarts[i].BrokerID < restarts[j].BrokerID
+	})
+	return restarts
+}
+
+func restartsOf(dcs map[int]*config.DCInfo, key [2]int, from, to time.Duration) []failover.BrokerEvent {
+	brokers := []int{key[1]}
+	if Kind(key[0]) == DCFail {
+		brokers = nil
+		if dc, ok := dcs[key[1]]; ok {
+			for id := range dc.Brokers {
+				brokers = append(brokers, id)
+			}
+		}
+	}
+	restarts := make([]failover.BrokerEvent, len(brokers))
+	for i, id := range brokers {
+		restarts[i] = failover.BrokerEvent{Kind: failover.EventRestart, BrokerID: id, At: from, Duration: to - from}
+	}
+	return restarts
+}
+
+func sortedBrokers(m map[int]bool) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func sortedKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
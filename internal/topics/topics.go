@@ -0,0 +1,87 @@
+// Package topics loads a topic list used to place a realistic multi-topic
+// workload on the simulated cluster.
+package topics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// LoadFile reads topics from a CSV file with the columns name, partitions,
+// replication_factor, min_isr and optionally size_bytes (the estimated total
+// size of the topic, may be empty) and tenant (the team owning it). A header
+// row is allowed.
+func LoadFile(path string) ([]config.TopicSpec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading topics file: %w", err)
+	}
+	return parseCSV(bytes.TrimSpace(content))
+}
+
+func parseCSV(content []byte) ([]config.TopicSpec, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1 // Size and tenant columns are optional
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	var specs []config.TopicSpec
+	seen := make(map[string]bool)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing topics CSV: %w", err)
+		}
+		if len(record) < 4 {
+			return nil, fmt.Errorf("topics CSV line %d: expected name,partitions,replication_factor,min_isr[,size_bytes[,tenant]]", line)
+		}
+		values := make([]int, 3)
+		for i, field := range record[1:4] {
+			if values[i], err = strconv.Atoi(strings.TrimSpace(field)); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			if line == 1 {
+				continue // Header row
+			}
+			return nil, fmt.Errorf("topics CSV line %d: invalid number in %q", line, strings.Join(record[1:4], ","))
+		}
+		spec := config.TopicSpec{
+			Name:              strings.TrimSpace(record[0]),
+			NumPartitions:     values[0],
+			ReplicationFactor: values[1],
+			MinInSyncReplicas: values[2],
+		}
+		if spec.Name == "" {
+			return nil, fmt.Errorf("topics CSV line %d: topic name cannot be empty", line)
+		}
+		if seen[spec.Name] {
+			return nil, fmt.Errorf("topics CSV line %d: duplicate topic %q", line, spec.Name)
+		}
+		seen[spec.Name] = true
+		if len(record) > 4 && strings.TrimSpace(record[4]) != "" {
+			if spec.SizeBytes, err = strconv.ParseInt(strings.TrimSpace(record[4]), 10, 64); err != nil {
+				return nil, fmt.Errorf("topics CSV line %d: invalid size %q", line, record[4])
+			}
+		}
+		if len(record) > 5 {
+			spec.Tenant = strings.TrimSpace(record[5])
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("topics CSV contains no topics")
+	}
+	return specs, nil
+}
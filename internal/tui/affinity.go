@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// SetClientTraffic sets the producer and consumer traffic of the topic's
+// clients per DC, which the client-affinity strategy leads from and the
+// placement header reports cross-DC hops for.
+func (m *Model) SetClientTraffic(t config.ClientTraffic) {
+	m.clientTraffic = t
+}
+
+// affinityStatus renders how much client traffic crosses DCs to reach the
+// partition leaders, against the random strategy's placement of the same
+// topic, for the placement header.
+func (m Model) affinityStatus() string {
+	if len(m.clientTraffic) == 0 || len(m.dcs) < 2 {
+		return ""
+	}
+	var b strings.Builder
+	cfg := m.placementCfg
+	cfg.ClientTraffic = m.clientTraffic
+	perDC, unknown := cfg.ClientTraffic.PerDC(m.dcs)
+	if len(unknown) > 0 {
+		b.WriteString(WarnStyle.Render(i18n.T("affinity.unknownDC", strings.Join(unknown, ", "))) + "\n")
+	}
+	var produce, consume float64
+	for _, t := range perDC {
+		produce += t.ProduceBytesPerSec
+		consume += t.ConsumeBytesPerSec
+	}
+	if produce+consume == 0 {
+		return b.String()
+	}
+
+	hops := placement.CrossDCClientTraffic(cfg, m.dcs)
+	var parts []string
+	if produce > 0 {
+		parts = append(parts, i18n.T("affinity.produce", formatRate(hops.Produce), formatRate(produce), percent(hops.Produce/produce)))
+	}
+	if consume > 0 {
+		parts = append(parts, i18n.T("affinity.consume", formatRate(hops.Consume), formatRate(consume), percent(hops.Consume/consume)))
+	}
+	b.WriteString(i18n.T("affinity.crossing", strings.Join(parts, ", ")) + "\n")
+
+	// The same topic placed by the random strategy, round-robin leaders
+	baseline := cfg
+	baseline.Strategy = config.StrategyRandom
+	random, _ := m.placements.CalculatePlacement(baseline)
+	before := placement.CrossDCClientTraffic(baseline, random)
+	switch {
+	case produce == 0:
+	case cfg.Strategy != config.StrategyClientAffinity:
+		b.WriteString(HelpStyle.Render("  "+i18n.T("affinity.hint")) + "\n")
+	case before.Produce > hops.Produce:
+		b.WriteString(PassStyle.Render("  "+i18n.T("affinity.fewer", percent(1-hops.Produce/before.Produce), formatRate(before.Produce))) + "\n")
+	default:
+		b.WriteString(HelpStyle.Render("  "+i18n.T("affinity.notFewer", formatRate(before.Produce))) + "\n")
+	}
+	return b.String()
+}
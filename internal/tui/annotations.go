@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/scenarios"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/session"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// partitionNotePrefix matches the "p3:" prefix that attaches a note to a
+// partition instead of a broker.
+var partitionNotePrefix = regexp.MustCompile(`^[pP](\d+):\s*`)
+
+// openNoteInput starts typing a note on the selected broker, or on the
+// whole placement when no broker is selected. A "pN:" prefix puts the
+// note on partition N.
+func (m *Model) openNoteInput() tea.Cmd {
+	m.editingNote = true
+	m.noteInput = textinput.New()
+	m.noteInput.Placeholder = i18n.T("note.placeholder")
+	m.noteInput.Cursor.Style = CursorStyle
+	m.noteInput.PromptStyle = FocusedStyle
+	m.noteInput.TextStyle = FocusedStyle
+	m.noteInput.CharLimit = 200
+	m.noteInput.Width = 60
+	return m.noteInput.Focus()
+}
+
+// updateNoteInput takes all keys while a note is typed: Enter adds it
+// (unless empty) and Esc discards it.
+func (m Model) updateNoteInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m.quit()
+	case tea.KeyEsc:
+		m.editingNote = false
+		return m, nil
+	case tea.KeyEnter:
+		m.editingNote = false
+		if note, ok := m.parseNote(m.noteInput.Value()); ok {
+			m.annotations = append(m.annotations, note)
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.noteInput, cmd = m.noteInput.Update(msg)
+	return m, cmd
+}
+
+// parseNote turns the typed text into a note: on the partition of a "pN:"
+// prefix (if the topic has it), else on the selected broker or the whole
+// placement. ok is false for an empty note.
+func (m Model) parseNote(value string) (session.Annotation, bool) {
+	text := strings.TrimSpace(value)
+	if match := partitionNotePrefix.FindStringSubmatch(text); match != nil {
+		n, err := strconv.Atoi(match[1])
+		if id := m.placementCfg.PartitionID(n); err == nil && id >= 1 && id <= m.placementCfg.NumPartitions {
+			text = strings.TrimSpace(text[len(match[0]):])
+			if text == "" {
+				return session.Annotation{}, false
+			}
+			return session.Annotation{Partition: &id, Topic: m.placementCfg.TopicName, Text: text}, true
+		}
+	}
+	if text == "" {
+		return session.Annotation{}, false
+	}
+	note := session.Annotation{Text: text}
+	if m.brokerSelected {
+		id := m.selectedBroker
+		note.Broker = &id
+	}
+	return note, true
+}
+
+// partitionNotes returns the notes on partitions of the shown topic, keyed
+// by partition.
+func (m Model) partitionNotes() map[int][]string {
+	notes := make(map[int][]string)
+	for _, note := range m.annotations {
+		if note.Partition != nil && note.Topic == m.placementCfg.TopicName {
+			notes[*note.Partition] = append(notes[*note.Partition], note.Text)
+		}
+	}
+	return notes
+}
+
+// noteLabel names what a note is on, e.g. "Broker 2" or "p3"; "" for the
+// whole placement.
+func (m Model) noteLabel(note session.Annotation) string {
+	switch {
+	case note.Partition != nil:
+		return fmt.Sprintf("p%d", m.placementCfg.PartitionNumber(*note.Partition))
+	case note.Broker != nil:
+		return fmt.Sprintf("Broker %d", *note.Broker)
+	}
+	return ""
+}
+
+// annotationsView lists the notes for the placement header, followed by
+// the note being typed. Partition notes of other topics are left out.
+func (m Model) annotationsView() string {
+	var b strings.Builder
+	for _, note := range m.annotations {
+		if note.Partition != nil && note.Topic != m.placementCfg.TopicName {
+			continue
+		}
+		if label := m.noteLabel(note); label != "" {
+			b.WriteString(fmt.Sprintf("✎ %s: %s\n", label, note.Text))
+		} else {
+			b.WriteString(fmt.Sprintf("✎ %s\n", note.Text))
+		}
+	}
+	if m.editingNote {
+		target := i18n.T("note.title")
+		if m.brokerSelected {
+			target = i18n.T("note.broker", m.selectedBroker)
+		}
+		b.WriteString(fmt.Sprintf("%s: %s\n", target, m.noteInput.View()))
+		b.WriteString(HelpStyle.Render(i18n.T("note.help")) + "\n")
+	}
+	return b.String()
+}
+
+// Bundle returns the current placement with its scenario and notes as a
+// bundle to share, or nil if no placement is shown.
+func (m Model) Bundle() *session.Bundle {
+	s := m.Session()
+	if s == nil {
+		return nil
+	}
+	b := &session.Bundle{Session: *s, Annotations: m.annotations}
+	if m.scenario != nil {
+		b.Scenario = m.scenario.Name
+	}
+	return b
+}
+
+// OpenBundle shows a shared bundle exactly as it was saved, with its
+// scenario notes and annotations; a bundle saved with 0-based partition
+// numbers keeps them.
+func (m *Model) OpenBundle(b *session.Bundle) {
+	m.zeroBasedPartitions = m.zeroBasedPartitions || b.Config.ZeroBasedPartitions
+	m.resume(&b.Session)
+	m.scenario = nil
+	if b.Scenario != "" {
+		if s, err := scenarios.Find(b.Scenario); err == nil {
+			m.scenario = &s
+		}
+	}
+	m.annotations = b.Annotations
+}
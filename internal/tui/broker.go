@@ -0,0 +1,183 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// brokerModalChrome is the number of screen lines the broker modal uses
+// besides the replica rows: title, broker header, table header and footer.
+const brokerModalChrome = 9
+
+// brokerOrder returns all broker IDs in display order: by DC, then by ID.
+func (m Model) brokerOrder() []int {
+	var ids []int
+	for _, dcID := range sortedDCIDs(m.dcs) {
+		ids = append(ids, sortedBrokerIDs(m.dcs[dcID])...)
+	}
+	return ids
+}
+
+// moveSelection moves the broker selection by delta in display order,
+// wrapping around. The first move selects the first (or last) broker.
+func (m *Model) moveSelection(delta int) {
+	ids := m.brokerOrder()
+	if len(ids) == 0 {
+		return
+	}
+	if !m.brokerSelected {
+		m.brokerSelected = true
+		if delta > 0 {
+			m.selectedBroker = ids[0]
+		} else {
+			m.selectedBroker = ids[len(ids)-1]
+		}
+		return
+	}
+	for i, id := range ids {
+		if id == m.selectedBroker {
+			m.selectedBroker = ids[((i+delta)%len(ids)+len(ids))%len(ids)]
+			return
+		}
+	}
+	m.selectedBroker = ids[0] // Selected broker no longer exists
+}
+
+// findSelectedBroker returns the selected broker and its DC, if any.
+func (m Model) findSelectedBroker() (*config.BrokerInfo, *config.DCInfo) {
+	if !m.brokerSelected {
+		return nil, nil
+	}
+	for _, dc := range m.dcs {
+		if broker, ok := dc.Brokers[m.selectedBroker]; ok {
+			return broker, dc
+		}
+	}
+	return nil, nil
+}
+
+// modalPageSize is the number of replica rows that fit on screen besides
+// the selected broker's tags, notes and disk breakdown. Without a known terminal height every row
+// is shown.
+func (m Model) modalPageSize(rows int) int {
+	chrome := brokerModalChrome + len(m.brokerNotes(m.selectedBroker)) + m.storageLines(m.selectedBroker)
+	if m.brokerTagsText(m.selectedBroker) != "" {
+		chrome++
+	}
+	if m.height <= chrome {
+		return rows
+	}
+	return min(rows, m.height-chrome)
+}
+
+// brokerNotes returns the texts of the notes on a broker.
+func (m Model) brokerNotes(id int) []string {
+	var texts []string
+	for _, note := range m.annotations {
+		if note.Broker != nil && *note.Broker == id {
+			texts = append(texts, note.Text)
+		}
+	}
+	return texts
+}
+
+// scrollModal moves the broker modal's first visible row by delta, keeping
+// the last page full.
+func (m *Model) scrollModal(delta int) {
+	broker, _ := m.findSelectedBroker()
+	if broker == nil {
+		return
+	}
+	rows := len(broker.Replicas)
+	m.modalScroll = max(0, min(m.modalScroll+delta, rows-m.modalPageSize(rows)))
+}
+
+// brokerModalView renders the full-screen detail view of the selected
+// broker: its disk usage stacked by topic, then every replica with its
+// partition, topic, role, preferred-leader status and estimated size.
+func (m Model) brokerModalView() string {
+	broker, dc := m.findSelectedBroker()
+	if broker == nil {
+		return HelpStyle.Render(i18n.T("broker.none"))
+	}
+	cfg := m.placementCfg
+
+	var b strings.Builder
+	header := i18n.T("broker.header.dc", broker.ID, dc.ID)
+	if m.placementCfg.RackAware() {
+		header = i18n.T("broker.header.rack", broker.ID, dc.Name)
+	} else if dc.Name != "" {
+		header += fmt.Sprintf(" (%s)", dc.Name)
+	}
+	b.WriteString(DCHeaderStyle.Render(header))
+	b.WriteString("\n")
+
+	var totalBytes int64
+	leaders := 0
+	for _, r := range broker.Replicas {
+		totalBytes += cfg.PartitionLoads[r.PartitionID].SizeBytes
+		if r.Role == config.Leader {
+			leaders++
+		}
+	}
+	summary := fmt.Sprintf("%s, %s", i18n.Plural(len(broker.Replicas), "replica"), i18n.Plural(leaders, "leader"))
+	if len(cfg.PartitionLoads) > 0 {
+		summary += ", " + formatBytes(totalBytes)
+	}
+	b.WriteString(summary + "\n")
+	if tags := m.brokerTagsText(broker.ID); tags != "" {
+		b.WriteString(HelpStyle.Render(i18n.T("broker.tags", tags)) + "\n")
+	}
+	for _, text := range m.brokerNotes(broker.ID) {
+		b.WriteString(fmt.Sprintf("✎ %s\n", text))
+	}
+	b.WriteString(m.storageView(broker.ID))
+	b.WriteString("\n")
+	notes := m.partitionNotes()
+
+	topic := cfg.TopicName
+	if topic == "" {
+		topic = "-"
+	}
+	b.WriteString(fmt.Sprintf("%-10s %-20s %-10s %-17s %s\n", i18n.T("broker.partition"), i18n.T("broker.topic"), i18n.T("broker.role"), i18n.T("broker.preferred"), i18n.T("tenants.size")))
+
+	replicas := make([]config.ReplicaInfo, len(broker.Replicas))
+	copy(replicas, broker.Replicas)
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i].PartitionID < replicas[j].PartitionID })
+	rows := len(replicas)
+	page := m.modalPageSize(rows)
+	start := min(m.modalScroll, rows-page)
+	for _, r := range replicas[start : start+page] {
+		// The placement assigns the leader role to the preferred (first) replica
+		preferred := i18n.T("rf.no")
+		if r.Role == config.Leader {
+			preferred = i18n.T("rf.yes")
+		}
+		size := i18n.T("mirror.unknown")
+		if load, ok := cfg.PartitionLoads[r.PartitionID]; ok {
+			size = formatBytes(load.SizeBytes)
+		}
+		line := fmt.Sprintf("%-10s %s %-10s %-17s %s", fmt.Sprintf("p%d", m.placementCfg.PartitionNumber(r.PartitionID)), padRight(topic, 20), roleName(r.Role), preferred, size)
+		b.WriteString(m.replicaStyle(r.Role, dc.ID).Render(line))
+		if texts := notes[r.PartitionID]; len(texts) > 0 {
+			b.WriteString(HelpStyle.Render("  ✎ " + strings.Join(texts, "; ")))
+		}
+		b.WriteString("\n")
+	}
+	if rows == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("placement.empty")) + "\n")
+	}
+
+	b.WriteString("\n")
+	help := i18n.T("broker.help")
+	if page < rows {
+		help = i18n.T("broker.rows", start+1, start+page, rows, help)
+	}
+	b.WriteString(HelpStyle.Render(help))
+	return b.String()
+}
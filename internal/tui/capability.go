@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// capabilityTopics caps the topics the capability matrix is shown for.
+const capabilityTopics = 5
+
+// capabilityScenario is a failure the capability matrix shows.
+type capabilityScenario struct {
+	name    string
+	brokers map[int]bool
+	dcs     []int
+}
+
+// capabilityScenarios returns the failures of the failover pane: the
+// selected broker, the broker whose loss hurts most, and every DC.
+func (m Model) capabilityScenarios(estimates []failover.Estimate) []capabilityScenario {
+	var scenarios []capabilityScenario
+	if m.brokerSelected {
+		scenarios = append(scenarios, capabilityScenario{
+			name:    i18n.T("capability.selected", m.selectedBroker),
+			brokers: map[int]bool{m.selectedBroker: true},
+		})
+	}
+	if len(estimates) > 0 && (!m.brokerSelected || estimates[0].BrokerID != m.selectedBroker) {
+		worst := estimates[0].BrokerID
+		scenarios = append(scenarios, capabilityScenario{
+			name:    i18n.T("capability.worst", worst),
+			brokers: map[int]bool{worst: true},
+		})
+	}
+	if ids := sortedDCIDs(m.dcs); len(ids) > 1 {
+		for _, id := range ids {
+			scenarios = append(scenarios, capabilityScenario{name: i18n.T("capability.dcLost", m.dcs[id].Rack()), dcs: []int{id}})
+		}
+	}
+	return scenarios
+}
+
+// capabilityView renders, per topic, which client requests still work
+// during each failure of the failover pane: produce with acks=all or
+// acks=1, consume from the leader, and consume from a follower in the
+// consumer's own DC.
+func (m Model) capabilityView(estimates []failover.Estimate) string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("capability.title")))
+	b.WriteString("\n")
+	scenarios := m.capabilityScenarios(estimates)
+	if len(scenarios) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("capability.none")))
+		return b.String()
+	}
+
+	q := m.quorum()
+	multiDC := len(m.dcs) > 1
+	topics := m.placedTopics()
+	for i, t := range topics[:min(len(topics), capabilityTopics)] {
+		if len(topics) > 1 {
+			name := t.cfg.TopicName
+			if name == "" {
+				name = i18n.T("capability.topic", i+1)
+			}
+			b.WriteString(i18n.T("capability.topicHeader", name, t.cfg.MinInSyncReplicas) + "\n")
+		}
+		b.WriteString(fmt.Sprintf("  %s %-10s %-10s %-13s %s\n", padRight(i18n.T("capability.col.failure"), 28), "acks=all", "acks=1",
+			i18n.T("capability.col.leader"), i18n.T("capability.col.local")))
+		for _, s := range scenarios {
+			c := failover.Capabilities(t.dcs, q, s.brokers, s.dcs, t.cfg.MinInSyncReplicas)
+			local := "-"
+			if multiDC {
+				local = capabilityCell(c.LocalReads, c.Partitions)
+			}
+			line := fmt.Sprintf("%s %-10s %-10s %-13s %s", padRight(s.name, 28),
+				capabilityCell(c.AcksAll, c.Partitions), capabilityCell(c.Led, c.Partitions), capabilityCell(c.Led, c.Partitions), local)
+			switch {
+			case c.Led < c.Partitions:
+				b.WriteString("  " + FailStyle.Render(line) + "\n")
+			case c.AcksAll < c.Partitions:
+				b.WriteString("  " + WarnStyle.Render(line) + "\n")
+			default:
+				b.WriteString("  " + line + "\n")
+			}
+		}
+	}
+	if n := len(topics) - capabilityTopics; n > 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("capability.more", i18n.Plural(n, "topic"))) + "\n")
+	}
+	help := i18n.T("capability.help")
+	if multiDC {
+		help += i18n.T("capability.help.local")
+	}
+	if !m.brokerSelected && !m.printing {
+		help += i18n.T("capability.help.select")
+	}
+	b.WriteString(HelpStyle.Render(help))
+	return b.String()
+}
+
+// capabilityCell renders how many of the partitions still serve a request.
+func capabilityCell(ok, partitions int) string {
+	if ok == partitions {
+		return "✓"
+	}
+	return i18n.T("capability.failing", partitions-ok, partitions)
+}
@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/capacity"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetBrokerCapacity sets the throughput capacity of the brokers: defaults
+// for every broker and overrides by broker ID.
+func (m *Model) SetBrokerCapacity(defaults capacity.Capacity, perBroker map[int]capacity.Capacity) {
+	m.capacityDefaults = defaults
+	m.brokerCapacity = perBroker
+}
+
+// capacityUsage estimates every broker's throughput against its capacity,
+// or returns nil when no capacity or no produce/consume rates are loaded.
+func (m Model) capacityUsage() map[int]capacity.Usage {
+	if m.capacityDefaults == (capacity.Capacity{}) && len(m.brokerCapacity) == 0 {
+		return nil
+	}
+	hasTraffic := false
+	for _, load := range m.placementCfg.PartitionLoads {
+		if load.BytesInPerSec > 0 || load.BytesOutPerSec > 0 {
+			hasTraffic = true
+			break
+		}
+	}
+	if !hasTraffic {
+		return nil
+	}
+	return capacity.Estimate(m.dcs, m.placementCfg.PartitionLoads, m.capacityDefaults, m.brokerCapacity)
+}
+
+// capacityBorder returns the border color of a broker nearing or over its
+// throughput capacity, and false for brokers with enough headroom.
+func capacityBorder(usage map[int]capacity.Usage, brokerID int) (lipgloss.AdaptiveColor, bool) {
+	u, ok := usage[brokerID]
+	switch {
+	case !ok:
+		return lipgloss.AdaptiveColor{}, false
+	case u.Max() >= 1:
+		return errorColor, true
+	case u.Max() >= capacity.NearSaturation:
+		return followerColor, true
+	}
+	return lipgloss.AdaptiveColor{}, false
+}
+
+// capacityStatus renders the header warning about saturated brokers, or ""
+// when every broker has headroom left.
+func (m Model) capacityStatus() string {
+	var over, near []int
+	for id, u := range m.capacityUsage() {
+		if u.Max() >= 1 {
+			over = append(over, id)
+		} else if u.Max() >= capacity.NearSaturation {
+			near = append(near, id)
+		}
+	}
+	sort.Ints(over)
+	sort.Ints(near)
+	var b strings.Builder
+	if len(over) > 0 {
+		b.WriteString(FailStyle.Render(i18n.T("capacity.over", brokerList(over))))
+		b.WriteString("\n")
+	}
+	if len(near) > 0 {
+		b.WriteString(WarnStyle.Render(i18n.T("capacity.near", capacity.NearSaturation*100, brokerList(near))))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// headroomView renders the stats pane's table of every broker's estimated
+// throughput, utilization and remaining headroom.
+func (m Model) headroomView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("capacity.title")))
+	b.WriteString("\n")
+	usage := m.capacityUsage()
+	if usage == nil {
+		b.WriteString(HelpStyle.Render(i18n.T("capacity.none")))
+		return b.String()
+	}
+
+	ids := make([]int, 0, len(usage))
+	for id := range usage {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	b.WriteString(fmt.Sprintf("%-8s %-12s %-12s %-12s %-9s %-9s %s\n", i18n.T("capacity.col.broker"), i18n.T("capacity.col.in"), i18n.T("capacity.col.out"),
+		i18n.T("capacity.col.diskWrite"), i18n.T("capacity.col.network"), i18n.T("capacity.col.disk"), i18n.T("capacity.col.headroom")))
+	for _, id := range ids {
+		u := usage[id]
+		headroom := i18n.T("capacity.unknown")
+		if u.Max() > 0 {
+			bottleneck := i18n.T("capacity.bottleneck." + u.Bottleneck())
+			headroom = i18n.T("capacity.headroom", formatRate(max(u.Headroom(), 0)), bottleneck)
+			if u.Headroom() < 0 {
+				headroom = i18n.T("capacity.overBy", formatRate(-u.Headroom()), bottleneck)
+			}
+		}
+		line := fmt.Sprintf("%-8d %-12s %-12s %-12s %-9s %-9s %s", id,
+			formatRate(u.Load.BytesIn), formatRate(u.Load.BytesOut), formatRate(u.DiskWrite),
+			utilization(u.Network(), u.Capacity.Network), utilization(u.Disk(), u.Capacity.Disk), headroom)
+		switch {
+		case u.Max() >= 1:
+			b.WriteString(FailStyle.Render(line))
+		case u.Max() >= capacity.NearSaturation:
+			b.WriteString(WarnStyle.Render(line))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("capacity.help")))
+	return b.String()
+}
+
+// utilization formats a utilization as a percentage, "-" when the capacity
+// is unknown.
+func utilization(u, limit float64) string {
+	if limit <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", u*100)
+}
+
+// brokerList renders broker IDs after "broker" or "brokers", e.g.
+// "brokers 1, 2 and 3".
+func brokerList(ids []int) string {
+	if len(ids) == 1 {
+		return i18n.T("list.brokers.one", joinInts(ids))
+	}
+	return i18n.T("list.brokers.other", joinInts(ids))
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// ClusterMetadata describes the live cluster a placement was read from, so
+// screenshots of a live assignment say which cluster they show.
+type ClusterMetadata struct {
+	Name         string
+	ID           string         // Kafka cluster ID, empty if unknown
+	KafkaVersion string         // Empty if unknown
+	Controller   string         // e.g. "KRaft quorum 3,4,5", empty if unknown
+	Racks        map[int]string // Broker ID -> rack; "" for brokers without one
+}
+
+// SetClusterMetadata sets the metadata shown above a live assignment.
+func (m *Model) SetClusterMetadata(meta *ClusterMetadata) {
+	m.clusterMeta = meta
+}
+
+// clusterMetadataView renders the cluster metadata header of a live
+// assignment, or "" when no live assignment is shown. Unknown fields are
+// shown as such rather than left out, so the header always has one shape.
+func (m Model) clusterMetadataView() string {
+	if !m.liveAssignment || m.clusterMeta == nil {
+		return ""
+	}
+	meta := m.clusterMeta
+	var b strings.Builder
+	b.WriteString(FocusedStyle.Bold(true).Render(i18n.T("cluster.title", meta.Name)))
+	b.WriteString(i18n.T("cluster.details", orUnknown(meta.ID), orUnknown(meta.KafkaVersion), orUnknown(meta.Controller)) + "\n")
+	if racks := meta.racks(); racks != "" {
+		b.WriteString(HelpStyle.Render(i18n.T("cluster.racks", racks)) + "\n")
+	}
+	return b.String()
+}
+
+// racks groups the brokers by rack, e.g. "zone-a: 0 and 1 · zone-b: 2".
+func (meta *ClusterMetadata) racks() string {
+	byRack := make(map[string][]int)
+	for id, rack := range meta.Racks {
+		byRack[rack] = append(byRack[rack], id)
+	}
+	names := make([]string, 0, len(byRack))
+	for rack := range byRack {
+		names = append(names, rack)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, rack := range names {
+		ids := byRack[rack]
+		sort.Ints(ids)
+		name := rack
+		if name == "" {
+			name = i18n.T("cluster.noRack")
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", name, joinInts(ids)))
+	}
+	return strings.Join(parts, " · ")
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return i18n.T("cluster.unknown")
+	}
+	return s
+}
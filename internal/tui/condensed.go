@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// Below this size the broker boxes, header and footer no longer fit side by
+// side and overlap as the terminal wraps them, so the placement is shown
+// condensed: one line per broker.
+const (
+	minWidth  = 60
+	minHeight = 16
+)
+
+// condensedChrome is the lines the condensed view uses besides the broker
+// lines: the title and its margin, the notice, the topic and health lines,
+// and the help line with its margin.
+const condensedChrome = 7
+
+// tinyTerminal reports whether the terminal is below the minimum size. An
+// unknown size (before the first resize message, or inline mode) is not.
+func (m Model) tinyTerminal() bool {
+	return (m.width > 0 && m.width < minWidth) || (m.height > 0 && m.height < minHeight)
+}
+
+// condensedView renders the placement in a single column for terminals
+// below the minimum size: a line per broker with the partitions it leads,
+// follows and observes, cut to the terminal width, as many as fit.
+func (m Model) condensedView() string {
+	var lines []string
+	for _, dcID := range sortedDCIDs(m.dcs) {
+		dc := m.dcs[dcID]
+		if m.grouped() {
+			lines = append(lines, DCHeaderStyle.UnsetMarginBottom().Render(m.fit(dc.Rack()+":")))
+		}
+		for _, brokerID := range sortedBrokerIDs(dc) {
+			lines = append(lines, m.condensedBroker(dc.Brokers[brokerID]))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(WarnStyle.Render(m.fit(i18n.T("condensed.warning", m.width, m.height, minWidth, minHeight))) + "\n")
+	status := i18n.T("condensed.health", m.health().Score)
+	if m.placementCfg.TopicName != "" {
+		status = m.placementCfg.TopicName + " · " + status
+	}
+	b.WriteString(m.fit(status) + "\n")
+	if avail := m.height - condensedChrome; m.height > 0 && len(lines) > max(1, avail) {
+		shown := max(1, avail-1)
+		lines = append(lines[:shown], HelpStyle.Render(m.fit(i18n.T("condensed.more", len(lines)-shown))))
+	}
+	b.WriteString(strings.Join(lines, "\n"))
+	b.WriteString("\n\n")
+	b.WriteString(HelpStyle.Render(m.fit(i18n.T("condensed.help"))))
+	return b.String()
+}
+
+// condensedBroker renders a broker as one line, e.g. "b1 L p1 p4 F p2",
+// marking the selected broker with ">".
+func (m Model) condensedBroker(broker *config.BrokerInfo) string {
+	byRole := map[config.ReplicaRole][]string{}
+	for _, r := range broker.Replicas {
+		byRole[r.Role] = append(byRole[r.Role], fmt.Sprintf("p%d", m.placementCfg.PartitionNumber(r.PartitionID)))
+	}
+	prefix := "  "
+	if m.brokerSelected && broker.ID == m.selectedBroker {
+		prefix = "> "
+	}
+	parts := []string{fmt.Sprintf("%sb%d", prefix, broker.ID)}
+	for _, role := range []config.ReplicaRole{config.Leader, config.Follower, config.Observer} {
+		if ids := byRole[role]; len(ids) > 0 {
+			parts = append(parts, string(role)[:1]+" "+strings.Join(ids, " "))
+		}
+	}
+	if len(broker.Replicas) == 0 {
+		parts = append(parts, i18n.T("condensed.empty"))
+	}
+	return m.fit(strings.Join(parts, " "))
+}
+
+// fit cuts s to the terminal width, so a line never wraps into the next.
+func (m Model) fit(s string) string {
+	if m.width <= 0 {
+		return s
+	}
+	return cellWidth.Truncate(s, m.width, "…")
+}
@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// quorum returns the KRaft controller quorum of the current placement, as
+// deployed by the session's controller mode.
+func (m Model) quorum() failover.Quorum {
+	cfg := m.placementCfg
+	cfg.Controllers, cfg.ControllerCount = m.controllers, m.controllerCount
+	q := failover.NewQuorum(cfg, m.dcs)
+	q.Active = m.activeController
+	return q
+}
+
+// toggleControllers cycles the controllers between combined, dedicated and
+// a ZooKeeper ensemble. The replica placement stays the same; only the
+// failure simulations change.
+func (m *Model) toggleControllers() {
+	m.controllers = (m.controllers + 1) % (config.ControllersZooKeeper + 1)
+	m.placementCfg.Controllers = m.controllers
+}
+
+// quorumDCName names a DC of the quorum, which may have no brokers (e.g. a
+// tiebreaker site of an imported cluster).
+func (m Model) quorumDCName(id int) string {
+	if dc, ok := m.dcs[id]; ok {
+		return dc.Rack()
+	}
+	if name := m.placementCfg.DCNames[id]; name != "" {
+		return name
+	}
+	return fmt.Sprintf("dc%d", id)
+}
+
+// quorumStatus renders where the controller voters run and whether the
+// quorum survives the loss of any one DC, for the placement header. When a
+// DC holds the quorum's majority although the data would survive its loss,
+// it also suggests how to spread the voters instead.
+func (m Model) quorumStatus() string {
+	q := m.quorum()
+	if len(q.Voters) == 0 {
+		return ""
+	}
+	var nodes []int
+	dcIDs := make(map[int]bool)
+	for _, v := range q.Voters {
+		nodes = append(nodes, v.NodeID)
+		dcIDs[v.DCID] = true
+	}
+	var where string
+	switch q.Mode {
+	case config.ControllersDedicated:
+		where = i18n.T("controllers.dedicated", joinInts(nodes))
+	case config.ControllersZooKeeper:
+		where = i18n.T("controllers.zookeeper", joinInts(nodes))
+	default:
+		where = i18n.T("controllers.combined", joinInts(nodes))
+	}
+	line := i18n.T("controllers.status", where, q.Majority(), len(q.Voters))
+	if l, ok := failover.NewMetadataLog(q, m.dcs); ok {
+		line += i18n.T("controllers.metadataLeader", failover.MetadataTopic, l.Leader.NodeID)
+	}
+	for id := range m.dcs {
+		dcIDs[id] = true
+	}
+	if len(dcIDs) < 2 {
+		return line + "\n"
+	}
+
+	var lost []string
+	for _, id := range sortedKeys(dcIDs) {
+		if !q.Survives(nil, map[int]bool{id: true}) {
+			lost = append(lost, m.quorumDCName(id))
+		}
+	}
+	if len(lost) == 0 {
+		return line + "; " + PassStyle.Render(i18n.T("controllers.survives")) + "\n"
+	}
+	line += "; " + FailStyle.Render(i18n.T("controllers.lost", strings.Join(lost, i18n.T("controllers.or")))) + "\n"
+
+	risks := failover.QuorumRisks(m.dcs, q)
+	if len(risks) == 0 {
+		return line // The data goes down with the quorum anyway
+	}
+	var b strings.Builder
+	b.WriteString(line)
+	for _, r := range risks {
+		b.WriteString(WarnStyle.Render(i18n.T("controllers.risk", m.quorumDCName(r.DCID), r.Voters, len(q.Voters))) + "\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("controllers.suggested", m.voterSuggestion(q, sortedKeys(dcIDs)))) + "\n")
+	return b.String()
+}
+
+// voterSuggestion describes a spread of the voters over the DCs that
+// survives the loss of any one of them, adding a tiebreaker site when there
+// are only two DCs.
+func (m Model) voterSuggestion(q failover.Quorum, dcIDs []int) string {
+	const tiebreaker = 0 // DC IDs are 1-based
+	ids := dcIDs
+	if len(ids) < 3 {
+		ids = append(append([]int(nil), ids...), tiebreaker)
+	}
+	perDC, ok := failover.SuggestVoters(q, ids)
+	if !ok {
+		return i18n.T("controllers.addDCs")
+	}
+	total := 0
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name := i18n.T("controllers.tiebreaker")
+		if id != tiebreaker {
+			name = m.quorumDCName(id)
+		}
+		parts = append(parts, i18n.T("controllers.votersIn", perDC[id], name))
+		total += perDC[id]
+	}
+	s := i18n.T("controllers.voters", total, strings.Join(parts, ", "))
+	if q.Mode == config.ControllersCombined && len(dcIDs) < 3 {
+		s += i18n.T("controllers.tiebreakerNode")
+	}
+	return s
+}
+
+// metadataView renders the placement of the __cluster_metadata log and
+// what happens to it when each voter's broker or each DC fails. Every
+// leader election, ISR change and broker registration is a write to this
+// log, so it gates the recovery of all other partitions.
+func (m Model) metadataView() string {
+	q := m.quorum()
+	l, ok := failover.NewMetadataLog(q, m.dcs)
+	if !ok {
+		return ""
+	}
+	t := m.failoverTiming
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("metadata.title", failover.MetadataTopic, l.Leader.NodeID, m.quorumDCName(l.Leader.DCID))))
+	b.WriteString("\n")
+	voters := make([]string, len(l.Voters))
+	for i, v := range l.Voters {
+		voters[i] = fmt.Sprintf("%d (%s)", v.NodeID, m.quorumDCName(v.DCID))
+	}
+	b.WriteString(i18n.T("metadata.voters", strings.Join(voters, ", "), q.Majority(), len(q.Voters)) + "\n")
+	if len(l.Observers) > 0 {
+		b.WriteString(i18n.T("metadata.observers", joinInts(l.Observers)) + "\n")
+	}
+
+	outcome := func(what string, o failover.MetadataOutcome) {
+		line := i18n.T("metadata.losing", what)
+		switch {
+		case !o.Writable:
+			b.WriteString(FailStyle.Render(line+i18n.T("metadata.readOnly", o.VotersUp, len(l.Voters), q.Majority())) + "\n")
+		case o.LeaderMoved:
+			b.WriteString(WarnStyle.Render(line+i18n.T("metadata.leaderMoved", o.Leader, t.ControllerFailover)) + "\n")
+		default:
+			b.WriteString(line + i18n.T("metadata.writable", o.VotersUp, len(l.Voters)) + "\n")
+		}
+	}
+	if q.Mode == config.ControllersCombined {
+		for _, v := range l.Voters {
+			o := l.After(q, map[int]bool{v.NodeID: true}, nil)
+			outcome(i18n.T("metadata.losing.broker", v.NodeID), o)
+		}
+	}
+	dcIDs := make(map[int]bool)
+	for _, v := range l.Voters {
+		dcIDs[v.DCID] = true
+	}
+	for id := range m.dcs {
+		dcIDs[id] = true
+	}
+	if len(dcIDs) > 1 {
+		for _, id := range sortedKeys(dcIDs) {
+			outcome(m.quorumDCName(id), l.After(q, nil, map[int]bool{id: true}))
+		}
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("metadata.help")))
+	return b.String()
+}
+
+// sortedKeys returns the keys of a set in ascending order.
+func sortedKeys(set map[int]bool) []int {
+	keys := make([]int, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,206 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/export"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// In the "by changes" coloring mode the broker boxes show how the placement
+// differs from a diff base: the placement shown before the strategy changed
+// or the scenario was edited, or else the random strategy's placement of the
+// same config. Every chip carries a text marker too, so the diff does not
+// depend on color alone.
+
+// replicaChange is how a replica differs from the diff base.
+type replicaChange int
+
+const (
+	replicaSame        replicaChange = iota
+	replicaAdded                     // The broker did not host the partition
+	replicaRoleChanged               // Same partition, different role
+	replicaRemoved                   // The broker no longer hosts the partition
+)
+
+// Styles of the diff chips, set by buildStyles
+var (
+	diffAddedStyle   lipgloss.Style
+	diffRemovedStyle lipgloss.Style
+	diffChangedStyle lipgloss.Style
+)
+
+// diffChip is one replica chip of a broker in the diff view.
+type diffChip struct {
+	replica config.ReplicaInfo // Removed replicas carry their old role
+	change  replicaChange
+}
+
+// diffChipText returns the unstyled chip: +pX added, -pX removed, ~pX role
+// changed.
+func (m Model) diffChipText(c diffChip) string {
+	n := m.placementCfg.PartitionNumber(c.replica.PartitionID)
+	switch c.change {
+	case replicaAdded:
+		return fmt.Sprintf(" +p%d", n)
+	case replicaRemoved:
+		return fmt.Sprintf(" -p%d", n)
+	case replicaRoleChanged:
+		return fmt.Sprintf(" ~p%d", n)
+	}
+	return fmt.Sprintf(" p%d", n)
+}
+
+// diffBase returns the placement the current one is compared with, and a
+// description of it, or nil if there is none.
+func (m Model) diffBase() (map[int]*config.DCInfo, string) {
+	if m.diffDCs != nil {
+		return m.diffDCs, m.diffLabel
+	}
+	if m.baselineDCs != nil {
+		return m.baselineDCs, i18n.T("diff.random")
+	}
+	return nil, ""
+}
+
+// diffing reports whether the broker boxes show the diff.
+func (m Model) diffing() bool {
+	base, _ := m.diffBase()
+	return m.colorMode == ColorByDiff && base != nil
+}
+
+// setDiffBase makes dcs the diff base, e.g. the placement shown before a
+// change.
+func (m *Model) setDiffBase(dcs map[int]*config.DCInfo, label string) {
+	m.diffDCs, m.diffLabel = dcs, label
+}
+
+// brokerDiffChips compares the replicas of a broker with the same broker in
+// base: its current replicas by partition, then the ones it lost.
+func brokerDiffChips(base map[int]*config.DCInfo, broker *config.BrokerInfo) []diffChip {
+	before := make(map[int]config.ReplicaRole)
+	for _, dc := range base {
+		if b, ok := dc.Brokers[broker.ID]; ok {
+			for _, r := range b.Replicas {
+				before[r.PartitionID] = r.Role
+			}
+		}
+	}
+	chips := make([]diffChip, 0, len(broker.Replicas))
+	current := make(map[int]bool, len(broker.Replicas))
+	for _, r := range broker.Replicas {
+		current[r.PartitionID] = true
+		chip := diffChip{replica: r}
+		if role, ok := before[r.PartitionID]; !ok {
+			chip.change = replicaAdded
+		} else if role != r.Role {
+			chip.change = replicaRoleChanged
+		}
+		chips = append(chips, chip)
+	}
+	var removed []int
+	for id := range before {
+		if !current[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Ints(removed)
+	for _, id := range removed {
+		chips = append(chips, diffChip{replica: config.ReplicaInfo{PartitionID: id, Role: before[id]}, change: replicaRemoved})
+	}
+	return chips
+}
+
+// renderDiffChips renders the chips of a broker in the diff view. The role
+// is shown as text style, as in the by-DC mode, and the color is the change.
+func (m Model) renderDiffChips(chips []diffChip) string {
+	var b strings.Builder
+	for _, c := range chips {
+		style := m.replicaStyle(c.replica.Role, -1)
+		switch c.change {
+		case replicaAdded:
+			style = style.Foreground(leaderColor)
+		case replicaRemoved:
+			style = diffRemovedStyle
+		case replicaRoleChanged:
+			style = style.Foreground(followerColor)
+		}
+		b.WriteString(style.Render(m.diffChipText(c)))
+	}
+	return b.String()
+}
+
+// diffCounts totals the changes from the diff base over all brokers.
+func (m Model) diffCounts() (added, removed, changed int) {
+	base, _ := m.diffBase()
+	for _, dc := range m.dcs {
+		for _, broker := range dc.Brokers {
+			for _, c := range brokerDiffChips(base, broker) {
+				switch c.change {
+				case replicaAdded:
+					added++
+				case replicaRemoved:
+					removed++
+				case replicaRoleChanged:
+					changed++
+				}
+			}
+		}
+	}
+	return added, removed, changed
+}
+
+// diffLegend renders the legend of the diff view with the change totals.
+func (m Model) diffLegend() string {
+	_, label := m.diffBase()
+	added, removed, changed := m.diffCounts()
+	var b strings.Builder
+	b.WriteString(i18n.T("diff.legend", label) + " ")
+	b.WriteString(diffAddedStyle.Render(i18n.T("diff.added", added)))
+	b.WriteString("  ")
+	b.WriteString(diffRemovedStyle.Render(i18n.T("diff.removed", removed)))
+	b.WriteString("  ")
+	b.WriteString(diffChangedStyle.Render(i18n.T("diff.changed", changed)))
+	b.WriteString("  ")
+	b.WriteString(m.replicaStyle(config.Leader, -1).Render(i18n.T("legend.leader.bold")))
+	if m.clusterType == config.MRC {
+		b.WriteString("  ")
+		b.WriteString(m.replicaStyle(config.Observer, -1).Render(i18n.T("legend.observer.italic")))
+	}
+	if m.diffDCs != nil {
+		b.WriteString("\n" + m.wavesSummary())
+	}
+	return b.String()
+}
+
+// wavesSummary describes how the migration from the diff base to the shown
+// placement splits into waves under the session's limits.
+func (m Model) wavesSummary() string {
+	waves := export.PlanWaves(m.diffDCs, m.dcs, m.waveLimits)
+	if len(waves) == 0 {
+		return HelpStyle.Render(i18n.T("diff.nothingMoves"))
+	}
+	partitions, moves := 0, 0
+	for _, w := range waves {
+		partitions += len(w.Partitions)
+		moves += w.Moves
+	}
+	var limits []string
+	if m.waveLimits.PerBroker > 0 {
+		limits = append(limits, i18n.T("diff.perBroker", i18n.Plural(m.waveLimits.PerBroker, "move")))
+	}
+	if m.waveLimits.PerDC > 0 {
+		limits = append(limits, i18n.T("diff.perDC", i18n.Plural(m.waveLimits.PerDC, "new replica")))
+	}
+	s := i18n.T("diff.migration", i18n.Plural(partitions, "partition"), i18n.Plural(moves, "new replica"), i18n.Plural(len(waves), "wave"))
+	if len(limits) > 0 {
+		s += i18n.T("diff.atMost", joinList(limits))
+	}
+	return HelpStyle.Render(s + i18n.T("diff.wavesFlag"))
+}
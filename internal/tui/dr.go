@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/dr"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// drColumnWidth is the width of each design's column in the DR comparison.
+const drColumnWidth = 40
+
+// SetMirrorLag sets how far MirrorMaker 2 trails the primary cluster in the
+// DR comparison, the RPO of the two-cluster design. 0 keeps the default.
+func (m *Model) SetMirrorLag(lag time.Duration) {
+	m.mirrorLag = lag
+}
+
+// drParams returns the assumptions of the DR comparison: the session's
+// failover timing and MM2 lag.
+func (m Model) drParams() dr.Params {
+	p := dr.DefaultParams()
+	p.Timing = m.failoverTiming
+	if m.mirrorLag > 0 {
+		p.MirrorLag = m.mirrorLag
+	}
+	return p
+}
+
+// drView renders the MRC placement and two independent clusters with MM2
+// side by side for the same topic: RPO and RTO of a DC loss, brokers,
+// storage and cross-DC traffic, and what each takes to run.
+func (m Model) drView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("dr.title")))
+	b.WriteString("\n")
+	if m.clusterType != config.MRC || len(m.dcs) < 2 {
+		b.WriteString(HelpStyle.Render(i18n.T("dr.needsMRC")))
+		return b.String()
+	}
+
+	mrc, mm2 := dr.Compare(m.placementCfg, m.dcs, m.quorum(), m.drParams())
+	row := func(label, left, right string) {
+		b.WriteString(padRight(label, 18) + padRight(left, drColumnWidth) + right + "\n")
+	}
+	duration := func(d time.Duration, zero string) string {
+		if d == 0 {
+			return zero
+		}
+		return d.Round(time.Millisecond).String()
+	}
+	row("", mrc.Name, mm2.Name)
+	row("RPO", fmt.Sprintf("%s (%s)", duration(mrc.RPO, "0"), mrc.RPONote), fmt.Sprintf("≤ %s (%s)", duration(mm2.RPO, "0"), mm2.RPONote))
+	row("RTO", fmt.Sprintf("%s (%s)", duration(mrc.RTO, "-"), mrc.RTONote), fmt.Sprintf("%s (%s)", duration(mm2.RTO, "-"), mm2.RTONote))
+	row(i18n.T("dr.row.brokers"), fmt.Sprintf("%d (%s)", mrc.Brokers, mrc.Sizing), fmt.Sprintf("%d (%s)", mm2.Brokers, mm2.Sizing))
+	if mrc.StorageBytes > 0 {
+		row(i18n.T("dr.row.storage"), formatBytes(mrc.StorageBytes), formatBytes(mm2.StorageBytes))
+	}
+	if mrc.CrossDCBytesPerSec > 0 || mm2.CrossDCBytesPerSec > 0 {
+		row(i18n.T("dr.row.crossDC"), formatRate(mrc.CrossDCBytesPerSec), formatRate(mm2.CrossDCBytesPerSec))
+	}
+
+	notes := func(d dr.Design) string {
+		lines := make([]string, len(d.Notes))
+		for i, note := range d.Notes {
+			lines[i] = "• " + note
+		}
+		return lipgloss.NewStyle().Width(drColumnWidth - 2).MarginRight(2).Render(strings.Join(lines, "\n"))
+	}
+	b.WriteString("\n")
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, padRight("", 18), notes(mrc), notes(mm2)))
+	b.WriteString("\n")
+	b.WriteString(HelpStyle.Render(i18n.T("dr.help", m.drParams().MirrorLag, m.failoverTiming.PromotionTime)))
+	return b.String()
+}
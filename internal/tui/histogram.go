@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+const (
+	histogramBarWidth = 40 // Width of the longest bar, in cells
+	histogramMaxRows  = 12 // More distinct counts than this are grouped into ranges
+)
+
+// histogramView renders the statistics screen: the distributions of
+// partitions (replicas) and leaders per broker. For large clusters this is
+// easier to read than the broker boxes.
+func (m Model) histogramView() string {
+	replicas := make(map[int]int)
+	for _, dc := range m.dcs {
+		for _, broker := range dc.Brokers {
+			replicas[broker.ID] = len(broker.Replicas)
+		}
+	}
+	leaders := leaderCounts(m.dcs)
+	for id := range replicas {
+		leaders[id] += 0 // Brokers without leaders still count as zero
+	}
+
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("histogram.partitions", i18n.Plural(len(replicas), "broker"))))
+	b.WriteString("\n")
+	b.WriteString(histogram(replicas, FollowerStyle.Render))
+	b.WriteString("\n\n")
+	b.WriteString(DCHeaderStyle.Render(i18n.T("histogram.leaders")))
+	b.WriteString("\n")
+	b.WriteString(histogram(leaders, LeaderStyle.Render))
+	return b.String()
+}
+
+// histogram renders how many brokers have each count as horizontal bars,
+// followed by a min/avg/max summary. render styles the bars.
+func histogram(counts map[int]int, render func(...string) string) string {
+	if len(counts) == 0 {
+		return HelpStyle.Render(i18n.T("failover.noBrokers"))
+	}
+	values := make([]int, 0, len(counts))
+	total := 0
+	for _, v := range counts {
+		values = append(values, v)
+		total += v
+	}
+	sort.Ints(values)
+	lo, hi := values[0], values[len(values)-1]
+
+	// One row per count, or per range of counts when there are too many
+	bucketSize := 1
+	if span := hi - lo + 1; span > histogramMaxRows {
+		bucketSize = (span + histogramMaxRows - 1) / histogramMaxRows
+	}
+	numBuckets := (hi-lo)/bucketSize + 1
+	buckets := make([]int, numBuckets)
+	for _, v := range values {
+		buckets[(v-lo)/bucketSize]++
+	}
+	maxBucket := 0
+	for _, n := range buckets {
+		if n > maxBucket {
+			maxBucket = n
+		}
+	}
+
+	labels := make([]string, numBuckets)
+	labelWidth := 0
+	for i := range buckets {
+		from := lo + i*bucketSize
+		if bucketSize == 1 {
+			labels[i] = fmt.Sprint(from)
+		} else {
+			labels[i] = fmt.Sprintf("%d-%d", from, from+bucketSize-1)
+		}
+		if len(labels[i]) > labelWidth {
+			labelWidth = len(labels[i])
+		}
+	}
+
+	var b strings.Builder
+	for i, n := range buckets {
+		bar := ""
+		if n > 0 {
+			// Scale to the widest bar, but never hide a non-empty bucket
+			bar = strings.Repeat("█", max(1, n*histogramBarWidth/maxBucket))
+		}
+		b.WriteString(fmt.Sprintf("%*s │%s %s\n", labelWidth, labels[i], render(bar), HelpStyle.Render(i18n.Plural(n, "broker"))))
+	}
+	b.WriteString(fmt.Sprintf("min %d, avg %.1f, max %d", lo, float64(total)/float64(len(values)), hi))
+	return b.String()
+}
@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"context"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// importBarWidth is the width of the import progress bar.
+const importBarWidth = 30
+
+// ImportProgress is told how far an import got: done of total units, e.g.
+// 40 of 1200 "topics" fetched. The unit is shown through its catalog key
+// "import.unit.<unit>". A total of 0 means it is not known yet.
+type ImportProgress func(done, total int, unit string)
+
+// ImportJob loads a cluster or scenario in the background, reporting its
+// progress, and returns how to show the result. It should give up when ctx
+// is cancelled. The returned function runs on the UI and may call the
+// model's setters, e.g. ImportPlacement or LoadScenario.
+type ImportJob func(ctx context.Context, progress ImportProgress) (func(*Model), error)
+
+// importState is an import running in the background. Its fields are
+// shared by every copy of the model, so the job can be cancelled from any.
+type importState struct {
+	title   string
+	job     ImportJob
+	ctx     context.Context
+	cancel  context.CancelFunc
+	updates chan tea.Msg // Progress, then the result
+}
+
+// importProgressMsg reports the progress of the running import.
+type importProgressMsg struct {
+	state       *importState
+	done, total int
+	unit        string
+}
+
+// importDoneMsg carries the result of an import.
+type importDoneMsg struct {
+	state *importState
+	apply func(*Model)
+	err   error
+}
+
+// StartImport runs job in the background once the program starts, showing
+// its progress under title, e.g. "Importing Strimzi cluster my-cluster".
+// Esc cancels it and returns to the cluster type menu.
+func (m *Model) StartImport(title string, job ImportJob) {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.importing = &importState{title: title, job: job, ctx: ctx, cancel: cancel, updates: make(chan tea.Msg)}
+	m.importDone, m.importTotal, m.importUnit = 0, 0, ""
+	m.stage = Importing
+}
+
+// run starts the import job and waits for its first message.
+func (s *importState) run() tea.Cmd {
+	go func() {
+		send := func(msg tea.Msg) {
+			select {
+			case s.updates <- msg:
+			case <-s.ctx.Done(): // Nobody listens after a cancel
+			}
+		}
+		apply, err := s.job(s.ctx, func(done, total int, unit string) {
+			send(importProgressMsg{state: s, done: done, total: total, unit: unit})
+		})
+		send(importDoneMsg{state: s, apply: apply, err: err})
+	}()
+	return s.wait()
+}
+
+// wait returns the next progress or result message of the import.
+func (s *importState) wait() tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case msg := <-s.updates:
+			return msg
+		case <-s.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// cancelImport stops the running import and returns to the cluster type
+// menu.
+func (m *Model) cancelImport() {
+	if m.importing == nil {
+		return
+	}
+	m.importing.cancel()
+	applog.Warnf("%s", i18n.T("import.cancelled", m.importing.title))
+	m.importing = nil
+	m.stage = AskClusterType
+}
+
+// updateImport handles the messages of the running import. Messages of an
+// import that was cancelled are dropped.
+func (m Model) updateImport(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case importProgressMsg:
+		if msg.state != m.importing {
+			return m, nil
+		}
+		m.importDone, m.importTotal, m.importUnit = msg.done, msg.total, msg.unit
+		return m, msg.state.wait()
+
+	case importDoneMsg:
+		if msg.state != m.importing {
+			return m, nil
+		}
+		m.importing = nil
+		msg.state.cancel() // Release the context
+		if msg.err != nil {
+			applog.Errorf("%s", i18n.T("import.failed", msg.state.title, msg.err))
+			m.err = msg.err
+			m.stage = ShowError
+			return m, nil
+		}
+		m.stage = AskClusterType // Unless the result shows something else
+		msg.apply(&m)
+		applog.Infof("%s", i18n.T("import.finished", msg.state.title))
+		if m.assignmentPath != "" {
+			return m, pollAssignment() // Follow a live assignment from now on
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			m.cancelImport()
+			return m.quit()
+		case "esc":
+			m.cancelImport()
+		}
+	}
+	return m, nil
+}
+
+// importView renders the progress of the running import.
+func (m Model) importView() string {
+	var b strings.Builder
+	title := i18n.T("import.title")
+	if m.importing != nil {
+		title = m.importing.title
+	}
+	b.WriteString(title + "...\n\n")
+	if m.importTotal > 0 {
+		// Progress may over-report, or the file grow during the read
+		filled := min(max(m.importDone*importBarWidth/m.importTotal, 0), importBarWidth)
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", importBarWidth-filled)
+		unit := i18n.T("import.unit." + m.importUnit)
+		b.WriteString(i18n.T("import.progress", bar, m.importDone*100/m.importTotal, m.importDone, m.importTotal, unit) + "\n")
+	} else {
+		b.WriteString(HelpStyle.Render(i18n.T("import.starting")) + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(HelpStyle.Render(i18n.T("import.help")))
+	return b.String()
+}
@@ -1,11 +1,15 @@
 package tui
 
 import (
-	"fmt"
+	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/glossary"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // setupInputsForStage configures the text input fields based on the current stage.
@@ -14,11 +18,13 @@ func (m *Model) setupInputsForStage() {
 	m.inputs = nil // Clear previous inputs
 	m.focused = 0
 	m.err = nil // Clear previous errors
+	m.fieldWarnings = nil
+	m.defaulted = nil
 
 	switch m.stage {
 	case AskSingleConfig:
 		m.inputs = make([]textinput.Model, 4)
-		placeholders := []string{"Total Brokers", "Partitions", "Replication Factor", "Min ISR"}
+		placeholders := fieldLabels(m.stage)
 		for i := range m.inputs {
 			m.inputs[i] = textinput.New()
 			m.inputs[i].Cursor.Style = CursorStyle // Use style from styles.go
@@ -32,7 +38,7 @@ func (m *Model) setupInputsForStage() {
 
 	case AskMRCConfig:
 		m.inputs = make([]textinput.Model, 5)
-		placeholders := []string{"Data Centers", "Brokers per DC", "Partitions", "Replication Factor", "Min ISR"}
+		placeholders := fieldLabels(m.stage)
 		for i := range m.inputs {
 			m.inputs[i] = textinput.New()
 			m.inputs[i].Cursor.Style = CursorStyle // Use style from styles.go
@@ -40,10 +46,55 @@ func (m *Model) setupInputsForStage() {
 			m.inputs[i].Placeholder = placeholders[i]
 			m.inputs[i].Validate = isNumber // Basic validation
 		}
+		// One count for every DC, or a count per DC for uneven racks
+		m.inputs[fieldBrokers].CharLimit = 40
+		m.inputs[fieldBrokers].Validate = isBrokerCounts
 		m.inputs[0].Focus() // Focus the first input
 		m.inputs[0].PromptStyle = FocusedStyle
 		m.inputs[0].TextStyle = FocusedStyle
 	}
+	m.defaulted = make([]bool, len(m.inputs))
+}
+
+// fieldLabels returns the names of the input fields of a form stage, in the
+// UI language.
+func fieldLabels(stage Stage) []string {
+	keys := []string{"field.brokers", "field.partitions", "field.rf", "field.minISR"}
+	if stage == AskMRCConfig {
+		keys = []string{"field.dcs", "field.brokersPerDC", "field.partitions", "field.rf", "field.minISR"}
+	}
+	labels := make([]string, len(keys))
+	for i, key := range keys {
+		labels[i] = i18n.T(key)
+	}
+	return labels
+}
+
+// cloneToInputs returns a fresh model at the input stage for the current
+// cluster type with every field pre-filled from the current scenario, so a
+// single value can be changed and placed again.
+func (m Model) cloneToInputs() (Model, tea.Cmd) {
+	nm := m.restart()
+	nm.clusterType = m.clusterType
+	nm.stage = AskSingleConfig
+	values := []int{m.numBrokers, m.numPartitions, m.replicationFactor, m.minInSyncReplicas}
+	if m.clusterType == config.MRC {
+		nm.stage = AskMRCConfig
+		values = append([]int{m.numDCs}, values...)
+	}
+	nm.setupInputsForStage()
+	for i := range nm.inputs {
+		if values[i] > 0 {
+			nm.inputs[i].SetValue(strconv.Itoa(values[i]))
+		}
+	}
+	if m.clusterType == config.MRC && m.placementCfg.UnevenRacks() {
+		nm.inputs[fieldBrokers].SetValue(formatBrokerCounts(m.placementCfg.RackSizes(), ","))
+	}
+	nm.updateFieldWarnings()
+	nm.setDiffBase(m.dcs, i18n.T("form.beforeEdit"))
+	nm.colorMode = m.colorMode
+	return nm, nm.inputs[0].Focus()
 }
 
 // isNumber is a validation function for textinput, ensuring input is numeric.
@@ -54,30 +105,96 @@ func isNumber(s string) error {
 	}
 	_, err := strconv.Atoi(s)
 	if err != nil {
-		return fmt.Errorf("must be a number")
+		return errors.New(i18n.T("form.notNumber"))
+	}
+	return nil
+}
+
+// isBrokerCounts is the validation function of the brokers per DC field: a
+// number, or a comma-separated number per DC while typing.
+func isBrokerCounts(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		if err := isNumber(strings.TrimSpace(part)); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// parseBrokerCounts parses the brokers per DC field: one count for every
+// DC, or one per DC, e.g. "4,3,2".
+func parseBrokerCounts(s string) ([]int, error) {
+	parts := strings.Split(s, ",")
+	counts := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		if n <= 0 {
+			return nil, strconv.ErrRange
+		}
+		counts[i] = n
+	}
+	return counts, nil
+}
+
+// formatBrokerCounts renders per-DC broker counts joined by sep.
+func formatBrokerCounts(counts []int, sep string) string {
+	parts := make([]string, len(counts))
+	for i, n := range counts {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, sep)
+}
+
+func sum(ns []int) int {
+	total := 0
+	for _, n := range ns {
+		total += n
+	}
+	return total
+}
+
+// maxReplicasPerBroker is the commonly recommended upper bound of partition
+// replicas a single broker should host.
+const maxReplicasPerBroker = 4000
+
 // parseAndValidateInputs attempts to parse integer values from the input fields
 // and performs logical validation on the configuration.
-// Returns an error if parsing fails or validation rules are violated.
+// Every problem is collected rather than stopping at the first one, so the
+// returned error (an errors.Join) lists all of them at once.
 // This is an unexported method modifying the model's state.
 func (m *Model) parseAndValidateInputs() error {
-	var err error
-	values := make([]int, len(m.inputs))
+	var problems []error
+	values := make([]int, len(m.inputs)) // 0 marks a field with a problem
 
+	m.rackSizes = nil
 	for i, input := range m.inputs {
 		if input.Value() == "" {
-			return fmt.Errorf("input for '%s' cannot be empty", input.Placeholder)
+			problems = append(problems, errors.New(i18n.T("form.empty", input.Placeholder)))
+			continue
+		}
+		if m.stage == AskMRCConfig && i == m.fieldInput(fieldBrokers) && strings.Contains(input.Value(), ",") {
+			counts, err := parseBrokerCounts(input.Value())
+			if err != nil {
+				problems = append(problems, errors.New(i18n.T("form.brokerCounts", input.Placeholder)))
+				continue
+			}
+			m.rackSizes = counts
+			values[i] = max(1, sum(counts)/len(counts)) // Approximate, the per-DC counts are authoritative
+			continue
 		}
-		values[i], err = strconv.Atoi(input.Value())
+		value, err := strconv.Atoi(input.Value())
 		if err != nil {
-			return fmt.Errorf("invalid number for '%s': %w", input.Placeholder, err)
+			problems = append(problems, errors.New(i18n.T("form.invalid", input.Placeholder, err)))
+			continue
 		}
-		if values[i] <= 0 {
-			return fmt.Errorf("input for '%s' must be positive", input.Placeholder)
+		if value <= 0 {
+			problems = append(problems, errors.New(i18n.T("form.positive", input.Placeholder)))
+			continue
 		}
+		values[i] = value
 	}
 
 	// Assign parsed values to model fields based on stage
@@ -96,26 +213,241 @@ func (m *Model) parseAndValidateInputs() error {
 	}
 
 	// --- Logical Validation ---
-	totalBrokers := m.numBrokers
-	if m.clusterType == config.MRC {
-		totalBrokers *= m.numDCs // Calculate total brokers for MRC
-		if m.numDCs <= 1 {
-			return fmt.Errorf("MRC requires at least 2 Data Centers")
+	// Cross-field checks only compare fields that parsed; their own
+	// problems are reported above.
+	cross := crossFieldProblems(m.clusterType, m.formValues(), m.formRackSizes())
+	for f := fieldDCs; f <= fieldMinISR; f++ {
+		if err := cross[f]; err != nil {
+			problems = append(problems, err)
 		}
 	}
 
-	if totalBrokers <= 0 {
-		// This check should ideally be covered by individual broker count checks,
-		// but good as a safeguard.
-		return fmt.Errorf("total number of brokers must be positive")
+	return errors.Join(problems...) // nil if there were no problems
+}
+
+// formField names a value of the input form.
+type formField int
+
+const (
+	fieldDCs     formField = iota // MRC only
+	fieldBrokers                  // Total for a single cluster, per DC for MRC
+	fieldPartitions
+	fieldRF
+	fieldMinISR
+)
+
+// fieldInput returns the index of a field's input on the current form, -1
+// if the form has no such field.
+func (m Model) fieldInput(f formField) int {
+	if m.stage == AskMRCConfig {
+		return int(f)
 	}
-	if m.replicationFactor > totalBrokers {
-		return fmt.Errorf("replication Factor (%d) cannot exceed total brokers (%d)", m.replicationFactor, totalBrokers)
+	if f == fieldDCs {
+		return -1
 	}
-	if m.minInSyncReplicas > m.replicationFactor {
-		return fmt.Errorf("min ISR (%d) cannot exceed Replication Factor (%d)", m.minInSyncReplicas, m.replicationFactor)
+	return int(f) - 1
+}
+
+// formValues parses the form leniently: fields that are empty, not a
+// number or not positive (yet) are 0.
+func (m Model) formValues() map[formField]int {
+	values := make(map[formField]int)
+	for f := fieldDCs; f <= fieldMinISR; f++ {
+		i := m.fieldInput(f)
+		if i < 0 || i >= len(m.inputs) {
+			continue
+		}
+		if v, err := strconv.Atoi(m.inputs[i].Value()); err == nil && v > 0 {
+			values[f] = v
+		}
+	}
+	if m.clusterType != config.MRC {
+		values[fieldDCs] = 1 // Implicitly 1 DC for single cluster
 	}
-	// Other checks (positivity) are handled by the loop above.
+	return values
+}
+
+// formRackSizes returns the per-DC broker counts of an MRC form with uneven
+// racks, nil while the brokers per DC field holds a single count or a
+// count is not a positive number (yet).
+func (m Model) formRackSizes() []int {
+	i := m.fieldInput(fieldBrokers)
+	if m.clusterType != config.MRC || i < 0 || i >= len(m.inputs) || !strings.Contains(m.inputs[i].Value(), ",") {
+		return nil
+	}
+	counts, err := parseBrokerCounts(m.inputs[i].Value())
+	if err != nil {
+		return nil
+	}
+	return counts
+}
 
-	return nil // No error
+// formTotalBrokers returns the total brokers of the form values, from the
+// per-DC counts when the racks are uneven.
+func formTotalBrokers(v map[formField]int, racks []int) int {
+	if racks != nil {
+		return sum(racks)
+	}
+	return v[fieldBrokers] * v[fieldDCs]
+}
+
+// crossFieldProblems checks the relationships between the fields that have
+// a value, attributing each problem to the field that breaks it. racks are
+// the per-DC broker counts of uneven racks, else nil.
+func crossFieldProblems(clusterType config.ClusterType, v map[formField]int, racks []int) map[formField]error {
+	problems := make(map[formField]error)
+	totalBrokers := formTotalBrokers(v, racks)
+	if clusterType == config.MRC {
+		if v[fieldDCs] == 1 {
+			problems[fieldDCs] = errors.New(i18n.T("form.mrcDCs"))
+		}
+		if racks != nil && v[fieldDCs] > 0 && len(racks) != v[fieldDCs] {
+			problems[fieldBrokers] = errors.New(i18n.T("form.rackCount", len(racks), v[fieldDCs]))
+		}
+	}
+	if totalBrokers > 0 && v[fieldRF] > totalBrokers {
+		problems[fieldRF] = errors.New(i18n.T("form.rfOverBrokers", v[fieldRF], totalBrokers))
+	}
+	if v[fieldRF] > 0 && v[fieldMinISR] > v[fieldRF] {
+		problems[fieldMinISR] = errors.New(i18n.T("form.minISROverRF", v[fieldMinISR], v[fieldRF]))
+	}
+	if totalBrokers > 0 && v[fieldPartitions] > 0 && v[fieldRF] > 0 {
+		// Ceiling: the busiest broker hosts at least this many replicas
+		perBroker := (v[fieldPartitions]*v[fieldRF] + totalBrokers - 1) / totalBrokers
+		if perBroker > maxReplicasPerBroker {
+			problems[fieldPartitions] = errors.New(i18n.T("form.replicasPerBrkr", perBroker, maxReplicasPerBroker))
+		}
+	}
+	return problems
+}
+
+// defaultPartitionsPerBroker is the multiple of the broker count suggested
+// for the partitions, so every broker leads the same number of them.
+const defaultPartitionsPerBroker = 2
+
+// fieldDefault suggests a value for a field derived from the fields before
+// it, 0 if there is none yet.
+func (m Model) fieldDefault(f formField) int {
+	v := m.formValues()
+	totalBrokers := formTotalBrokers(v, m.formRackSizes())
+	switch f {
+	case fieldPartitions:
+		return totalBrokers * defaultPartitionsPerBroker
+	case fieldRF:
+		return min(3, totalBrokers)
+	case fieldMinISR:
+		if v[fieldRF] > 0 {
+			return max(1, v[fieldRF]-1)
+		}
+	}
+	return 0
+}
+
+// prefillDefault fills the focused field with its suggested default when
+// it is empty. The value stays marked as a default until the user edits it.
+func (m *Model) prefillDefault() {
+	i := m.focused
+	if i >= len(m.inputs) || m.inputs[i].Value() != "" {
+		return
+	}
+	for f := fieldDCs; f <= fieldMinISR; f++ {
+		if m.fieldInput(f) == i {
+			if d := m.fieldDefault(f); d > 0 {
+				m.inputs[i].SetValue(strconv.Itoa(d))
+				m.defaulted[i] = true
+			}
+		}
+	}
+}
+
+// editDefault runs before a key reaches the focused input: typing into a
+// pre-filled default replaces it, any other edit keeps it as the user's
+// own value.
+func (m *Model) editDefault(msg tea.KeyMsg) {
+	i := m.focused
+	if i >= len(m.defaulted) || !m.defaulted[i] {
+		return
+	}
+	switch msg.Type {
+	case tea.KeyRunes:
+		m.inputs[i].SetValue("")
+		m.defaulted[i] = false
+	case tea.KeyBackspace, tea.KeyDelete:
+		m.defaulted[i] = false
+	}
+}
+
+// refreshDefaults re-derives the untouched defaults of the other fields
+// after an edit, so they follow changes to the fields before them.
+func (m *Model) refreshDefaults() {
+	for f := fieldDCs; f <= fieldMinISR; f++ {
+		i := m.fieldInput(f)
+		if i < 0 || i >= len(m.defaulted) || i == m.focused || !m.defaulted[i] {
+			continue
+		}
+		if d := m.fieldDefault(f); d > 0 {
+			m.inputs[i].SetValue(strconv.Itoa(d))
+		} else {
+			m.inputs[i].SetValue("")
+			m.defaulted[i] = false
+		}
+	}
+}
+
+// fieldTerms maps the form fields to their glossary terms, which are keys
+// and not translated.
+var fieldTerms = map[formField]string{
+	fieldDCs:        "data center",
+	fieldBrokers:    "broker",
+	fieldPartitions: "partition",
+	fieldRF:         "replication factor",
+	fieldMinISR:     "min isr",
+}
+
+// focusedFieldHelp returns the glossary entry of the focused field; MRC
+// forms also explain observers below the min ISR.
+func (m Model) focusedFieldHelp() []glossary.Entry {
+	var help []glossary.Entry
+	for f, term := range fieldTerms {
+		if m.fieldInput(f) != m.focused {
+			continue
+		}
+		if e, ok := glossary.Lookup(term); ok {
+			help = append(help, e)
+		}
+		if f == fieldMinISR && m.clusterType == config.MRC {
+			if e, ok := glossary.Lookup("observer"); ok {
+				help = append(help, e)
+			}
+		}
+	}
+	return help
+}
+
+// updateFieldWarnings re-checks the field relationships as the user types,
+// so a conflict shows under the offending field before Enter.
+func (m *Model) updateFieldWarnings() {
+	m.fieldWarnings = make([]string, len(m.inputs))
+	for f, err := range crossFieldProblems(m.clusterType, m.formValues(), m.formRackSizes()) {
+		if i := m.fieldInput(f); i >= 0 && i < len(m.inputs) {
+			m.fieldWarnings[i] = err.Error()
+		}
+	}
+	// Observers that cannot work do not stop the placement, but explain why
+	if i := m.fieldInput(fieldMinISR); i >= 0 && i < len(m.inputs) && m.fieldWarnings[i] == "" {
+		m.fieldWarnings[i] = m.formObserverWarning()
+	}
+}
+
+// errorLines splits an error joined from several problems into one line
+// per problem.
+func errorLines(err error) []string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var lines []string
+		for _, e := range joined.Unwrap() {
+			lines = append(lines, errorLines(e)...)
+		}
+		return lines
+	}
+	return []string{err.Error()}
 }
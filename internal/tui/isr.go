@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/timeline"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	isrStep       = 5 * time.Second // Time per column of the ISR timeline
+	isrPartitions = 8               // Most partitions the ISR pane draws
+	isrLogLines   = 14              // Most lines of the ISR event log
+)
+
+// SetISRTimeline sets replica.lag.time.max.ms and the broker slowdowns and
+// restarts the ISR timeline plays. Without events the pane replays the
+// outages of the --timeline script, or slows down and then restarts one
+// broker.
+func (m *Model) SetISRTimeline(lagTimeMax time.Duration, events []failover.BrokerEvent) {
+	m.isrLagTimeMax = lagTimeMax
+	m.isrEvents = events
+}
+
+// isrParams returns the ISR timeline settings for the shown placement.
+func (m Model) isrParams() failover.ISRParams {
+	lag := m.isrLagTimeMax
+	if lag <= 0 {
+		lag = failover.DefaultReplicaLagTimeMax
+	}
+	return failover.ISRParams{
+		LagTimeMax:     lag,
+		SessionTimeout: m.failoverTiming.SessionTimeout,
+		CatchUp:        failover.DefaultCatchUp,
+		MinISR:         m.placementCfg.MinInSyncReplicas,
+		Quorum:         m.quorum(),
+	}
+}
+
+// isrEventList returns the events to play: the configured ones, else the
+// outages of the event timeline's script, else a slowdown longer than
+// replica.lag.time.max.ms and then a restart of the selected broker or the
+// one hosting the most replicas.
+func (m Model) isrEventList(p failover.ISRParams) []failover.BrokerEvent {
+	if len(m.isrEvents) > 0 {
+		return m.isrEvents
+	}
+	if len(m.timelineEvents) > 0 {
+		if restarts := timeline.BrokerRestarts(m.dcs, m.timelineEvents); len(restarts) > 0 {
+			return restarts
+		}
+	}
+	id := m.busiestBroker()
+	slowFor := p.LagTimeMax + 15*time.Second
+	restartAt := 10*time.Second + slowFor + p.CatchUp + 25*time.Second
+	return []failover.BrokerEvent{
+		{Kind: failover.EventSlowdown, BrokerID: id, At: 10 * time.Second, Duration: slowFor},
+		{Kind: failover.EventRestart, BrokerID: id, At: restartAt, Duration: 20 * time.Second},
+	}
+}
+
+// isrView renders the ISR membership of the partitions over the timeline,
+// one strip per replica, and a log of every ISR shrink, expansion and
+// leader change, showing how replica.lag.time.max.ms and min ISR decide
+// when acks=all writes fail.
+func (m Model) isrView() string {
+	p := m.isrParams()
+	events := m.isrEventList(p)
+	length := failover.Horizon(events, p)
+	tl := failover.SimulateISR(m.dcs, events, length, p)
+
+	var b strings.Builder
+	names := make([]string, len(events))
+	for i, e := range events {
+		key := "isr.slow"
+		if e.Kind == failover.EventRestart {
+			key = "isr.down"
+		}
+		names[i] = i18n.T(key, e.BrokerID, e.At, e.At+e.Duration)
+	}
+	b.WriteString(DCHeaderStyle.Render(i18n.T("isr.title", strings.Join(names, ", "), p.LagTimeMax.Milliseconds(), p.MinISR)))
+	b.WriteString("\n")
+	if len(tl.Partitions) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("isr.none")))
+		return b.String()
+	}
+
+	// Affected partitions first, in ID order within each group
+	parts := append([]failover.PartitionISR(nil), tl.Partitions...)
+	sort.SliceStable(parts, func(i, j int) bool { return isrAffected(parts[i]) && !isrAffected(parts[j]) })
+	affected := 0
+	for _, pt := range parts {
+		if isrAffected(pt) {
+			affected++
+		}
+	}
+	shown := parts[:min(len(parts), isrPartitions)]
+
+	columns := int(length / isrStep)
+	axis := make([]byte, columns)
+	for c := range axis {
+		axis[c] = ' '
+		if c%6 == 0 {
+			axis[c] = '|'
+		}
+	}
+	b.WriteString(fmt.Sprintf("%-12s %s  %s\n", "", string(axis), HelpStyle.Render(i18n.T("isr.columns", isrStep, 6*isrStep))))
+	for _, pt := range shown {
+		for i, id := range pt.Brokers {
+			label := fmt.Sprintf("p%d B%d", m.placementCfg.PartitionNumber(pt.PartitionID), id)
+			if i == 0 {
+				label += " L"
+			}
+			b.WriteString(fmt.Sprintf("%-12s %s\n", label, isrStrip(pt, i, columns)))
+		}
+		b.WriteString(fmt.Sprintf("%-12s %s\n", fmt.Sprintf("p%d ISR", m.placementCfg.PartitionNumber(pt.PartitionID)), isrSizes(pt, columns, p.MinISR)))
+	}
+	if len(parts) > len(shown) {
+		b.WriteString(HelpStyle.Render(i18n.T("isr.morePartitions", len(parts)-len(shown), affected, len(parts))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	log := m.isrLog(tl.Events)
+	for i, line := range log {
+		if i == isrLogLines {
+			b.WriteString(HelpStyle.Render(i18n.T("isr.moreEvents", len(log)-i)))
+			b.WriteString("\n")
+			break
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("isr.help", p.LagTimeMax)))
+	return b.String()
+}
+
+// isrAffected reports whether any replica of the partition left the ISR,
+// lagged or went down.
+func isrAffected(pt failover.PartitionISR) bool {
+	for _, states := range pt.States {
+		for _, s := range states {
+			if s != failover.StateInSync {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isrStrip draws replica i's states, one column per isrStep showing the
+// worst state within it, so short lags stay visible.
+func isrStrip(pt failover.PartitionISR, i, columns int) string {
+	step := int(isrStep / time.Second)
+	var b strings.Builder
+	for c := 0; c < columns; c++ {
+		worst := failover.StateInSync
+		for t := c * step; t < (c+1)*step && t < len(pt.States[i]); t++ {
+			worst = max(worst, pt.States[i][t])
+		}
+		switch worst {
+		case failover.StateInSync:
+			b.WriteString(lipgloss.NewStyle().Foreground(leaderColor).Render("█"))
+		case failover.StateLagging:
+			b.WriteString(lipgloss.NewStyle().Foreground(followerColor).Render("▒"))
+		case failover.StateOutOfSync:
+			b.WriteString(HelpStyle.Render("·"))
+		default:
+			b.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("✗"))
+		}
+	}
+	return b.String()
+}
+
+// isrSizes draws the smallest ISR size within each column, red where
+// acks=all writes fail.
+func isrSizes(pt failover.PartitionISR, columns, minISR int) string {
+	step := int(isrStep / time.Second)
+	var b strings.Builder
+	for c := 0; c < columns; c++ {
+		size, under := -1, false
+		for t := c * step; t < (c+1)*step && t < len(pt.ISR); t++ {
+			if size < 0 || pt.ISR[t] < size {
+				size = pt.ISR[t]
+			}
+			under = under || pt.UnderMinISR(t, minISR)
+		}
+		cell := fmt.Sprint(min(size, 9))
+		if under {
+			cell = FailStyle.Render(cell)
+		}
+		b.WriteString(cell)
+	}
+	return b.String()
+}
+
+// isrLog groups the timeline's events that happen at the same time to the
+// same broker, e.g. "t+40s  broker 2 leaves the ISR: … (p1, p3)", red where
+// writes start failing.
+func (m Model) isrLog(events []failover.ISREvent) []string {
+	type key struct {
+		At       time.Duration
+		BrokerID int
+		What     string
+		Alert    bool
+	}
+	var order []key
+	partitions := make(map[key][]int)
+	for _, e := range events {
+		k := key{e.At, e.BrokerID, e.What, e.Alert}
+		if _, ok := partitions[k]; !ok {
+			order = append(order, k)
+		}
+		partitions[k] = append(partitions[k], e.PartitionID)
+	}
+	lines := make([]string, len(order))
+	for i, k := range order {
+		ids := make([]string, len(partitions[k]))
+		for j, id := range partitions[k] {
+			ids[j] = fmt.Sprintf("p%d", m.placementCfg.PartitionNumber(id))
+		}
+		switch {
+		case partitions[k][0] == 0:
+			lines[i] = fmt.Sprintf("t+%-5s %s", k.At, k.What) // Cluster-wide
+		case k.BrokerID < 0:
+			lines[i] = fmt.Sprintf("t+%-5s %s: %s", k.At, strings.Join(ids, ", "), k.What)
+		default:
+			lines[i] = fmt.Sprintf("t+%-5s %s", k.At, i18n.T("isr.brokerEvent", k.BrokerID, k.What, strings.Join(ids, ", ")))
+		}
+		if k.Alert {
+			lines[i] = FailStyle.Render(lines[i])
+		}
+	}
+	return lines
+}
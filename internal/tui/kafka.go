@@ -0,0 +1,243 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/kafka"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// kafkaTopicLines is how many topics the topic picker lists around the
+// highlighted one.
+const kafkaTopicLines = 12
+
+// Fields of the connect form.
+const (
+	kafkaFieldBootstrap = iota
+	kafkaFieldTopic
+	numKafkaFields
+)
+
+// SetKafkaOptions sets how the connect form reaches the cluster, e.g. over
+// TLS, and the bootstrap servers it starts with.
+func (m *Model) SetKafkaOptions(bootstrap string, opts kafka.Options) {
+	m.kafkaBootstrap, m.kafkaOpts = bootstrap, opts
+}
+
+// OpenConnectKafka switches to the form asking for the bootstrap servers
+// and the topic of a live cluster.
+func (m *Model) OpenConnectKafka() tea.Cmd {
+	m.stage = ConnectKafka
+	m.kafkaCluster = nil
+	m.kafkaInputs = make([]textinput.Model, numKafkaFields)
+	placeholders := []string{"localhost:9092", i18n.T("kafka.topicHint")}
+	for i := range m.kafkaInputs {
+		m.kafkaInputs[i] = textinput.New()
+		m.kafkaInputs[i].Cursor.Style = CursorStyle
+		m.kafkaInputs[i].Placeholder = placeholders[i]
+		m.kafkaInputs[i].CharLimit = 250
+		m.kafkaInputs[i].Width = 50
+	}
+	m.kafkaInputs[kafkaFieldBootstrap].SetValue(m.kafkaBootstrap)
+	m.kafkaFocus = kafkaFieldBootstrap
+	return m.focusKafkaInput()
+}
+
+// focusKafkaInput focuses the highlighted field of the connect form.
+func (m *Model) focusKafkaInput() tea.Cmd {
+	for i := range m.kafkaInputs {
+		m.kafkaInputs[i].Blur()
+		m.kafkaInputs[i].PromptStyle = NoStyle
+		m.kafkaInputs[i].TextStyle = NoStyle
+	}
+	m.kafkaInputs[m.kafkaFocus].PromptStyle = FocusedStyle
+	m.kafkaInputs[m.kafkaFocus].TextStyle = FocusedStyle
+	return m.kafkaInputs[m.kafkaFocus].Focus()
+}
+
+// KafkaImport returns an import job reading topic from the live cluster
+// behind bootstrap and showing its actual placement. Without a topic it
+// shows the cluster's topics to pick one from.
+func KafkaImport(bootstrap, topic string, opts kafka.Options) ImportJob {
+	return func(ctx context.Context, progress ImportProgress) (func(*Model), error) {
+		steps := 1
+		if topic != "" {
+			steps = 2 // Metadata, then the topic's configuration
+		}
+		progress(0, steps, "requests")
+		cluster, err := kafka.Fetch(ctx, bootstrap, topic, opts)
+		if err != nil {
+			return nil, err
+		}
+		progress(steps, steps, "requests")
+		if topic == "" {
+			return func(m *Model) {
+				m.kafkaBootstrap = bootstrap
+				m.pickKafkaTopic(cluster)
+			}, nil
+		}
+		return kafkaPlacement(cluster, topic)
+	}
+}
+
+// kafkaPlacement returns how to show the live placement of topic on the
+// cluster.
+func kafkaPlacement(cluster *kafka.Cluster, topic string) (func(*Model), error) {
+	cfg, err := cluster.PlacementConfig(topic)
+	if err != nil {
+		return nil, err
+	}
+	data := cluster.Assignment()
+	dcs := placement.Topology(cfg)
+	if err := data.Apply(dcs, cfg.TopicName); err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("kafka.readAssignment", topic), err)
+	}
+
+	meta := &ClusterMetadata{Name: cluster.Bootstrap, ID: cluster.ID, Racks: make(map[int]string)}
+	if cluster.ControllerID >= 0 {
+		meta.Controller = i18n.T("kafka.controller", cluster.ControllerID)
+	}
+	for _, b := range cluster.Brokers {
+		meta.Racks[b.ID] = b.Rack
+	}
+	// Not translated: the source up to " (" keys the cluster's history
+	source := fmt.Sprintf("Read from %s (%d brokers, %d topics)", cluster.Bootstrap, len(cluster.Brokers), len(cluster.TopicNames()))
+	return func(m *Model) {
+		m.kafkaBootstrap = cluster.Bootstrap
+		m.ImportPlacement(cfg, dcs, source)
+		m.WatchAssignment("", data, nil)
+		m.SetClusterMetadata(meta)
+	}, nil
+}
+
+// pickKafkaTopic lists the topics of a cluster to pick the one to show.
+func (m *Model) pickKafkaTopic(cluster *kafka.Cluster) {
+	if len(cluster.TopicNames()) == 0 {
+		m.err = errors.New(i18n.T("kafka.noTopics", cluster.Bootstrap))
+		m.stage = ShowError
+		return
+	}
+	m.stage = ConnectKafka
+	m.kafkaCluster = cluster
+	m.kafkaTopicIndex = 0
+}
+
+// updateConnectKafka handles the connect form and, once connected without
+// a topic, the topic picker.
+func (m Model) updateConnectKafka(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.kafkaCluster != nil {
+		return m.updateKafkaTopics(msg)
+	}
+	switch msg.String() {
+	case "ctrl+c":
+		return m.quit()
+	case "esc":
+		return m.restart(), nil
+	case "tab", "down", "shift+tab", "up":
+		if s := msg.String(); s == "tab" || s == "down" {
+			m.kafkaFocus = (m.kafkaFocus + 1) % numKafkaFields
+		} else {
+			m.kafkaFocus = (m.kafkaFocus - 1 + numKafkaFields) % numKafkaFields
+		}
+		m.kafkaErr = nil
+		return m, m.focusKafkaInput()
+	case "enter":
+		bootstrap := strings.TrimSpace(m.kafkaInputs[kafkaFieldBootstrap].Value())
+		if bootstrap == "" {
+			m.kafkaErr = errors.New(i18n.T("kafka.noBootstrap"))
+			return m, nil
+		}
+		topic := strings.TrimSpace(m.kafkaInputs[kafkaFieldTopic].Value())
+		m.kafkaErr = nil
+		m.StartImport(i18n.T("kafka.connecting", bootstrap), KafkaImport(bootstrap, topic, m.kafkaOpts))
+		return m, m.importing.run()
+	}
+	var cmd tea.Cmd
+	m.kafkaInputs[m.kafkaFocus], cmd = m.kafkaInputs[m.kafkaFocus].Update(msg)
+	return m, cmd
+}
+
+// updateKafkaTopics moves through the cluster's topics; Enter reads the
+// highlighted one and Esc goes back to the connect form.
+func (m Model) updateKafkaTopics(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	names := m.kafkaCluster.TopicNames()
+	switch msg.String() {
+	case "ctrl+c":
+		return m.quit()
+	case "esc", "q":
+		return m, m.OpenConnectKafka()
+	case "up", "k":
+		m.kafkaTopicIndex = (m.kafkaTopicIndex - 1 + len(names)) % len(names)
+	case "down", "j", "tab":
+		m.kafkaTopicIndex = (m.kafkaTopicIndex + 1) % len(names)
+	case "pgup":
+		m.kafkaTopicIndex = max(0, m.kafkaTopicIndex-kafkaTopicLines)
+	case "pgdown":
+		m.kafkaTopicIndex = min(len(names)-1, m.kafkaTopicIndex+kafkaTopicLines)
+	case "enter":
+		// Again, for the topic's min.insync.replicas and a fresh assignment
+		bootstrap := m.kafkaCluster.Bootstrap
+		m.StartImport(i18n.T("kafka.reading", names[m.kafkaTopicIndex], bootstrap), KafkaImport(bootstrap, names[m.kafkaTopicIndex], m.kafkaOpts))
+		return m, m.importing.run()
+	}
+	return m, nil
+}
+
+// connectKafkaView renders the connect form or the topic picker.
+func (m Model) connectKafkaView() string {
+	var b strings.Builder
+	if cluster := m.kafkaCluster; cluster != nil {
+		names := cluster.TopicNames()
+		b.WriteString(DCHeaderStyle.Render(i18n.T("kafka.topics", cluster.Bootstrap, i18n.Plural(len(cluster.Brokers), "broker"), i18n.Plural(len(names), "topic"))) + "\n")
+		first := max(0, min(m.kafkaTopicIndex-kafkaTopicLines/2, len(names)-kafkaTopicLines))
+		if first > 0 {
+			b.WriteString(HelpStyle.Render("  "+i18n.T("kafka.above", first)) + "\n")
+		}
+		for i := first; i < min(len(names), first+kafkaTopicLines); i++ {
+			t := cluster.Topic(names[i])
+			line := fmt.Sprintf("%s  (%s)", t.Name, i18n.Plural(len(t.Partitions), "partition"))
+			if i == m.kafkaTopicIndex {
+				b.WriteString(FocusedStyle.Render("> "+line) + "\n")
+			} else {
+				b.WriteString("  " + line + "\n")
+			}
+		}
+		if n := len(names) - first - kafkaTopicLines; n > 0 {
+			b.WriteString(HelpStyle.Render("  "+i18n.T("kafka.below", n)) + "\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(HelpStyle.Render(i18n.T("kafka.topicsHelp")))
+		return b.String()
+	}
+
+	b.WriteString(i18n.T("kafka.title") + "\n\n")
+	labels := []string{i18n.T("kafka.bootstrap"), i18n.T("kafka.topic")}
+	for i, input := range m.kafkaInputs {
+		b.WriteString(padRight(labels[i], 19) + " " + input.View() + "\n")
+	}
+	b.WriteString("\n")
+	if m.kafkaOpts.TLS != nil {
+		b.WriteString(HelpStyle.Render(i18n.T("kafka.tls")) + "\n")
+	} else {
+		b.WriteString(HelpStyle.Render(i18n.T("kafka.plaintext")) + "\n")
+	}
+	if sasl := m.kafkaOpts.SASL; sasl.Mechanism != "" {
+		b.WriteString(HelpStyle.Render(i18n.T("kafka.sasl", sasl.User, strings.ToUpper(sasl.Mechanism))) + "\n")
+	} else {
+		b.WriteString(HelpStyle.Render(i18n.T("kafka.noSASL")) + "\n")
+	}
+	if m.kafkaErr != nil {
+		b.WriteString(ErrorStyle.Render(m.kafkaErr.Error()) + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(HelpStyle.Render(i18n.T("kafka.help")))
+	return b.String()
+}
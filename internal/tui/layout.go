@@ -0,0 +1,271 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/lag"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// The placement view is virtualized: broker boxes are laid out in rows that
+// fit the terminal width, and only the rows that fit the terminal height are
+// rendered. Box sizes are computed from the unstyled text, so laying out
+// thousands of brokers is cheap; only the visible boxes are styled.
+
+// brokerRow is one row of broker boxes, all from the same DC.
+type brokerRow struct {
+	dcID      int
+	first     bool // First row of its DC, carries the DC or rack header when grouped
+	brokerIDs []int
+	height    int // Lines, including the DC header and box margins
+}
+
+const (
+	boxChromeWidth  = 6 // Border, padding and right margin of a broker box
+	boxChromeHeight = 3 // Border and bottom margin of a broker box
+	dcHeaderHeight  = 2 // DC header line and its margin
+)
+
+// brokerRows lays out all broker boxes. Without a known terminal width each
+// DC is a single row.
+func (m Model) brokerRows(partitionLag map[int]lag.PartitionLag) []brokerRow {
+	var brokerBytes map[int]int64
+	if len(m.placementCfg.PartitionLoads) > 0 {
+		brokerBytes = placement.BrokerBytes(m.dcs, m.placementCfg.PartitionLoads)
+	}
+	var diffBase map[int]*config.DCInfo
+	if m.diffing() {
+		diffBase, _ = m.diffBase()
+	}
+	var rows []brokerRow
+	for _, dcID := range sortedDCIDs(m.dcs) {
+		dc := m.dcs[dcID]
+		row := brokerRow{dcID: dcID, first: true}
+		rowWidth := 0
+		for _, brokerID := range sortedBrokerIDs(dc) {
+			w, h := m.boxSize(dc.Brokers[brokerID], partitionLag, brokerBytes, diffBase)
+			if m.width > 0 && len(row.brokerIDs) > 0 && rowWidth+w > m.width {
+				rows = append(rows, m.finishRow(row))
+				row = brokerRow{dcID: dcID}
+				rowWidth = 0
+			}
+			row.brokerIDs = append(row.brokerIDs, brokerID)
+			row.height = max(row.height, h)
+			rowWidth += w
+		}
+		rows = append(rows, m.finishRow(row))
+	}
+	return rows
+}
+
+// grouped reports whether the brokers show grouped under headers: by DC
+// in an MRC, or by broker.rack in a rack-aware single cluster.
+func (m Model) grouped() bool {
+	return m.clusterType == config.MRC || m.placementCfg.RackAware()
+}
+
+// finishRow adds the DC header to the height of a DC's first row.
+func (m Model) finishRow(row brokerRow) brokerRow {
+	if row.height == 0 {
+		row.height = boxChromeHeight // DC without brokers still takes its margin
+	}
+	if row.first && m.grouped() {
+		row.height += dcHeaderHeight
+	}
+	return row
+}
+
+// boxSize returns the rendered width and height of a broker box; diffBase
+// is the diff base when the diff view is shown, else nil.
+func (m Model) boxSize(broker *config.BrokerInfo, partitionLag map[int]lag.PartitionLag, brokerBytes map[int]int64, diffBase map[int]*config.DCInfo) (int, int) {
+	width := lipgloss.Width(fmt.Sprintf("Broker %d:", broker.ID))
+	lines := 2
+	if brokerBytes != nil {
+		lines++
+		width = max(width, lipgloss.Width(" "+formatBytes(brokerBytes[broker.ID])))
+	}
+	chips := len("  (empty)")
+	if diffBase != nil {
+		if diffChips := brokerDiffChips(diffBase, broker); len(diffChips) > 0 {
+			chips = 0
+			for _, c := range diffChips {
+				chips += len(m.diffChipText(c))
+			}
+		}
+	} else if len(broker.Replicas) > 0 {
+		chips = 0
+		for _, replica := range broker.Replicas {
+			chips += lipgloss.Width(m.chipText(replica, partitionLag))
+		}
+	}
+	return max(width, chips) + boxChromeWidth, lines + boxChromeHeight
+}
+
+// placementChrome returns the number of screen lines the placement view
+// uses besides the broker boxes: the title, replay banner, header, footer
+// and scroll indicator.
+func (m Model) placementChrome(header, footer string) int {
+	// header and the banner end with a newline and footer starts with one
+	return 2 + m.screenLines(m.replayBanner()) - 1 + m.screenLines(header) - 1 + m.screenLines(footer) - 1 + 1
+}
+
+// screenLines returns how many terminal lines s occupies once long lines
+// wrap at the terminal width.
+func (m Model) screenLines(s string) int {
+	n := 0
+	for _, line := range strings.Split(s, "\n") {
+		if w := lipgloss.Width(line); m.width > 0 && w > m.width {
+			n += (w + m.width - 1) / m.width
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// boxesHeight returns the lines available for broker boxes, or 0 when the
+// terminal height is unknown (everything is shown).
+func (m Model) boxesHeight(header, footer string) int {
+	if m.height == 0 {
+		return 0
+	}
+	return max(1, m.height-m.placementChrome(header, footer))
+}
+
+// rowsEnd returns the end (exclusive) of the rows shown from start: as many
+// as fit avail lines, but at least one. avail 0 shows everything.
+func rowsEnd(rows []brokerRow, start, avail int) int {
+	if avail == 0 {
+		return len(rows)
+	}
+	used, end := 0, start
+	for end < len(rows) && (end == start || used+rows[end].height <= avail) {
+		used += rows[end].height
+		end++
+	}
+	return end
+}
+
+// rowsStartBefore returns the smallest start whose rows up to and including
+// last fit avail lines.
+func rowsStartBefore(rows []brokerRow, last, avail int) int {
+	if avail == 0 {
+		return 0
+	}
+	start, used := last, rows[last].height
+	for start > 0 && used+rows[start-1].height <= avail {
+		start--
+		used += rows[start].height
+	}
+	return start
+}
+
+// rowsWindow clamps the scroll position so the last page stays full and
+// returns the visible range of rows.
+func (m Model) rowsWindow(rows []brokerRow, avail int) (int, int) {
+	if len(rows) == 0 {
+		return 0, 0
+	}
+	start := min(max(0, m.placementScroll), rowsStartBefore(rows, len(rows)-1, avail))
+	return start, rowsEnd(rows, start, avail)
+}
+
+// brokerBoxesView renders the visible rows of broker boxes.
+func (m Model) brokerBoxesView(partitionLag map[int]lag.PartitionLag, avail int) string {
+	rows := m.brokerRows(partitionLag)
+	start, end := m.rowsWindow(rows, avail)
+
+	// Per-broker disk usage, only when partition sizes are known
+	var brokerBytes map[int]int64
+	if len(m.placementCfg.PartitionLoads) > 0 {
+		brokerBytes = placement.BrokerBytes(m.dcs, m.placementCfg.PartitionLoads)
+	}
+	heat := m.heatMap()
+
+	var rowViews []string
+	for _, row := range rows[start:end] {
+		dc := m.dcs[row.dcID]
+		var rowBuilder strings.Builder
+
+		// Add DC header only for MRC setups, or the rack of racked brokers
+		if row.first && m.grouped() {
+			header := i18n.T("group.dc", row.dcID)
+			if m.placementCfg.RackAware() {
+				header = i18n.T("group.rack", dc.Name)
+			} else if dc.Name != "" {
+				header = i18n.T("group.dcNamed", row.dcID, dc.Name)
+			}
+			headerStyle := DCHeaderStyle
+			if m.colorMode == ColorByDC {
+				headerStyle = headerStyle.Foreground(DCColor(row.dcID))
+			}
+			rowBuilder.WriteString(headerStyle.Render(header))
+			rowBuilder.WriteString("\n") // Add space below DC header
+		}
+
+		brokerViews := make([]string, 0, len(row.brokerIDs))
+		for _, brokerID := range row.brokerIDs {
+			brokerViews = append(brokerViews, m.renderBroker(row.dcID, dc.Brokers[brokerID], heat, partitionLag, brokerBytes))
+		}
+		rowBuilder.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, brokerViews...))
+		rowViews = append(rowViews, rowBuilder.String())
+	}
+
+	view := lipgloss.JoinVertical(lipgloss.Left, rowViews...)
+	if start > 0 || end < len(rows) {
+		view += "\n" + HelpStyle.Render(i18n.T("layout.rows", start+1, end, len(rows)))
+	}
+	return view
+}
+
+// placementRows lays out the placement view as the next render will,
+// returning the rows, the visible window and the lines available for boxes.
+func (m Model) placementRows() (rows []brokerRow, start, end, avail int) {
+	partitionLag := m.overlayLag()
+	rows = m.brokerRows(partitionLag)
+	avail = m.boxesHeight(m.placementHeader(partitionLag), m.placementFooter(partitionLag != nil))
+	start, end = m.rowsWindow(rows, avail)
+	return rows, start, end, avail
+}
+
+// scrollPlacement scrolls the broker boxes by whole pages.
+func (m *Model) scrollPlacement(pages int) {
+	rows, start, end, avail := m.placementRows()
+	switch {
+	case pages > 0 && end < len(rows):
+		m.placementScroll = end
+	case pages < 0 && start > 0:
+		m.placementScroll = rowsStartBefore(rows, start-1, avail)
+	default:
+		m.placementScroll = start
+	}
+}
+
+// scrollToSelection scrolls the broker boxes so the selected broker is
+// visible.
+func (m *Model) scrollToSelection() {
+	if !m.brokerSelected {
+		return
+	}
+	rows, start, end, avail := m.placementRows()
+	m.placementScroll = start
+	for i, row := range rows {
+		for _, id := range row.brokerIDs {
+			if id != m.selectedBroker {
+				continue
+			}
+			if i < start {
+				m.placementScroll = i
+			} else if i >= end {
+				m.placementScroll = rowsStartBefore(rows, i, avail)
+			}
+			return
+		}
+	}
+}
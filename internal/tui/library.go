@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/scenarios"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// OpenLibrary switches to the scenario library list.
+func (m *Model) OpenLibrary() {
+	m.stage = Library
+	m.libraryIndex = 0
+}
+
+// LoadScenario places the cluster of a library scenario and keeps its notes
+// above the placement.
+func (m *Model) LoadScenario(s scenarios.Scenario) {
+	m.ImportConfig(s.Config, "")
+	m.scenario = &s
+}
+
+// updateLibrary moves through the scenario list; Enter places the
+// highlighted scenario and Esc goes back to the first screen.
+func (m Model) updateLibrary(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	all := scenarios.All()
+	switch msg.String() {
+	case "ctrl+c":
+		return m.quit()
+	case "esc", "q":
+		return m.restart(), nil
+	case "up", "k":
+		m.libraryIndex = (m.libraryIndex - 1 + len(all)) % len(all)
+	case "down", "j", "tab":
+		m.libraryIndex = (m.libraryIndex + 1) % len(all)
+	case "enter":
+		m.LoadScenario(all[m.libraryIndex])
+	}
+	return m, nil
+}
+
+// libraryView lists the scenarios with the notes of the highlighted one.
+func (m Model) libraryView() string {
+	all := scenarios.All()
+	wrap := lipgloss.NewStyle().Width(min(max(m.width, 40), 100))
+
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("library.title")))
+	b.WriteString("\n")
+	for i, s := range all {
+		line := fmt.Sprintf("  %s: %s", s.Name, s.Summary)
+		if i == m.libraryIndex {
+			line = FocusedStyle.Render(fmt.Sprintf("> %s: %s", s.Name, s.Summary))
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(wrap.Render(all[m.libraryIndex].Notes) + "\n\n")
+	b.WriteString(HelpStyle.Render(i18n.T("library.help")))
+	return b.String()
+}
+
+// scenarioNotes renders the name and notes of the loaded library scenario
+// for the placement header, or "" outside a scenario.
+func (m Model) scenarioNotes() string {
+	if m.scenario == nil {
+		return ""
+	}
+	wrap := HelpStyle
+	if m.width > 0 {
+		wrap = wrap.Width(max(m.width, 40))
+	}
+	return wrap.Render(fmt.Sprintf("Scenario %s: %s", m.scenario.Name, m.scenario.Notes)) + "\n"
+}
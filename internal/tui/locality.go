@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/locality"
+)
+
+// SetConsumers sets how many consumer instances of the group run in each
+// DC, for the fetch locality pane. Without them every DC runs one.
+func (m *Model) SetConsumers(c locality.Consumers) {
+	m.consumers = c
+}
+
+// localityView renders how much of the consumer group's fetch traffic
+// stays in the consumers' DCs, fetching from leaders only and with KIP-392
+// follower fetching, with every broker up and under DC and broker losses.
+func (m Model) localityView() string {
+	var b strings.Builder
+	perDC, unknown := m.consumers.PerDC(m.dcs)
+	ids := sortedDCIDs(m.dcs)
+	sites := make([]string, 0, len(ids))
+	total := 0
+	for _, id := range ids {
+		if perDC[id] > 0 {
+			sites = append(sites, fmt.Sprintf("%s %d", m.dcs[id].Rack(), perDC[id]))
+			total += perDC[id]
+		}
+	}
+	b.WriteString(DCHeaderStyle.Render(i18n.T("locality.title", i18n.Plural(total, "consumer"), strings.Join(sites, ", "))))
+	b.WriteString("\n")
+	if len(unknown) > 0 {
+		b.WriteString(WarnStyle.Render(i18n.T("locality.unknownDC", strings.Join(unknown, ", "))))
+		b.WriteString("\n")
+	}
+	if total == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("locality.none")))
+		return b.String()
+	}
+
+	results := locality.Analyze(m.dcs, m.quorum(), perDC, m.placementCfg.PartitionLoads)
+	b.WriteString(fmt.Sprintf("%-22s %-21s %s\n", "", i18n.T("locality.leaderOnly"), i18n.T("locality.followerFetching")))
+	local, crossDC := i18n.T("locality.local"), i18n.T("locality.crossDC")
+	b.WriteString(fmt.Sprintf("%-22s %-8s %-12s %-8s %-10s %s\n", i18n.T("locality.scenario"), local, crossDC, local, crossDC, i18n.T("locality.unavailable")))
+	for i, r := range results {
+		name := r.Scenario.Name
+		if i == len(results)-1 && len(r.Scenario.FailedBrokers) > 0 {
+			name += i18n.T("locality.worst")
+		}
+		line := fmt.Sprintf("%s %-8s %-12s %-8s %-10s %s", padRight(name, 22),
+			percent(r.Leader.Local), percent(r.Leader.Remote), percent(r.RackAware.Local), percent(r.RackAware.Remote), percent(r.RackAware.Unavailable))
+		if r.Stopped > 0 {
+			line += HelpStyle.Render(i18n.T("locality.stopped", i18n.Plural(r.Stopped, "consumer")))
+		}
+		if r.RackAware.Unavailable > 0 {
+			line = FailStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	// Per DC with every broker up: which consumers follower fetching helps
+	normal := results[0]
+	for _, d := range normal.PerDC {
+		line := "  " + i18n.T("locality.dc", m.dcs[d.DCID].Rack(), i18n.Plural(d.Consumers, "consumer"), percent(d.Leader.Local), percent(d.RackAware.Local))
+		if d.RackAware.Observer > 0 {
+			line += i18n.T("locality.fromObservers", percent(d.RackAware.Observer))
+		}
+		if d.RackAware.Remote > 0 {
+			line += WarnStyle.Render(i18n.T("locality.noReplica", percent(d.RackAware.Remote)))
+		}
+		b.WriteString(line + "\n")
+	}
+	if normal.BytesPerSec > 0 {
+		b.WriteString(i18n.T("locality.traffic", formatRate(normal.Leader.Remote*normal.BytesPerSec), formatRate(normal.RackAware.Remote*normal.BytesPerSec)) + "\n")
+	}
+	if obs := normal.RackAware.Observer; obs > 0 {
+		// Observers only help reads: they copy every write across DCs, outside acks
+		line := i18n.T("locality.observerReads", percent(obs))
+		if normal.BytesPerSec > 0 {
+			line += i18n.T("locality.saving", formatRate(obs*normal.BytesPerSec))
+		}
+		b.WriteString(line + "\n")
+	}
+	if rep := normal.Replication; rep.Followers+rep.Observers > 0 {
+		b.WriteString(i18n.T("locality.replication", formatRate(rep.Followers), formatRate(rep.Observers)) + "\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("locality.help")))
+	return b.String()
+}
+
+// percent formats a fraction as a whole percentage.
+func percent(f float64) string {
+	return fmt.Sprintf("%.0f%%", f*100)
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// logPaneLines is how many of the latest log entries the log pane shows.
+const logPaneLines = 15
+
+// toggleLog shows or hides the log pane. Either way the entries logged so
+// far count as seen, so the header stops announcing them.
+func (m *Model) toggleLog() {
+	m.showLog = !m.showLog
+	m.logSeen = applog.Count()
+}
+
+// logBadge announces warnings and errors logged since the log pane was last
+// opened, as they are not shown anywhere else. It is "" when there are none
+// or the pane is open.
+func (m Model) logBadge() string {
+	if m.showLog {
+		return ""
+	}
+	n := 0
+	for _, e := range applog.Since(m.logSeen) {
+		if e.Level >= applog.Warn {
+			n++
+		}
+	}
+	if n == 0 {
+		return ""
+	}
+	return WarnStyle.Render(i18n.T("log.badge", i18n.Plural(n, "new warning"))) + "\n"
+}
+
+// logView renders the latest placement warnings, import errors and live
+// cluster events, oldest first.
+func (m Model) logView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("log.title")) + "\n")
+	entries := applog.Entries()
+	if len(entries) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("log.none")))
+		return b.String()
+	}
+	if n := len(entries) - logPaneLines; n > 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("log.older", i18n.Plural(n, "message"))) + "\n")
+		entries = entries[n:]
+	}
+	for i, e := range entries {
+		line := fmt.Sprintf("%s %-5s %s", e.Time.Format("15:04:05"), e.Level, e.Message)
+		switch e.Level {
+		case applog.Warn:
+			line = WarnStyle.Render(line)
+		case applog.Error:
+			line = FailStyle.Render(line)
+		}
+		b.WriteString(line)
+		if i < len(entries)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/mirror"
+)
+
+// mirrorViewRows is the number of topics listed per flow in the MM2 pane.
+const mirrorViewRows = 10
+
+// SetMirrorMapping sets the MirrorMaker 2 topology and its topic mapping
+// shown in the MM2 pane.
+func (m *Model) SetMirrorMapping(cfg *mirror.Config, flows []mirror.FlowMapping) {
+	m.mirrorCfg = cfg
+	m.mirrorFlows = flows
+}
+
+// mirrorMismatches counts the mirrored topics whose partition counts differ
+// from their source's.
+func (m Model) mirrorMismatches() int {
+	n := 0
+	for _, f := range m.mirrorFlows {
+		n += len(f.Mismatches())
+	}
+	return n
+}
+
+// mirrorStatus renders the header warning about mismatched partition
+// counts, or "" when there are none.
+func (m Model) mirrorStatus() string {
+	n := m.mirrorMismatches()
+	if n == 0 {
+		return ""
+	}
+	return FailStyle.Render(i18n.T("mirror.status", i18n.Plural(n, "mirrored topic"))) + "\n"
+}
+
+// mirrorView renders the MM2 pane: per flow, every mirrored topic with its
+// name and partition count on both sides, mismatches first, and the
+// internal topics MM2 keeps for the flow.
+func (m Model) mirrorView() string {
+	c := m.mirrorCfg
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("mirror.title", c.Policy, c.Separator)))
+	b.WriteString("\n")
+	for i, f := range m.mirrorFlows {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(FocusedStyle.Bold(true).Render(fmt.Sprintf("%s -> %s", f.Flow.Source, f.Flow.Target)))
+		b.WriteString(i18n.T("mirror.mirrored", i18n.Plural(len(f.Topics), "topic")) + "\n")
+		if c.Policy == mirror.PolicyIdentity && m.reverseFlow(f.Flow) {
+			b.WriteString(WarnStyle.Render("  "+i18n.T("mirror.loop", f.Flow.Target, f.Flow.Source)) + "\n")
+		}
+
+		topics := append([]mirror.TopicMapping(nil), f.Topics...)
+		sort.SliceStable(topics, func(i, j int) bool { return topics[i].Mismatched() && !topics[j].Mismatched() })
+		if len(topics) > 0 {
+			b.WriteString(fmt.Sprintf("  %-30s %-34s %s\n", i18n.T("mirror.source"), i18n.T("mirror.target"), i18n.T("tenants.partitions")))
+		}
+		for _, t := range topics[:min(len(topics), mirrorViewRows)] {
+			line := fmt.Sprintf("  %s %s %s", padRight(t.SourceTopic, 30), padRight(t.TargetTopic, 34), mirrorPartitions(t))
+			switch t.Status {
+			case mirror.StatusFewer, mirror.StatusMore:
+				b.WriteString(FailStyle.Render(line) + "\n")
+			case mirror.StatusMissing:
+				b.WriteString(WarnStyle.Render(line) + "\n")
+			default:
+				b.WriteString(line + "\n")
+			}
+		}
+		if n := len(topics) - mirrorViewRows; n > 0 {
+			b.WriteString(HelpStyle.Render("  "+i18n.T("mirror.more", n)) + "\n")
+		}
+
+		for _, it := range f.Internal {
+			state := i18n.T("mirror.unknown")
+			if it.Known && it.Present {
+				state = i18n.T("mirror.present")
+			} else if it.Known {
+				state = i18n.T("mirror.missing")
+			}
+			line := "  " + i18n.T("mirror.internal", it.Name, it.Cluster, state, it.Purpose)
+			if it.Known && !it.Present {
+				b.WriteString(WarnStyle.Render(line) + "\n")
+			} else {
+				b.WriteString(HelpStyle.Render(line) + "\n")
+			}
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// reverseFlow reports whether the flow in the other direction is enabled.
+func (m Model) reverseFlow(f mirror.Flow) bool {
+	for _, other := range m.mirrorCfg.Flows {
+		if other.Source == f.Target && other.Target == f.Source {
+			return true
+		}
+	}
+	return false
+}
+
+// mirrorPartitions describes the partition counts of a mirrored topic.
+func mirrorPartitions(t mirror.TopicMapping) string {
+	switch t.Status {
+	case mirror.StatusUnknown:
+		return i18n.T("mirror.partitions.unknown", t.SourcePartitions)
+	case mirror.StatusMissing:
+		return i18n.T("mirror.partitions.missing", t.SourcePartitions)
+	case mirror.StatusFewer:
+		return i18n.T("mirror.partitions.fewer", t.SourcePartitions, t.TargetPartitions)
+	case mirror.StatusMore:
+		return i18n.T("mirror.partitions.more", t.SourcePartitions, t.TargetPartitions)
+	}
+	return fmt.Sprintf("%d -> %d", t.SourcePartitions, t.TargetPartitions)
+}
@@ -1,8 +1,31 @@
 package tui
 
 import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
 	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/assignment"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/capacity"
 	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/export"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/kafka"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/lag"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/locality"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/mirror"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/naming"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/recording"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/rules"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/scenarios"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/screenshot"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/session"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/timeline"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/weights"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -16,43 +39,572 @@ const (
 	AskSingleConfig
 	AskMRCConfig
 	ShowPlacement
-	ShowError // Represents a state where a known error is displayed
+	EditTopics   // Table editor for multi-topic placements
+	AskStrategy  // Strategy and its parameters, for placing again
+	Tutorial     // Guided walkthrough of a small example cluster
+	Quiz         // Questions on what random failures do to a placement
+	Library      // List of the bundled example scenarios
+	Importing    // Progress of a cluster or scenario import running in the background
+	ConnectKafka // Bootstrap servers and topic of a live cluster, then its topics to pick from
+	ShowError    // Represents a state where a known error is displayed
 )
 
+// ColorMode selects what the placement view colors replica chips and
+// broker borders by.
+type ColorMode int
+
+const (
+	ColorByRole       ColorMode = iota // Leader/follower/observer colors
+	ColorByDC                          // One color per data center, role shown by text style
+	ColorHeatReplicas                  // Broker background shaded by replica count vs. the average
+	ColorHeatLeaders                   // Broker background shaded by leader count vs. the average
+	ColorByDiff                        // Changes from the diff base: added, removed, role changed
+	numColorModes
+)
+
+// String returns the name shown in the legend.
+func (c ColorMode) String() string {
+	switch c {
+	case ColorByDC:
+		return i18n.T("colorMode.dc")
+	case ColorHeatReplicas:
+		return i18n.T("colorMode.replicas")
+	case ColorHeatLeaders:
+		return i18n.T("colorMode.leaders")
+	case ColorByDiff:
+		return i18n.T("colorMode.diff")
+	default:
+		return i18n.T("colorMode.role")
+	}
+}
+
 // Model holds the state for the TUI application. Exported for use in main.go.
 type Model struct {
 	stage         Stage
 	clusterType   config.ClusterType
 	inputs        []textinput.Model
 	focused       int
-	err           error // To store validation or processing errors
-	width, height int   // Terminal size
+	err           error    // To store validation or processing errors
+	fieldWarnings []string // Live cross-field warnings, one per input
+	defaulted     []bool   // Inputs holding a suggested default the user hasn't edited
+	width, height int      // Terminal size
+	quitting      bool     // Set on quit so the final frame is blank
+	printing      bool     // Rendering for the scrollback (PrintView), not the screen
 
 	// Config values gathered from inputs
 	numPartitions     int
 	minInSyncReplicas int
 	replicationFactor int
-	numBrokers        int // Represents Total Brokers for Single, Brokers Per DC for MRC
+	numBrokers        int   // Represents Total Brokers for Single, Brokers Per DC for MRC
+	rackSizes         []int // Brokers in each DC of an MRC with uneven racks, else nil
 	numDCs            int
 
 	// Placement results from the placement package
+	placementCfg      config.PlacementConfig // Config the current placement was calculated from
 	dcs               map[int]*config.DCInfo // Map DC ID -> DCInfo
 	mrcRecommendation string
+	source            string                      // Where the configuration came from, if not the wizard
+	liveAssignment    bool                        // The placement is a live cluster's actual assignment, not simulated
+	drift             []assignment.PartitionDrift // Live partitions that differ from the approved placement
+	driftChecked      bool                        // An approved placement was given to compare with
+	rackViolations    []int                       // Partitions not spread over as many DCs as they could be
+	baselineDCs       map[int]*config.DCInfo      // Same config placed with the random strategy, for comparison
+	diffDCs           map[int]*config.DCInfo      // Placement shown before the last change, for the diff view
+	diffLabel         string                      // Describes diffDCs in the diff legend
+	showStats         bool                        // Show the per-broker network load pane
+	showHistogram     bool                        // Show the distribution screen instead of broker boxes
+	hideFooter        bool                        // Collapse the legend and key help to a one-line hint
+	showFailover      bool                        // Show the broker failure estimates pane
+	showKubernetes    bool                        // Show the Kubernetes topology spread pane
+	lostDC            int                         // DC whose loss the failover pane shows promotion commands for (0 = none)
+	placementScroll   int                         // First visible row of broker boxes
+
+	// Broker selection in the placement view and its detail modal
+	selectedBroker  int // Broker ID, valid when brokerSelected is set
+	brokerSelected  bool
+	showBrokerModal bool
+	modalScroll     int                   // First replica row shown in the modal
+	comparison      *placement.Comparison // Strategy comparison, computed when the stats pane opens
+
+	// Session-wide placement options (set at startup)
+	strategy         config.Strategy
+	weights          *weights.Data
+	goals            []string // Goal priority order for the goals strategy
+	maxMoves         int
+	looseRacks       bool  // Don't spread MRC replicas over every DC first
+	noLeaderSpread   bool  // Skip the size-aware strategy's leader balancing
+	seed             int64 // Reproducible placements when non-zero
+	controllers      config.ControllerMode
+	controllerCount  int                  // KRaft quorum voters, 0 for the default
+	activeController int                  // Node ID of the active controller, -1 for the lowest voter
+	recording        *recording.Recording // Keys are recorded into it when not nil
+	screenshotDir    string               // Where Ctrl+S saves screenshots, "" for the current directory
+	screenshotFormat screenshot.Format    // And as SVG or text
+	screenshotSaved  string               // Path of the screenshot just saved, shown until the next key
+	replaying        *replay              // The recorded session being replayed, if any
+	rackCount        int                  // Racks to spread a single cluster's brokers over, 0 for none
+	brokerRacks      map[int]string       // Or the broker.rack of each broker
+	brokerTags       map[int]map[string]string
+	avoidLeaders     []config.TagSelector // Keep leaders off brokers with these tags
+	clientTraffic    config.ClientTraffic // Producer/consumer traffic per DC for the client-affinity strategy
+	failoverTiming   failover.Timing
+	leaderRebalance  failover.Rebalance // Simulated auto.leader.rebalance.enable and friends
+	waveLimits       export.WaveLimits  // Concurrent moves per wave of a migration
+	// Throughput capacity of every broker and overrides by broker ID
+	capacityDefaults capacity.Capacity
+	brokerCapacity   map[int]capacity.Capacity
+	placements       *placement.Cache // Computed placements, shared by restarts
+
+	// Strategy parameters form
+	paramStrategy config.Strategy
+	paramInputs   []textinput.Model
+	paramFocus    int // 0 is the strategy selector, then the inputs
+
+	// User-defined validation rules and their results for the current placement
+	rules       []rules.Rule
+	ruleResults []rules.Result
+
+	// Multi-topic mode: every topic placed on the same brokers. The
+	// placement fields above hold the active topic while it is shown.
+	topics      []topicPlacement
+	activeTopic int
+	topicSpecs  []config.TopicSpec // Topic list loaded at startup, placed instead of a single topic
+
+	// Topic table editor
+	topicRows          [][]textinput.Model
+	topicRow, topicCol int
+	topicBase          config.PlacementConfig // The brokers the edited topics are placed on
+	topicFrom          Stage                  // The form or placement the editor was opened from
+
+	// Last saved session, offered for resuming on the first screen
+	lastSession *session.Session
+
+	// Scenario library: the highlighted entry, and the scenario placed
+	libraryIndex int
+	scenario     *scenarios.Scenario
+
+	// Notes on the placement for reviewers, shared in bundles
+	annotations []session.Annotation
+	editingNote bool
+	noteInput   textinput.Model
+
+	// Live assignment file, reloaded when it changes to follow a reassignment
+	assignmentPath  string
+	assignmentMod   time.Time
+	assignmentErr   error
+	liveData        *assignment.Data
+	approved        *assignment.Data // Approved plan to check for drift, if given
+	showReplication bool             // Show the under-replicated/offline partitions pane
+	clusterMeta     *ClusterMetadata // Shown above the live assignment
+	namingReport    *naming.Report   // Naming convention audit of the imported topics
+
+	// Snapshots of the live assignment, and the one it is compared with
+	snapshotDir     string
+	snapshotEvery   time.Duration // 0 saves none
+	snapshotChecked time.Time     // Last time the live assignment was compared with the latest snapshot
+	snapshots       []assignment.Snapshot
+	snapshotIndex   int // -1 before one is picked
+	snapshotData    *assignment.Data
+	snapshotErr     error
+	showSnapshots   bool
+
+	// Guided walkthrough
+	tutorialStep int
+	tutorialCfg  config.PlacementConfig // The example cluster's config
+	tutorialDCs  map[int]*config.DCInfo // The example cluster
+
+	// Quiz mode
+	quizRand                  *rand.Rand
+	quizQuestion              quizQuestion
+	quizInput                 textinput.Model
+	quizAnswered, quizCorrect bool
+	quizAsked, quizScore      int
+
+	// Snapshots of earlier runs, charted per cluster in the trend pane
+	history   []session.Snapshot
+	showTrend bool
+
+	// Screen-reader friendly text summary instead of broker boxes
+	accessible bool
+	colorMode  ColorMode
+	tagFilter  string // key=value of the brokers highlighted, "" for none
+
+	// Partitions are shown as Kafka numbers them, from 0, instead of from 1
+	zeroBasedPartitions bool
+
+	// Consumer lag overlay (optional, loaded at startup)
+	lagData      *lag.Data
+	lagThreshold int64 // Lag at or above which a partition is highlighted as hot
+	showLag      bool
+
+	// MirrorMaker 2 topic mapping (optional, loaded at startup)
+	mirrorCfg   *mirror.Config
+	mirrorFlows []mirror.FlowMapping
+	showMirror  bool
+	showTenants bool // Show the per-tenant footprint pane
+
+	showSurvivability bool // Show the min ISR matrix by failed DCs
+
+	// DR comparison of the MRC placement with two clusters and MM2
+	mirrorLag time.Duration // 0 for the default
+	showDR    bool
+
+	// What-if replication factor change: the placement and config before it
+	rfBase     map[int]*config.DCInfo
+	rfBaseCfg  config.PlacementConfig
+	rfBaseLive bool // The base is a live cluster's assignment
+	rfErr      error
+
+	// ISR shrink/expand timeline: replica.lag.time.max.ms and the broker
+	// slowdowns and restarts it plays
+	isrLagTimeMax time.Duration
+	isrEvents     []failover.BrokerEvent
+	showISR       bool
+
+	// Consumer fetch locality pane
+	consumers    locality.Consumers
+	showLocality bool
+
+	// Event timeline: the scripted events and how fast its reassignment copies
+	timelineEvents   []timeline.Event
+	reassignThrottle float64 // Bytes/sec into each broker, with partition sizes
+	waveTime         time.Duration
+	replicationRate  float64 // Bytes/sec a recovering broker fetches, 0 for its network capacity
+	showTimeline     bool
+
+	// Log pane, and how many log entries were logged when it was last toggled
+	showLog bool
+	logSeen int
+
+	// Import running in the background, and how far it got
+	importing   *importState
+	importDone  int
+	importTotal int
+	importUnit  string
+
+	// Live cluster connection: the form, its options and, when connected
+	// without a topic, the cluster's topics to pick from
+	kafkaInputs     []textinput.Model
+	kafkaFocus      int
+	kafkaErr        error
+	kafkaBootstrap  string
+	kafkaOpts       kafka.Options
+	kafkaCluster    *kafka.Cluster
+	kafkaTopicIndex int
 }
 
 // NewModel creates the initial state of the TUI model. Exported for use in main.go.
 func NewModel() Model {
 	m := Model{
-		stage:   AskClusterType,
-		focused: 0,
-		dcs:     make(map[int]*config.DCInfo),
+		stage:            AskClusterType,
+		focused:          0,
+		dcs:              make(map[int]*config.DCInfo),
+		failoverTiming:   failover.DefaultTiming(),
+		leaderRebalance:  failover.DefaultRebalance(),
+		waveLimits:       export.WaveLimits{PerBroker: export.DefaultMovesPerBroker},
+		placements:       placement.NewCache(placement.DefaultCacheSize),
+		activeController: -1,
 	}
 	// No inputs needed for the first stage, they are setup in Update
 	return m
 }
 
+// ImportConfig switches the model directly to the placement view for a
+// configuration obtained outside the wizard (e.g. a Strimzi import).
+// source is a short description shown above the placement.
+func (m *Model) ImportConfig(cfg config.PlacementConfig, source string) {
+	m.clusterType = cfg.ClusterType
+	m.numPartitions = cfg.NumPartitions
+	m.replicationFactor = cfg.ReplicationFactor
+	m.minInSyncReplicas = cfg.MinInSyncReplicas
+	m.numBrokers = cfg.NumBrokers
+	m.rackSizes = unevenRackSizes(cfg)
+	m.numDCs = cfg.NumDCs
+	m.source = source
+	m.runPlacement(m.withSessionOptions(cfg))
+}
+
+// ImportPlacement switches the model directly to the placement view for
+// an actual assignment (e.g. of a live cluster) on the topology of cfg,
+// instead of simulating one.
+func (m *Model) ImportPlacement(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, source string) {
+	m.clusterType = cfg.ClusterType
+	m.numPartitions = cfg.NumPartitions
+	m.replicationFactor = cfg.ReplicationFactor
+	m.minInSyncReplicas = cfg.MinInSyncReplicas
+	m.numBrokers = cfg.NumBrokers
+	m.rackSizes = unevenRackSizes(cfg)
+	m.numDCs = cfg.NumDCs
+	m.source = source
+	m.topics = nil
+	m.showPlacement(m.withSessionOptions(cfg), dcs, "")
+	m.liveAssignment = true
+}
+
+// LoadPlacement switches the model directly to the placement view for a
+// placement exported earlier (see export.LoadFile). Unlike ImportPlacement
+// it is a plan, not a live assignment: it can be recalculated, compared and
+// exported again. The strategy that produced it becomes the session's, and
+// a placement exported with 0-based partition numbers keeps them.
+func (m *Model) LoadPlacement(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, source string) {
+	m.clusterType = cfg.ClusterType
+	m.numPartitions = cfg.NumPartitions
+	m.replicationFactor = cfg.ReplicationFactor
+	m.minInSyncReplicas = cfg.MinInSyncReplicas
+	m.numBrokers = cfg.NumBrokers
+	m.rackSizes = unevenRackSizes(cfg)
+	m.numDCs = cfg.NumDCs
+	m.strategy = cfg.Strategy
+	m.zeroBasedPartitions = m.zeroBasedPartitions || cfg.ZeroBasedPartitions
+	m.source = source
+	m.topics = nil
+	m.showPlacement(m.withSessionOptions(cfg), dcs, "")
+}
+
+// SetStrategy selects the placement strategy used for every calculation.
+func (m *Model) SetStrategy(strategy config.Strategy) {
+	m.strategy = strategy
+}
+
+// SetSeed makes every placement reproducible: the same configuration and
+// seed always give the same placement. 0 keeps placements random.
+func (m *Model) SetSeed(seed int64) {
+	m.seed = seed
+}
+
+// SetGoals configures the goal priority order (highest first) and move
+// budget used by the goals strategy.
+func (m *Model) SetGoals(goals []string, maxMoves int) {
+	m.goals = goals
+	m.maxMoves = maxMoves
+}
+
+// SetRules sets the user-defined validation rules evaluated against every
+// placement.
+func (m *Model) SetRules(r []rules.Rule) {
+	m.rules = r
+}
+
+// SetWeights attaches per-partition size/throughput estimates used by the
+// load-aware strategies and shown in the broker boxes.
+func (m *Model) SetWeights(data *weights.Data) {
+	m.weights = data
+}
+
+// SetTopics sets a topic list that is placed, on the brokers from the
+// wizard or import, instead of the single topic they describe.
+func (m *Model) SetTopics(specs []config.TopicSpec) {
+	m.topicSpecs = specs
+}
+
+// SetControllers sets how the KRaft controller quorum the failure
+// simulations account for is deployed, and its number of voters (0 for the
+// default).
+func (m *Model) SetControllers(mode config.ControllerMode, count int) {
+	m.controllers = mode
+	m.controllerCount = count
+}
+
+// SetActiveController sets the voter assumed to be the active controller,
+// the leader of the metadata log, by node ID (-1 for the lowest voter).
+func (m *Model) SetActiveController(nodeID int) {
+	m.activeController = nodeID
+}
+
+// SetBrokerTags sets the key/value tags of the brokers, keyed by broker ID,
+// and the tags whose brokers should not lead partitions. They replace the
+// tags of scenarios and bundles when set.
+func (m *Model) SetBrokerTags(tags map[int]map[string]string, avoidLeaders []config.TagSelector) {
+	m.brokerTags = tags
+	m.avoidLeaders = avoidLeaders
+}
+
+// SetBrokerRacks assigns the brokers of simulated single clusters to
+// racks: count racks rack1..rackN in turn, or the given broker.rack per
+// broker ID. Replicas of a partition then land on distinct racks.
+func (m *Model) SetBrokerRacks(count int, racks map[int]string) {
+	m.rackCount, m.brokerRacks = count, racks
+}
+
+// SetFailoverTiming sets the timeouts the broker failure estimates are
+// based on.
+func (m *Model) SetFailoverTiming(t failover.Timing) {
+	m.failoverTiming = t
+}
+
+// SetAccessible selects the screen-reader friendly text summary for the
+// placement view instead of the graphical broker boxes.
+func (m *Model) SetAccessible(on bool) {
+	m.accessible = on
+}
+
+// SetZeroBasedPartitions numbers partitions from 0, as Kafka does, in every
+// view and export instead of from 1.
+func (m *Model) SetZeroBasedPartitions(on bool) {
+	m.zeroBasedPartitions = on
+}
+
+// SetLagData attaches consumer-group lag to be overlaid on the placement
+// view. Partitions with lag at or above threshold are highlighted.
+func (m *Model) SetLagData(data *lag.Data, threshold int64) {
+	m.lagData = data
+	m.lagThreshold = threshold
+	m.showLag = data != nil
+}
+
+// SetLastSession offers a previously saved session for resuming on the
+// cluster type screen.
+func (m *Model) SetLastSession(s *session.Session) {
+	m.lastSession = s
+}
+
+// Placement returns the placement currently shown and the config it was
+// calculated from; ok is false if no placement is shown.
+func (m Model) Placement() (cfg config.PlacementConfig, dcs map[int]*config.DCInfo, ok bool) {
+	if m.stage != ShowPlacement {
+		return config.PlacementConfig{}, nil, false
+	}
+	return m.placementCfg, m.dcs, true
+}
+
+// Migration returns the placement shown before the last change (e.g. the
+// live assignment before re-placing it) as the starting point of a
+// migration to the final placement, and false when there is none.
+func (m Model) Migration() (from map[int]*config.DCInfo, ok bool) {
+	if m.stage != ShowPlacement || m.diffDCs == nil {
+		return nil, false
+	}
+	return m.diffDCs, true
+}
+
+// SetWaveLimits sets the concurrent moves allowed per wave when a migration
+// is split into waves.
+func (m *Model) SetWaveLimits(limits export.WaveLimits) {
+	m.waveLimits = limits
+}
+
+// Drift returns the partitions of the shown live assignment that drifted
+// from the approved placement, nil if none did or no live assignment is
+// shown.
+func (m Model) Drift() []assignment.PartitionDrift {
+	if m.stage != ShowPlacement || !m.liveAssignment {
+		return nil
+	}
+	return m.drift
+}
+
+// Breaches returns the rules the current placement fails, nil if no
+// placement is shown.
+func (m Model) Breaches() []rules.Result {
+	if m.stage != ShowPlacement {
+		return nil
+	}
+	var failed []rules.Result
+	for _, r := range m.ruleResults {
+		if !r.Passed {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// SetHistory sets the snapshots of earlier runs, oldest first.
+func (m *Model) SetHistory(h []session.Snapshot) {
+	m.history = h
+}
+
+// Snapshot measures the current placement for the history, or returns nil
+// if no placement is shown.
+func (m Model) Snapshot() *session.Snapshot {
+	if m.stage != ShowPlacement {
+		return nil
+	}
+	s := session.NewSnapshot(m.clusterKey(), m.placementCfg, m.dcs)
+	return &s
+}
+
+// unevenRackSizes returns the brokers in each DC of cfg when they differ,
+// else nil.
+func unevenRackSizes(cfg config.PlacementConfig) []int {
+	if cfg.ClusterType != config.MRC || !cfg.UnevenRacks() {
+		return nil
+	}
+	return cfg.RackSizes()
+}
+
+// clusterKey identifies the cluster of the current placement in the
+// history: the import source without its counts, which change as topics
+// come and go, or the simulated topology. The key is stored in the history,
+// so it is not translated.
+func (m Model) clusterKey() string {
+	if m.source != "" {
+		name, _, _ := strings.Cut(m.source, " (")
+		return name
+	}
+	if m.clusterType == config.MRC {
+		if len(m.rackSizes) > 0 {
+			return fmt.Sprintf("MRC, %d DCs with %s brokers", m.numDCs, formatBrokerCounts(m.rackSizes, "/"))
+		}
+		return fmt.Sprintf("MRC, %d DCs x %d brokers", m.numDCs, m.numBrokers)
+	}
+	return fmt.Sprintf("Single cluster, %d brokers", m.numBrokers)
+}
+
+// Session returns the current placement as a session to save, or nil if no
+// placement is shown.
+func (m Model) Session() *session.Session {
+	if m.stage != ShowPlacement {
+		return nil
+	}
+	return &session.Session{
+		SavedAt:           time.Now(),
+		Source:            m.source,
+		Config:            m.placementCfg,
+		Placement:         m.dcs,
+		MRCRecommendation: m.mrcRecommendation,
+	}
+}
+
+// resume restores a saved session's placement as it was, without
+// recalculating it.
+func (m *Model) resume(s *session.Session) {
+	cfg := s.Config
+	m.clusterType = cfg.ClusterType
+	m.numPartitions = cfg.NumPartitions
+	m.replicationFactor = cfg.ReplicationFactor
+	m.minInSyncReplicas = cfg.MinInSyncReplicas
+	m.numBrokers = cfg.NumBrokers
+	m.rackSizes = unevenRackSizes(cfg)
+	m.numDCs = cfg.NumDCs
+	m.source = s.Source
+	m.topics = nil
+	cfg.ZeroBasedPartitions = m.zeroBasedPartitions
+	m.showPlacement(cfg, s.Placement, s.MRCRecommendation)
+}
+
+// PrintView renders the current placement in full, without the key help and
+// the viewport limit, for printing to the terminal after the program exits.
+// It returns "" if no placement is shown.
+func (m Model) PrintView() string {
+	if m.stage != ShowPlacement {
+		return ""
+	}
+	m.quitting = false
+	m.printing = true
+	m.height = 0 // Render every broker row
+	m.brokerSelected = false
+	m.showBrokerModal = false
+	return strings.TrimRight(m.View(), "\n ")
+}
+
 // Init initializes the TUI model. Required by Bubble Tea.
 // Currently, just starts the cursor blinking.
 func (m Model) Init() tea.Cmd {
+	if m.importing != nil {
+		return tea.Batch(textinput.Blink, m.importing.run())
+	}
+	if m.assignmentPath != "" {
+		return tea.Batch(textinput.Blink, pollAssignment())
+	}
 	return textinput.Blink
 }
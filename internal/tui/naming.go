@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/naming"
+)
+
+// namingViewLimit is how many naming violations the placement footer lists;
+// the log has all of them.
+const namingViewLimit = 8
+
+// SetTopicNaming sets the naming convention audit of the imported cluster's
+// topics, shown below the placement and logged in full.
+func (m *Model) SetTopicNaming(report naming.Report) {
+	m.namingReport = &report
+	for _, v := range report.Violations {
+		applog.Warnf("%s", i18n.T("naming.log", v.Topic, v.Rule, v.Detail))
+	}
+}
+
+// namingView renders the topic naming audit, or "" without one.
+func (m Model) namingView() string {
+	r := m.namingReport
+	if r == nil {
+		return ""
+	}
+	var b strings.Builder
+	header := i18n.T("naming.title", r.Checked-r.Failing(), r.Checked)
+	if r.Ignored > 0 {
+		header += i18n.T("naming.ignored", r.Ignored)
+	}
+	b.WriteString(DCHeaderStyle.Render(header + "):"))
+	if len(r.Violations) == 0 {
+		b.WriteString("\n" + PassStyle.Render(i18n.T("naming.pass")))
+		return b.String()
+	}
+	for i, v := range r.Violations {
+		if i == namingViewLimit {
+			b.WriteString("\n" + HelpStyle.Render(i18n.T("naming.more", len(r.Violations)-i)))
+			break
+		}
+		b.WriteString("\n" + FailStyle.Render("✗ "+v.Topic))
+		b.WriteString(HelpStyle.Render(" — " + v.Rule + ": " + v.Detail))
+	}
+	return b.String()
+}
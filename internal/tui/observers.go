@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// observerStatus renders why the observers of the MRC placement shown
+// cannot do their job, and what to change, for the placement header. A
+// live assignment has the roles of the cluster, which are not checked.
+func (m Model) observerStatus() string {
+	if m.liveAssignment {
+		return ""
+	}
+	var b strings.Builder
+	for _, issue := range placement.ObserverIssues(m.placementCfg) {
+		warn, help := WarnStyle, HelpStyle
+		if m.width > 0 {
+			warn, help = warn.Width(m.width), help.Width(m.width)
+		}
+		b.WriteString(warn.Render("⚠ "+issue.Problem) + "\n")
+		b.WriteString(help.Render("  "+issue.Fix) + "\n")
+	}
+	return b.String()
+}
+
+// formObserverWarning checks the observer design of the MRC form as the
+// user types, "" while it is fine or the fields it needs are not filled in.
+func (m Model) formObserverWarning() string {
+	v := m.formValues()
+	if m.clusterType != config.MRC || v[fieldDCs] < 2 || v[fieldRF] == 0 || v[fieldMinISR] == 0 {
+		return ""
+	}
+	cfg := config.PlacementConfig{
+		ClusterType:       config.MRC,
+		NumDCs:            v[fieldDCs],
+		NumBrokers:        formTotalBrokers(v, m.formRackSizes()) / v[fieldDCs],
+		ReplicationFactor: v[fieldRF],
+		MinInSyncReplicas: v[fieldMinISR],
+	}
+	if issues := placement.ObserverIssues(cfg); len(issues) > 0 {
+		return issues[0].Problem + " " + issues[0].Fix
+	}
+	return ""
+}
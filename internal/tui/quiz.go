@@ -0,0 +1,247 @@
+package tui
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// quizQuestion is one generated question: a placement, the brokers that
+// fail and the partitions that are the right answer.
+type quizQuestion struct {
+	text   string
+	cfg    config.PlacementConfig
+	dcs    map[int]*config.DCInfo
+	failed map[int]bool
+	answer []int
+}
+
+// newQuizQuestion places a small random cluster, fails some of its brokers
+// and asks which partitions go offline or stop taking acks=all writes. The
+// answer comes from failover.SimulateOutage.
+func newQuizQuestion(rng *rand.Rand) quizQuestion {
+	var q quizQuestion
+	var failedDesc []string
+	if rng.Intn(2) == 0 {
+		rf := 2 + rng.Intn(2)
+		q.cfg = config.PlacementConfig{
+			ClusterType:       config.SingleCluster,
+			NumBrokers:        3 + rng.Intn(3),
+			NumDCs:            1,
+			NumPartitions:     6,
+			ReplicationFactor: rf,
+			MinInSyncReplicas: max(1, rf-rng.Intn(2)),
+		}
+	} else {
+		rf := 3 + rng.Intn(2)
+		q.cfg = config.PlacementConfig{
+			ClusterType:       config.MRC,
+			NumBrokers:        2,
+			NumDCs:            3,
+			NumPartitions:     6,
+			ReplicationFactor: rf,
+			MinInSyncReplicas: 2 + rng.Intn(2),
+		}
+	}
+	q.cfg.Seed = rng.Int63()
+	q.dcs, _ = placement.CalculatePlacement(q.cfg)
+
+	q.failed = make(map[int]bool)
+	brokerIDs := allBrokerIDs(q.dcs)
+	if q.cfg.ClusterType == config.MRC {
+		// A whole DC and a broker elsewhere
+		dcID := 1 + rng.Intn(q.cfg.NumDCs)
+		for id := range q.dcs[dcID].Brokers {
+			q.failed[id] = true
+		}
+		failedDesc = append(failedDesc, i18n.T("quiz.dc", dcID))
+	}
+	for len(failedDesc) < 2 {
+		id := brokerIDs[rng.Intn(len(brokerIDs))]
+		if q.failed[id] {
+			continue
+		}
+		q.failed[id] = true
+		failedDesc = append([]string{i18n.T("quiz.broker", id)}, failedDesc...)
+		if q.cfg.ClusterType == config.SingleCluster && rng.Intn(2) == 0 {
+			break // Sometimes a single broker
+		}
+	}
+
+	// The questions are about data availability, so the controllers are left out
+	outage := failover.SimulateOutage(q.dcs, failover.Quorum{}, q.failed, q.cfg.MinInSyncReplicas)
+	failures, count := failedDesc[0], "one"
+	if len(failedDesc) > 1 {
+		failures, count = i18n.T("list.and", failedDesc[0], failedDesc[1]), "other"
+	}
+	if rng.Intn(2) == 0 {
+		q.text = i18n.T("quiz.offline."+count, failures)
+		q.answer = outage.Offline
+	} else {
+		q.text = i18n.T("quiz.noWrites."+count, q.cfg.MinInSyncReplicas, failures)
+		q.answer = outage.NoWrites()
+	}
+	return q
+}
+
+// parseQuizAnswer reads partition numbers such as "p1 p3" or "1,3" into
+// partition IDs; empty, "-" or "none" (in the UI language too) is the
+// empty answer.
+func (m Model) parseQuizAnswer(s string) ([]int, error) {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool { return r == ',' || r == ' ' })
+	seen := make(map[int]bool)
+	var ids []int
+	for _, f := range fields {
+		if f == "none" || f == "-" || f == i18n.T("quiz.none") {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(f, "p"))
+		id := m.quizQuestion.cfg.PartitionID(n)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf(i18n.T("quiz.notPartition"), f)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// sameIDs reports whether two partition lists hold the same partitions.
+func sameIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[int]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// StartQuiz switches to quiz mode with a first question.
+func (m *Model) StartQuiz() tea.Cmd {
+	m.stage = Quiz
+	m.quizRand = placement.NewRand(m.seed)
+	m.quizAsked, m.quizScore = 0, 0
+	return m.nextQuizQuestion()
+}
+
+// nextQuizQuestion generates a question and clears the answer input.
+func (m *Model) nextQuizQuestion() tea.Cmd {
+	m.quizQuestion = newQuizQuestion(m.quizRand)
+	m.quizQuestion.cfg.ZeroBasedPartitions = m.zeroBasedPartitions
+	m.quizAnswered, m.quizCorrect = false, false
+	m.err = nil
+	m.quizInput = textinput.New()
+	m.quizInput.Placeholder = i18n.T("quiz.placeholder")
+	m.quizInput.Cursor.Style = CursorStyle
+	m.quizInput.PromptStyle = FocusedStyle
+	m.quizInput.TextStyle = FocusedStyle
+	m.quizInput.CharLimit = 80
+	m.quizInput.Width = 40
+	return m.quizInput.Focus()
+}
+
+// updateQuiz checks an answer on Enter, then moves on to the next question.
+func (m Model) updateQuiz(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m.quit()
+	case tea.KeyEsc:
+		return m.restart(), nil
+	case tea.KeyEnter:
+		if m.quizAnswered {
+			return m, m.nextQuizQuestion()
+		}
+		answer, err := m.parseQuizAnswer(m.quizInput.Value())
+		if err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.err = nil
+		m.quizAnswered = true
+		m.quizAsked++
+		m.quizCorrect = sameIDs(answer, m.quizQuestion.answer)
+		if m.quizCorrect {
+			m.quizScore++
+		}
+		m.quizInput.Blur()
+		return m, nil
+	}
+	if m.quizAnswered {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.quizInput, cmd = m.quizInput.Update(msg)
+	return m, cmd
+}
+
+// quizView renders the placement, the question and, once answered, the
+// verdict with the failed brokers marked.
+func (m Model) quizView() string {
+	q := m.quizQuestion
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("quiz.title", m.quizAsked+boolInt(!m.quizAnswered), m.quizScore, m.quizAsked)))
+	b.WriteString("\n")
+	kind := i18n.T("quiz.single")
+	if q.cfg.ClusterType == config.MRC {
+		kind = i18n.T("quiz.mrc", q.cfg.NumDCs)
+	}
+	b.WriteString(i18n.T("quiz.cluster", kind, i18n.Plural(q.cfg.NumPartitions, "partition"), q.cfg.ReplicationFactor, q.cfg.MinInSyncReplicas) + "\n\n")
+	var failed map[int]bool
+	if m.quizAnswered {
+		failed = q.failed
+	}
+	b.WriteString(m.compactClusterView(q.cfg, q.dcs, failed))
+	b.WriteString("\n" + q.text + "\n")
+	b.WriteString(m.quizInput.View() + "\n")
+
+	if m.err != nil {
+		b.WriteString(ErrorStyle.Render(m.err.Error()) + "\n")
+	}
+	if m.quizAnswered {
+		answer := i18n.T("quiz.none")
+		if len(q.answer) > 0 {
+			parts := make([]string, len(q.answer))
+			for i, id := range q.answer {
+				parts[i] = fmt.Sprintf("p%d", q.cfg.PartitionNumber(id))
+			}
+			answer = strings.Join(parts, " ")
+		}
+		if m.quizCorrect {
+			b.WriteString(PassStyle.Render(i18n.T("quiz.correct", answer)) + "\n")
+		} else {
+			b.WriteString(FailStyle.Render(i18n.T("quiz.wrong", answer)) + "\n")
+		}
+		b.WriteString(HelpStyle.Render(i18n.T("quiz.observers")) + "\n")
+		b.WriteString("\n" + HelpStyle.Render(i18n.T("quiz.helpNext")))
+	} else {
+		b.WriteString("\n" + HelpStyle.Render(i18n.T("quiz.help")))
+	}
+	return b.String()
+}
+
+// boolInt returns 1 for true.
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,207 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/assignment"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// assignmentPollInterval is how often the live assignment file is checked
+// for changes, e.g. rewritten by `watch kafka-topics.sh --describe`.
+const assignmentPollInterval = 2 * time.Second
+
+// reassignmentBarWidth is the width of the reassignment progress bar.
+const reassignmentBarWidth = 30
+
+// assignmentTickMsg asks to check the live assignment file for changes.
+type assignmentTickMsg time.Time
+
+// pollAssignment schedules the next check of the live assignment file.
+func pollAssignment() tea.Cmd {
+	return tea.Tick(assignmentPollInterval, func(t time.Time) tea.Msg { return assignmentTickMsg(t) })
+}
+
+// WatchAssignment keeps the shown live assignment in sync with the file it
+// was loaded from, so an in-flight reassignment can be followed. approved,
+// if not nil, is the plan the live assignment is checked for drift against.
+func (m *Model) WatchAssignment(path string, data, approved *assignment.Data) {
+	m.assignmentPath = path
+	if info, err := os.Stat(path); err == nil {
+		m.assignmentMod = info.ModTime()
+	}
+	m.liveData = data
+	m.approved = approved
+	m.showReplication = true
+	m.refreshDrift()
+}
+
+// refreshDrift compares the live assignment with the approved plan.
+func (m *Model) refreshDrift() {
+	if m.approved == nil || m.liveData == nil {
+		return
+	}
+	m.drift = assignment.Drift(m.approved, m.liveData, m.placementCfg.TopicName)
+	m.driftChecked = true
+}
+
+// checkAssignment reloads the live assignment file if it changed since it
+// was last read. Errors are shown in the reassignment pane; the previous
+// assignment stays on screen.
+func (m *Model) checkAssignment() {
+	if m.assignmentPath == "" || !m.liveAssignment || m.stage != ShowPlacement {
+		return
+	}
+	info, err := os.Stat(m.assignmentPath)
+	if err != nil {
+		m.setAssignmentErr(err)
+		return
+	}
+	if info.ModTime().Equal(m.assignmentMod) {
+		return
+	}
+	m.assignmentMod = info.ModTime()
+	data, err := assignment.LoadFile(m.assignmentPath)
+	if err == nil {
+		err = m.applyLiveAssignment(data)
+	}
+	m.setAssignmentErr(err)
+}
+
+// setAssignmentErr records the outcome of a reload, logging an error once
+// rather than on every poll while the file stays broken.
+func (m *Model) setAssignmentErr(err error) {
+	if err != nil && (m.assignmentErr == nil || m.assignmentErr.Error() != err.Error()) {
+		applog.Errorf("%s", i18n.T("log.reloadFailed", m.assignmentPath, err))
+	}
+	m.assignmentErr = err
+}
+
+// reassigning counts the partitions of topic being reassigned in data.
+func reassigning(data *assignment.Data, topic string) int {
+	n := 0
+	for _, p := range data.ForTopic(topic) {
+		if p.Reassigning() {
+			n++
+		}
+	}
+	return n
+}
+
+// applyLiveAssignment shows a reloaded live assignment, keeping the broker
+// selection and scroll position.
+func (m *Model) applyLiveAssignment(data *assignment.Data) error {
+	cfg := m.placementCfg
+	cfg.NumPartitions = len(data.ForTopic(cfg.TopicName))
+	dcs := placement.Topology(cfg)
+	if err := data.Apply(dcs, cfg.TopicName); err != nil {
+		return err
+	}
+	selected, brokerSelected, scroll := m.selectedBroker, m.brokerSelected, m.placementScroll
+	m.ImportPlacement(cfg, dcs, m.source)
+	m.selectedBroker, m.brokerSelected, m.placementScroll = selected, brokerSelected, scroll
+	before, drifted := 0, len(m.drift)
+	if m.liveData != nil {
+		before = reassigning(m.liveData, cfg.TopicName)
+	}
+	m.liveData = data
+	m.refreshDrift()
+
+	// Log what changed, for following the reassignment in the log pane
+	after := reassigning(data, cfg.TopicName)
+	switch {
+	case before > 0 && after == 0:
+		applog.Infof("%s", i18n.T("log.reassignDone", cfg.TopicName))
+	case after != before:
+		applog.Infof("%s", i18n.T("log.reassigning", i18n.Plural(after, "partition"), cfg.TopicName))
+	default:
+		applog.Infof("%s", i18n.T("log.reloaded", cfg.TopicName))
+	}
+	if m.approved != nil && len(m.drift) != drifted {
+		if len(m.drift) == 0 {
+			applog.Infof("%s", i18n.T("log.driftNone"))
+		} else {
+			applog.Warnf("%s", i18n.T("log.drift", i18n.Plural(len(m.drift), "partition")))
+		}
+	}
+	return nil
+}
+
+// reassignmentView renders the progress of an in-flight reassignment of
+// the live assignment: the replicas being added, whether they caught up
+// (joined the ISR), and the replicas being removed. It is "" when no live
+// assignment is shown or nothing is being reassigned.
+func (m Model) reassignmentView() string {
+	if !m.liveAssignment || m.liveData == nil {
+		return ""
+	}
+	var b strings.Builder
+	if m.assignmentErr != nil {
+		b.WriteString(ErrorStyle.Render(i18n.T("reassign.failed", m.assignmentErr)) + "\n")
+	}
+
+	var moving []assignment.Partition
+	adding, caughtUp := 0, 0
+	for _, p := range m.liveData.ForTopic(m.placementCfg.TopicName) {
+		if !p.Reassigning() {
+			continue
+		}
+		moving = append(moving, p)
+		for _, id := range p.Adding {
+			adding++
+			if slices.Contains(p.Isr, id) {
+				caughtUp++
+			}
+		}
+	}
+	if len(moving) == 0 {
+		return b.String()
+	}
+
+	b.WriteString(WarnStyle.Render(i18n.T("reassign.progress", i18n.Plural(len(moving), "partition"))) + "\n")
+	done, total := caughtUp, adding
+	what := i18n.T("reassign.inSync")
+	if m.approved != nil {
+		// With a plan, progress is the partitions that reached it
+		total = len(m.approved.ForTopic(m.placementCfg.TopicName))
+		done = max(0, total-len(m.drift))
+		what = i18n.T("reassign.approved")
+	}
+	if total > 0 {
+		filled := done * reassignmentBarWidth / total
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", reassignmentBarWidth-filled)
+		b.WriteString(i18n.T("reassign.bar", bar, done*100/total, done, total, what) + "\n")
+	}
+	shown := moving[:min(len(moving), 10)]
+	for _, p := range shown {
+		var parts []string
+		if len(p.Adding) > 0 {
+			ids := make([]string, len(p.Adding))
+			for i, id := range p.Adding {
+				state := i18n.T("reassign.catchingUp")
+				if slices.Contains(p.Isr, id) {
+					state = i18n.T("reassign.caughtUp")
+				}
+				ids[i] = fmt.Sprintf("%d (%s)", id, state)
+			}
+			parts = append(parts, i18n.T("reassign.adding", strings.Join(ids, ", ")))
+		}
+		if len(p.Removing) > 0 {
+			parts = append(parts, i18n.T("reassign.removing", joinInts(p.Removing)))
+		}
+		b.WriteString(fmt.Sprintf("  p%d: %s\n", m.placementCfg.PartitionNumber(p.Partition+1), strings.Join(parts, "; ")))
+	}
+	if n := len(moving) - len(shown); n > 0 {
+		b.WriteString("  " + i18n.T("list.more", n) + "\n")
+	}
+	return b.String()
+}
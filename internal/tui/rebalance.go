@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// preferredElectionCommand moves leadership back to the preferred replicas
+// by hand. It is a command, not translated.
+const preferredElectionCommand = "kafka-leader-election --bootstrap-server <host:port> --election-type PREFERRED --all-topic-partitions"
+
+// SetLeaderRebalance sets the automatic leader rebalancing the failover pane
+// simulates after a broker returns.
+func (m *Model) SetLeaderRebalance(r failover.Rebalance) {
+	m.leaderRebalance = r
+}
+
+// rebalanceBroker picks the broker whose failure and return the rebalance
+// timeline follows: the selected broker, else the one leading the most
+// partitions.
+func (m Model) rebalanceBroker(estimates []failover.Estimate) int {
+	if m.brokerSelected {
+		return m.selectedBroker
+	}
+	best := estimates[0]
+	for _, e := range estimates {
+		if e.Led > best.Led || (e.Led == best.Led && e.BrokerID < best.BrokerID) {
+			best = e
+		}
+	}
+	return best.BrokerID
+}
+
+// rebalanceView renders how leadership moves when a broker fails and comes
+// back, and how long leadership stays off the preferred replicas after it
+// has recovered.
+func (m Model) rebalanceView(estimates []failover.Estimate) string {
+	r := m.leaderRebalance
+	id := m.rebalanceBroker(estimates)
+	tl := failover.SimulateRebalance(m.dcs, m.quorum(), m.failoverTiming, r, id)
+
+	var b strings.Builder
+	settings := "auto.leader.rebalance.enable=false"
+	if r.Enabled {
+		settings = i18n.T("rebalance.settings", r.CheckInterval, r.ImbalancePercent)
+	}
+	b.WriteString(DCHeaderStyle.Render(i18n.T("rebalance.title", id, r.RestartTime, settings)))
+	b.WriteString("\n")
+	if tl.Preferred == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("rebalance.notPreferred", id)))
+		return b.String()
+	}
+	for _, s := range tl.Steps {
+		var what string
+		switch s.Phase {
+		case failover.PhaseFenced:
+			what = i18n.T("rebalance.fenced", i18n.Plural(s.Moved, "leadership"), s.MaxLeaders)
+			if n := tl.Preferred - s.Moved; n > 0 {
+				what += i18n.T("rebalance.leaderless", i18n.Plural(n, "partition"))
+			}
+		case failover.PhaseRestarted:
+			what = i18n.T("rebalance.restarted")
+			if s.Moved > 0 {
+				what += i18n.T("rebalance.leadsAgain", i18n.Plural(s.Moved, "leaderless partition"))
+			}
+		case failover.PhaseInSync:
+			what = i18n.T("rebalance.inSync", i18n.Plural(s.NonPreferred, "partition"))
+		case failover.PhaseCheck:
+			if s.Moved > 0 {
+				what = i18n.T("rebalance.checkMoved", s.Imbalance, r.ImbalancePercent, i18n.Plural(s.Moved, "leadership"), id)
+			} else {
+				what = i18n.T("rebalance.checkWithin", s.Imbalance, r.ImbalancePercent)
+			}
+		}
+		b.WriteString(fmt.Sprintf("  %-9s %s\n", "t+"+s.At.Round(100*time.Millisecond).String(), what))
+	}
+
+	switch {
+	case tl.Stuck && !r.Enabled:
+		b.WriteString(WarnStyle.Render(i18n.T("rebalance.stuckDisabled", preferredElectionCommand)))
+	case tl.Stuck:
+		b.WriteString(WarnStyle.Render(i18n.T("rebalance.stuck", r.ImbalancePercent, preferredElectionCommand)))
+	case tl.Returned > 0:
+		b.WriteString(i18n.T("rebalance.returned", tl.Returned, tl.Window, id, r.CheckInterval))
+	default:
+		b.WriteString(HelpStyle.Render(i18n.T("rebalance.noneMoved")))
+	}
+	return b.String()
+}
@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+const recoverySlowest = 5 // Most partitions listed per recovery
+
+// SetReplicationThrottle sets the rate a recovering broker's replicas fetch
+// at, bytes/sec. Without it they fetch at the broker's network capacity.
+func (m *Model) SetReplicationThrottle(rate float64) {
+	m.replicationRate = rate
+}
+
+// recoveryRate returns the rate a recovering broker fetches at and where it
+// comes from: the replication throttle, the broker's network capacity or
+// the assumed default.
+func (m Model) recoveryRate(brokerID int) (float64, string) {
+	switch {
+	case m.replicationRate > 0:
+		return m.replicationRate, "--replication-throttle"
+	case m.brokerCapacity[brokerID].Network > 0:
+		return m.brokerCapacity[brokerID].Network, i18n.T("recovery.rate.broker")
+	case m.capacityDefaults.Network > 0:
+		return m.capacityDefaults.Network, i18n.T("recovery.rate.default")
+	}
+	return failover.DefaultRecoveryRate, i18n.T("recovery.rate.assumed")
+}
+
+// recoveryView renders how long a broker's replicas take to catch up when
+// it returns after the rebalance timeline's restart time, and to be copied
+// again onto an empty broker, from the partition sizes and ingest rates.
+func (m Model) recoveryView(brokerID int) string {
+	rate, source := m.recoveryRate(brokerID)
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("recovery.title", brokerID, formatRate(rate), source)))
+	b.WriteString("\n")
+
+	cases := []struct {
+		name    string
+		rebuild bool
+	}{
+		{i18n.T("recovery.returns", m.leaderRebalance.RestartTime), false},
+		{i18n.T("recovery.rebuilt"), true},
+	}
+	var returned failover.Recovery
+	for i, c := range cases {
+		rec := failover.SimulateRecovery(m.dcs, brokerID, failover.RecoveryParams{
+			Downtime: m.leaderRebalance.RestartTime,
+			Rebuild:  c.rebuild,
+			Rate:     rate,
+			Loads:    m.placementCfg.PartitionLoads,
+		})
+		if i == 0 {
+			returned = rec
+		}
+		if len(rec.Partitions) == 0 {
+			need := "bytes_in"
+			if c.rebuild {
+				need = "size"
+			}
+			b.WriteString(fmt.Sprintf("  %-26s %s\n", c.name, HelpStyle.Render(i18n.T("recovery.unknown", need))))
+			continue
+		}
+		line := fmt.Sprintf("  %-26s %s", c.name, i18n.T("recovery.done", formatBytes(rec.Bytes), rec.Done))
+		if rec.Done < 0 {
+			line = FailStyle.Render(fmt.Sprintf("  %-26s %s", c.name, i18n.T("recovery.never", formatBytes(rec.Bytes))))
+		}
+		b.WriteString(line)
+		if rec.Unknown > 0 {
+			b.WriteString(HelpStyle.Render(i18n.T("recovery.leftOut", i18n.Plural(rec.Unknown, "replica"))))
+		}
+		b.WriteString("\n")
+		for j, p := range rec.Partitions {
+			if j == recoverySlowest {
+				b.WriteString(HelpStyle.Render("    " + i18n.T("recovery.more", len(rec.Partitions)-j)))
+				b.WriteString("\n")
+				break
+			}
+			done := i18n.T("recovery.neverDone")
+			if p.Done >= 0 {
+				done = "t+" + p.Done.String()
+			}
+			b.WriteString(fmt.Sprintf("    P%-4d %-9s %s %-10s %s\n", m.placementCfg.PartitionNumber(p.PartitionID), roleName(p.Role), i18n.T("recovery.from", p.Source), formatBytes(p.Bytes), done))
+		}
+	}
+
+	if returned.Done > 0 && returned.Unknown == 0 && returned.Done != m.leaderRebalance.CatchUpTime {
+		b.WriteString(WarnStyle.Render(i18n.T("recovery.catchUp", m.leaderRebalance.CatchUpTime, returned.Done)))
+		b.WriteString("\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("recovery.help")))
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/recording"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// replay is a recorded session being replayed: every key the viewer
+// presses plays its next step.
+type replay struct {
+	rec    *recording.Recording
+	source string // The recording's file, for the banner
+	next   int    // Index of the next step
+}
+
+// StartRecording records every key pressed from now on into rec, except
+// the one that quits. Placements use rec's seed so a replay shows the same
+// ones.
+func (m *Model) StartRecording(rec *recording.Recording) {
+	m.recording = rec
+	m.seed = rec.Seed
+}
+
+// Replay replays rec, read from source, one step per key the viewer
+// presses, with the recorded seed. Ctrl+C quits the replay; after the last
+// step the keys control the session again.
+func (m *Model) Replay(rec *recording.Recording, source string) {
+	m.replaying = &replay{rec: rec, source: source}
+	m.seed = rec.Seed
+}
+
+// replayStep plays the next recorded step as if its key was pressed.
+func (m Model) replayStep() (tea.Model, tea.Cmd) {
+	r := *m.replaying // A copy, so earlier models keep their position
+	step := r.rec.Steps[r.next]
+	r.next++
+	m.replaying = &r
+	return m.update(tea.KeyMsg{Type: tea.KeyType(step.Type), Runes: []rune(step.Runes), Alt: step.Alt, Paste: step.Paste})
+}
+
+// recordKey adds a pressed key to the recording.
+func (m Model) recordKey(key tea.KeyMsg) {
+	m.recording.Add(recording.Step{
+		Key:   key.String(),
+		Type:  int(key.Type),
+		Runes: string(key.Runes),
+		Alt:   key.Alt,
+		Paste: key.Paste,
+	})
+}
+
+// replayBanner renders where the replay is, under the title, or "" when
+// not replaying.
+func (m Model) replayBanner() string {
+	r := m.replaying
+	if r == nil {
+		return ""
+	}
+	total := len(r.rec.Steps)
+	var line string
+	switch {
+	case r.next == 0:
+		line = i18n.T("replay.start", r.source, r.rec.RecordedAt.Local().Format("2006-01-02 15:04"), i18n.Plural(total, "step"))
+	case r.next < total:
+		line = i18n.T("replay.step", r.next, total, r.rec.Steps[r.next-1].Key, r.rec.Steps[r.next-1].At)
+	default:
+		line = i18n.T("replay.finished", r.rec.Steps[total-1].Key, i18n.Plural(total, "step"))
+	}
+	return FocusedStyle.Render(line) + "\n"
+}
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/assignment"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// replicationIssue is a partition of the live assignment that is offline
+// or under-replicated.
+type replicationIssue struct {
+	partition assignment.Partition
+	offline   bool  // No leader
+	outOfSync []int // Replicas missing from the ISR
+}
+
+// replicationIssues lists the offline and under-replicated partitions of
+// the live assignment. Observers are never in the ISR and replicas an
+// in-flight reassignment is adding are still catching up, so neither
+// counts as out of sync.
+func (m Model) replicationIssues() []replicationIssue {
+	if !m.liveAssignment || m.liveData == nil {
+		return nil
+	}
+	var issues []replicationIssue
+	for _, p := range m.liveData.ForTopic(m.placementCfg.TopicName) {
+		issue := replicationIssue{partition: p, offline: p.Leader < 0}
+		for _, id := range p.Replicas {
+			if !slices.Contains(p.Isr, id) && !slices.Contains(p.Observers, id) && !slices.Contains(p.Adding, id) {
+				issue.outOfSync = append(issue.outOfSync, id)
+			}
+		}
+		if issue.offline || len(issue.outOfSync) > 0 {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// outOfSyncBrokers returns the brokers hosting an out-of-sync replica, to
+// highlight in the broker boxes.
+func (m Model) outOfSyncBrokers() map[int]bool {
+	brokers := make(map[int]bool)
+	for _, issue := range m.replicationIssues() {
+		for _, id := range issue.outOfSync {
+			brokers[id] = true
+		}
+	}
+	return brokers
+}
+
+// replicationView renders the panel of offline and under-replicated
+// partitions with their current ISR.
+func (m Model) replicationView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("replication.title")))
+	b.WriteString("\n")
+	issues := m.replicationIssues()
+	if len(issues) == 0 {
+		b.WriteString(PassStyle.Render(i18n.T("replication.ok")))
+		return b.String()
+	}
+	offline := 0
+	for _, issue := range issues {
+		if issue.offline {
+			offline++
+		}
+	}
+	b.WriteString(FailStyle.Render(i18n.T("replication.counts", offline, len(issues)-offline)))
+	b.WriteString("\n")
+	shown := issues[:min(len(issues), 20)]
+	for _, issue := range shown {
+		p := issue.partition
+		state := i18n.T("replication.underReplicated")
+		if issue.offline {
+			state = i18n.T("replication.offline")
+		}
+		line := i18n.T("replication.partition", m.placementCfg.PartitionNumber(p.Partition+1), state, joinInts(p.Isr), joinInts(p.Replicas))
+		if len(issue.outOfSync) > 0 {
+			line += i18n.T("replication.outOfSync", joinInts(issue.outOfSync))
+		}
+		style := ErrorStyle
+		if issue.offline {
+			style = FailStyle
+		}
+		b.WriteString(style.Render(line) + "\n")
+	}
+	if n := len(issues) - len(shown); n > 0 {
+		b.WriteString(i18n.T("replication.more", n) + "\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("replication.help")))
+	return b.String()
+}
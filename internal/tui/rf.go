@@ -0,0 +1,178 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// changeReplicationFactor shows the placement the shown one becomes when
+// its replication factor changes by delta, keeping the existing replicas in
+// place. The placement before the first change stays the diff base, so the
+// boxes show where replicas are added or removed and the export and
+// --waves write the reassignment plan. Getting back to the original
+// replication factor ends the what-if.
+func (m *Model) changeReplicationFactor(delta int) {
+	if m.stage != ShowPlacement {
+		return
+	}
+	base, baseCfg, live := m.rfBase, m.rfBaseCfg, m.rfBaseLive
+	if base == nil {
+		base, baseCfg, live = m.dcs, m.placementCfg, m.liveAssignment
+	}
+	rf := m.placementCfg.ReplicationFactor + delta
+	dcs, err := placement.ChangeReplicationFactor(baseCfg, base, rf)
+	if err != nil {
+		m.rfErr = err
+		return
+	}
+	m.rfErr = nil
+
+	cfg := m.placementCfg
+	cfg.ReplicationFactor = rf
+	m.replicationFactor = rf
+	if rf == baseCfg.ReplicationFactor {
+		m.setDiffBase(nil, "")
+		m.showPlacement(cfg, base, m.mrcRecommendation)
+		m.liveAssignment = live
+		return
+	}
+	m.setDiffBase(base, fmt.Sprintf("RF %d", baseCfg.ReplicationFactor))
+	m.showPlacement(cfg, dcs, m.mrcRecommendation)
+	m.rfBase, m.rfBaseCfg, m.rfBaseLive = base, baseCfg, live
+	m.colorMode = ColorByDiff
+}
+
+// tolerance is how many failures a placement survives.
+type tolerance struct {
+	Writes int // Broker failures before a partition drops below min ISR
+	Data   int // Broker failures before a partition loses its last replica
+	DCLoss bool
+}
+
+// failureTolerance measures the worst partition of a placement. Observers
+// keep data but don't count towards min ISR.
+func failureTolerance(dcs map[int]*config.DCInfo, minISR int) tolerance {
+	partitions := placement.Partitions(dcs)
+	if len(partitions) == 0 {
+		return tolerance{}
+	}
+	dcOf := make(map[int]int)
+	for _, dc := range dcs {
+		for id := range dc.Brokers {
+			dcOf[id] = dc.ID
+		}
+	}
+	t := tolerance{Writes: -1, Data: -1, DCLoss: len(dcs) > 1}
+	for _, p := range partitions {
+		isr := len(p.Brokers[config.Leader]) + len(p.Brokers[config.Follower])
+		replicas := isr + len(p.Brokers[config.Observer])
+		if w := max(isr-minISR, 0); t.Writes < 0 || w < t.Writes {
+			t.Writes = w
+		}
+		if t.Data < 0 || replicas-1 < t.Data {
+			t.Data = replicas - 1
+		}
+		inDC := make(map[int]bool)
+		for _, ids := range p.Brokers {
+			for _, id := range ids {
+				inDC[dcOf[id]] = true
+			}
+		}
+		if len(inDC) < 2 {
+			t.DCLoss = false
+		}
+	}
+	return t
+}
+
+// rfView renders the what-if replication factor change: where replicas are
+// added or removed, the data to copy, and the failure tolerance before and
+// after.
+func (m Model) rfView() string {
+	if m.rfErr != nil {
+		return ErrorStyle.Render(i18n.T("rf.error", m.rfErr)) + "\n"
+	}
+	if m.rfBase == nil {
+		return ""
+	}
+	from, to := m.rfBaseCfg.ReplicationFactor, m.placementCfg.ReplicationFactor
+	var b strings.Builder
+	b.WriteString(FocusedStyle.Bold(true).Render(i18n.T("rf.title", from, to)))
+	b.WriteString(HelpStyle.Render(i18n.T("rf.help")))
+	b.WriteString("\n")
+
+	added, removed := make(map[int]int), make(map[int]int)
+	var copied int64
+	for _, dc := range m.dcs {
+		for _, broker := range dc.Brokers {
+			for _, c := range brokerDiffChips(m.rfBase, broker) {
+				switch c.change {
+				case replicaAdded:
+					added[broker.ID]++
+					copied += m.placementCfg.PartitionLoads[c.replica.PartitionID].SizeBytes
+				case replicaRemoved:
+					removed[broker.ID]++
+				}
+			}
+		}
+	}
+	if len(added) > 0 {
+		b.WriteString("  " + i18n.T("rf.added", brokerCounts(added, "+")))
+		if len(m.placementCfg.PartitionLoads) > 0 {
+			b.WriteString(i18n.T("rf.copy", formatBytes(copied)))
+		} else {
+			b.WriteString(HelpStyle.Render(i18n.T("rf.copyUnknown")))
+		}
+		b.WriteString("\n")
+	}
+	if len(removed) > 0 {
+		b.WriteString("  " + i18n.T("rf.removed", brokerCounts(removed, "-")) + "\n")
+	}
+
+	before := failureTolerance(m.rfBase, m.rfBaseCfg.MinInSyncReplicas)
+	after := failureTolerance(m.dcs, m.placementCfg.MinInSyncReplicas)
+	line := "  " + i18n.T("rf.tolerance", before.Writes, after.Writes, before.Data, after.Data)
+	if len(m.dcs) > 1 {
+		line += i18n.T("rf.dcLoss", yesNo(before.DCLoss), yesNo(after.DCLoss))
+	}
+	switch {
+	case after.Writes < before.Writes || after.Data < before.Data || (before.DCLoss && !after.DCLoss):
+		b.WriteString(WarnStyle.Render(line))
+	case after.Writes > before.Writes || after.Data > before.Data || (after.DCLoss && !before.DCLoss):
+		b.WriteString(PassStyle.Render(line))
+	default:
+		b.WriteString(line)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// brokerCounts lists per-broker counts, e.g. "broker 1 +2, broker 4 +1".
+func brokerCounts(counts map[int]int, sign string) string {
+	ids := make([]int, 0, len(counts))
+	total := 0
+	for id, n := range counts {
+		ids = append(ids, id)
+		total += n
+	}
+	sort.Ints(ids)
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprintf("broker %d %s%d", id, sign, counts[id])
+	}
+	return fmt.Sprintf("%d (%s)", total, strings.Join(parts, ", "))
+}
+
+// yesNo formats a boolean for a before → after comparison.
+func yesNo(b bool) string {
+	if b {
+		return i18n.T("rf.yes")
+	}
+	return i18n.T("rf.no")
+}
@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// rollingView renders a rolling restart of every broker with a controlled
+// shutdown and with a hard kill side by side: how long partitions are
+// leaderless while each broker's leaderships move.
+func (m Model) rollingView() string {
+	r := m.leaderRebalance
+	modes := []failover.ShutdownMode{failover.ControlledShutdown, failover.HardKill}
+	restarts := make([]failover.RollingRestart, len(modes))
+	for i, mode := range modes {
+		restarts[i] = failover.SimulateRollingRestart(m.dcs, m.quorum(), m.failoverTiming, r, mode)
+	}
+
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("rolling.title", i18n.Plural(len(restarts[0].Steps), "broker"), r.RestartTime, r.CatchUpTime)))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%-20s %-22s %-26s %s\n", i18n.T("rolling.col.shutdown"), i18n.T("rolling.col.worst"),
+		i18n.T("rolling.col.unavailable"), i18n.T("rolling.col.takes")))
+	for i, mode := range modes {
+		rr := restarts[i]
+		b.WriteString(fmt.Sprintf("%-20s %-22s %-26s %s\n", i18n.T("rolling.mode."+mode.String()), rr.Worst.Round(time.Millisecond), rr.Unavailable.Round(time.Millisecond), rr.Duration.Round(time.Second)))
+	}
+
+	controlled, killed := restarts[0], restarts[1]
+	if killed.Unavailable > controlled.Unavailable {
+		b.WriteString(PassStyle.Render(i18n.T("rolling.saves",
+			(killed.Unavailable-controlled.Unavailable).Round(time.Millisecond), m.failoverTiming.SessionTimeout)) + "\n")
+	}
+	var offline []string
+	for _, s := range controlled.Steps {
+		if len(s.Offline) > 0 {
+			offline = append(offline, i18n.T("rolling.offlineBroker", s.BrokerID, i18n.Plural(len(s.Offline), "partition")))
+		}
+	}
+	if len(offline) > 0 {
+		b.WriteString(FailStyle.Render(i18n.T("rolling.offline", strings.Join(offline, ", "))) + "\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("rolling.help")))
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/screenshot"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetScreenshots sets the directory Ctrl+S saves screenshots of the
+// current view to ("" for the current directory), and their format.
+func (m *Model) SetScreenshots(dir string, format screenshot.Format) {
+	m.screenshotDir, m.screenshotFormat = dir, format
+}
+
+// takeScreenshot saves the current view under a caption saying what it
+// shows, and notes where it went.
+func (m *Model) takeScreenshot() {
+	format := m.screenshotFormat
+	if format == "" {
+		format = screenshot.FormatSVG
+	}
+	screen := screenshot.Screen{View: m.View(), Width: m.width, Dark: lipgloss.HasDarkBackground()}
+	path, err := screenshot.WriteFile(m.screenshotDir, format, screen, m.screenshotCaption(time.Now()))
+	if err != nil {
+		applog.Warnf("%s", i18n.T("screenshot.logFailed", err))
+		m.screenshotSaved = ""
+		return
+	}
+	applog.Infof("%s", i18n.T("screenshot.logSaved", path))
+	m.screenshotSaved = path
+}
+
+// screenshotCaption describes the current view for a screenshot taken at
+// the given time.
+func (m Model) screenshotCaption(at time.Time) screenshot.Caption {
+	cfg := m.placementCfg
+	name := m.clusterKey()
+	if m.scenario != nil {
+		name = m.scenario.Name
+	}
+	topic := i18n.T("screenshot.topic")
+	if cfg.TopicName != "" {
+		topic = i18n.T("screenshot.topicNamed", cfg.TopicName)
+	}
+	if len(m.topics) > 1 {
+		topic = i18n.T("screenshot.topicOf", topic, len(m.topics))
+	}
+	c := screenshot.Caption{
+		Scenario: i18n.T("screenshot.scenario", name, topic, i18n.Plural(cfg.NumPartitions, "partition"), cfg.ReplicationFactor, cfg.MinInSyncReplicas),
+		TakenAt:  at,
+		Failure:  m.simulatedFailure(),
+	}
+	if len(m.dcs) > 0 {
+		r := m.health()
+		c.Health = i18n.T("screenshot.health", r.Score, healthSummary(r))
+	}
+	return c
+}
+
+// simulatedFailure describes the failures the panes shown simulate, or ""
+// when none is shown.
+func (m Model) simulatedFailure() string {
+	var failures []string
+	if m.showFailover {
+		if m.brokerSelected {
+			failures = append(failures, i18n.T("screenshot.broker", m.selectedBroker))
+		} else {
+			failures = append(failures, i18n.T("screenshot.eachBroker"))
+		}
+		if dc, ok := m.dcs[m.lostDC]; ok {
+			failures = append(failures, i18n.T("screenshot.dcLost", dc.Rack()))
+		}
+	}
+	if m.showISR {
+		events := i18n.T("screenshot.defaults")
+		if len(m.isrEvents) > 0 {
+			events = joinStrings(m.isrEvents)
+		}
+		failures = append(failures, i18n.T("screenshot.isr", events))
+	}
+	if m.showTimeline {
+		events := i18n.T("screenshot.defaults")
+		if len(m.timelineEvents) > 0 {
+			events = joinStrings(m.timelineEvents)
+		}
+		failures = append(failures, i18n.T("screenshot.timeline", events))
+	}
+	if m.showSurvivability {
+		failures = append(failures, i18n.T("screenshot.matrix"))
+	}
+	return strings.Join(failures, "; ")
+}
+
+// joinStrings joins the string forms of events with commas.
+func joinStrings[T fmt.Stringer](events []T) string {
+	s := make([]string, len(events))
+	for i, e := range events {
+		s[i] = e.String()
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/assignment"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// snapshotListLines is how many snapshots the snapshot pane lists around
+// the compared one.
+const snapshotListLines = 8
+
+// snapshotChangeLines is how many changed partitions the snapshot pane
+// lists for the compared snapshot.
+const snapshotChangeLines = 10
+
+// SetSnapshots saves the watched live assignment to dir every interval
+// while it changes, and lists the snapshots saved there before, e.g. by
+// yesterday's session, for comparing with the live one. An interval of 0
+// only lists them. Call it after WatchAssignment.
+func (m *Model) SetSnapshots(dir string, every time.Duration) {
+	m.snapshotDir, m.snapshotEvery = dir, every
+	m.snapshotIndex = -1
+	m.loadSnapshots()
+	m.takeSnapshot()
+}
+
+// loadSnapshots lists the snapshots of the live topic, oldest first.
+func (m *Model) loadSnapshots() {
+	snapshots, err := assignment.Snapshots(m.snapshotDir, m.placementCfg.TopicName)
+	if err != nil {
+		applog.Warnf("%v", err)
+	}
+	m.snapshots = snapshots
+}
+
+// takeSnapshot saves the live assignment once the interval has passed since
+// the last snapshot, unless it is the same as the latest one.
+func (m *Model) takeSnapshot() {
+	if m.snapshotEvery <= 0 || m.liveData == nil {
+		return
+	}
+	now := time.Now()
+	if n := len(m.snapshots); n > 0 {
+		latest := m.snapshots[n-1]
+		if now.Sub(latest.TakenAt) < m.snapshotEvery || now.Sub(m.snapshotChecked) < m.snapshotEvery {
+			return
+		}
+		m.snapshotChecked = now
+		if data, err := latest.Load(); err == nil && len(assignment.Changes(data, m.liveData, m.placementCfg.TopicName)) == 0 {
+			return // Nothing changed since
+		}
+	}
+	s, err := assignment.SaveSnapshot(m.snapshotDir, m.liveData, m.placementCfg.TopicName, now)
+	if err != nil {
+		applog.Errorf("%v", err)
+		m.snapshotEvery = 0 // Rather than failing again on every poll
+		return
+	}
+	applog.Infof("%s", i18n.T("snapshots.saved", s.Topic, s.Path))
+	m.snapshots = append(m.snapshots, s)
+	if m.snapshotIndex >= 0 {
+		m.snapshotIndex = min(m.snapshotIndex, len(m.snapshots)-1)
+	}
+}
+
+// stepSnapshot compares the live assignment with the next older (delta -1)
+// or newer (delta 1) snapshot, shown in the by-changes coloring.
+func (m *Model) stepSnapshot(delta int) {
+	if len(m.snapshots) == 0 {
+		return
+	}
+	i := m.snapshotIndex
+	if i < 0 {
+		i = len(m.snapshots) // Start from the newest
+	}
+	i = max(0, min(len(m.snapshots)-1, i+delta))
+	s := m.snapshots[i]
+	data, err := s.Load()
+	if err == nil {
+		cfg := m.placementCfg
+		cfg.NumPartitions = len(data.ForTopic(cfg.TopicName))
+		dcs := placement.Topology(cfg)
+		if err = data.Apply(dcs, cfg.TopicName); err == nil {
+			m.snapshotIndex, m.snapshotData, m.snapshotErr = i, data, nil
+			m.setDiffBase(dcs, i18n.T("snapshots.base", s.TakenAt.Local().Format("2006-01-02 15:04")))
+			m.colorMode = ColorByDiff
+			return
+		}
+	}
+	m.snapshotIndex, m.snapshotData = i, nil
+	m.snapshotErr = fmt.Errorf("%s: %w", i18n.T("snapshots.loadError", s.Path), err)
+}
+
+// snapshotsView renders the snapshots of the live topic and what changed
+// since the compared one.
+func (m Model) snapshotsView() string {
+	var b strings.Builder
+	topic := m.placementCfg.TopicName
+	b.WriteString(DCHeaderStyle.Render(i18n.T("snapshots.title", topic, len(m.snapshots), m.snapshotDir)) + "\n")
+	if m.snapshotEvery > 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("snapshots.every", m.snapshotEvery)) + "\n")
+	}
+	if len(m.snapshots) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("snapshots.none")))
+		return b.String()
+	}
+
+	var first int
+	if m.snapshotIndex >= 0 {
+		first = m.snapshotIndex - snapshotListLines/2
+	} else {
+		first = len(m.snapshots) - snapshotListLines
+	}
+	first = max(0, min(first, len(m.snapshots)-snapshotListLines))
+	if first > 0 {
+		b.WriteString(HelpStyle.Render("  "+i18n.T("snapshots.older", i18n.Plural(first, "older snapshot"))) + "\n")
+	}
+	now := time.Now()
+	for i := first; i < min(len(m.snapshots), first+snapshotListLines); i++ {
+		s := m.snapshots[i]
+		line := fmt.Sprintf("%s  (%s)", s.TakenAt.Local().Format("2006-01-02 15:04:05"), ago(now.Sub(s.TakenAt)))
+		if i == m.snapshotIndex {
+			b.WriteString(FocusedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+
+	switch {
+	case m.snapshotErr != nil:
+		b.WriteString(ErrorStyle.Render(m.snapshotErr.Error()) + "\n")
+	case m.snapshotData != nil:
+		changes := assignment.Changes(m.snapshotData, m.liveData, topic)
+		if len(changes) == 0 {
+			b.WriteString(PassStyle.Render(i18n.T("snapshots.same")) + "\n")
+		} else {
+			b.WriteString(WarnStyle.Render(i18n.T("snapshots.changed", i18n.Plural(len(changes), "partition"))) + "\n")
+			for _, c := range changes[:min(len(changes), snapshotChangeLines)] {
+				b.WriteString(fmt.Sprintf("  p%d: %s\n", m.placementCfg.PartitionNumber(c.Partition+1), c.Reason))
+			}
+			if n := len(changes) - snapshotChangeLines; n > 0 {
+				b.WriteString(HelpStyle.Render("  "+i18n.T("list.more", n)) + "\n")
+			}
+		}
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("snapshots.help")))
+	return b.String()
+}
+
+// ago describes how long ago something happened, to the minute, hour or day.
+func ago(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return i18n.T("ago.now")
+	case d < time.Hour:
+		return i18n.T("ago.minutes", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return i18n.T("ago.hours", int(d.Hours()))
+	}
+	return i18n.T("ago.days", int(d.Hours()/24))
+}
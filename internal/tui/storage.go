@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+const (
+	storageBarWidth = 50 // Cells of the stacked disk bar in the broker modal
+	storageTopics   = 6  // Topics listed one by one; the rest are summed up
+	// hotBrokerRatio is how far above the average broker a broker's disk
+	// usage has to be for the breakdown to call it hot.
+	hotBrokerRatio = 1.2
+)
+
+// storageGlyphs tell the segments of the stacked bar apart without color.
+var storageGlyphs = []string{"█", "▓", "▒", "░"}
+
+// topicStorage is what one topic stores on a broker.
+type topicStorage struct {
+	Topic    string
+	Replicas int
+	Bytes    int64
+	// AvgBytes is the topic's bytes on an average broker, for telling a
+	// topic skewed onto this broker from one that is just large.
+	AvgBytes float64
+}
+
+// brokerStorage breaks a broker's disk usage down by topic, largest first,
+// over every placed topic. It also returns the average broker's total. nil
+// when no partition sizes are known.
+func (m Model) brokerStorage(id int) (topics []topicStorage, avgBytes float64) {
+	var brokers int
+	for _, dc := range m.dcs {
+		brokers += len(dc.Brokers)
+	}
+	if brokers == 0 {
+		return nil, 0
+	}
+	var total int64
+	for _, t := range m.placedTopics() {
+		ts := topicStorage{Topic: t.cfg.TopicName}
+		if ts.Topic == "" {
+			ts.Topic = "-"
+		}
+		var topicBytes int64
+		for _, dc := range t.dcs {
+			for _, broker := range dc.Brokers {
+				for _, r := range broker.Replicas {
+					size := t.cfg.PartitionLoads[r.PartitionID].SizeBytes
+					topicBytes += size
+					if broker.ID == id {
+						ts.Replicas++
+						ts.Bytes += size
+					}
+				}
+			}
+		}
+		total += topicBytes
+		ts.AvgBytes = float64(topicBytes) / float64(brokers)
+		if ts.Replicas > 0 {
+			topics = append(topics, ts)
+		}
+	}
+	if total == 0 {
+		return nil, 0
+	}
+	sort.SliceStable(topics, func(i, j int) bool { return topics[i].Bytes > topics[j].Bytes })
+	return topics, float64(total) / float64(brokers)
+}
+
+// storageView renders the broker's disk usage as a bar stacked by topic
+// with one row per topic, and, for a hot broker, the topic that dominates
+// it. Empty without partition sizes.
+func (m Model) storageView(id int) string {
+	topics, avg := m.brokerStorage(id)
+	if topics == nil {
+		return ""
+	}
+	if len(topics) > storageTopics {
+		rest := topicStorage{Topic: i18n.T("storage.others", len(topics)-storageTopics+1)}
+		for _, t := range topics[storageTopics-1:] {
+			rest.Replicas += t.Replicas
+			rest.Bytes += t.Bytes
+			rest.AvgBytes += t.AvgBytes
+		}
+		topics = append(topics[:storageTopics-1:storageTopics-1], rest)
+	}
+	var total int64
+	for _, t := range topics {
+		total += t.Bytes
+	}
+
+	var b strings.Builder
+	cells := make([]int, len(topics))
+	used := 0
+	for i, t := range topics {
+		cells[i] = int(float64(t.Bytes) / float64(total) * storageBarWidth)
+		used += cells[i]
+	}
+	cells[0] += storageBarWidth - used // Rounding leftovers go to the largest segment
+	var bar strings.Builder
+	for i := range topics {
+		bar.WriteString(storageStyle(i).Render(strings.Repeat(storageGlyphs[i%len(storageGlyphs)], cells[i])))
+	}
+	b.WriteString(i18n.T("storage.title", bar.String(), formatBytes(total)) + "\n")
+	for i, t := range topics {
+		size, pct := formatBytes(t.Bytes), fmt.Sprintf("%5.1f%%", float64(t.Bytes)*100/float64(total))
+		if t.AvgBytes == 0 {
+			size, pct = i18n.T("mirror.unknown"), "     -" // A topic without partition sizes
+		}
+		line := fmt.Sprintf("  %s %s %-10s %s  %s",
+			storageStyle(i).Render(storageGlyphs[i%len(storageGlyphs)]), padRight(t.Topic, 24), size, pct, i18n.Plural(t.Replicas, "replica"))
+		if t.AvgBytes > 0 {
+			line += HelpStyle.Render(i18n.T("storage.ratio", float64(t.Bytes)/t.AvgBytes))
+		}
+		b.WriteString(line + "\n")
+	}
+
+	if float64(total) > avg*hotBrokerRatio {
+		// The topic adding the most bytes above its average share makes the broker hot
+		top := topics[0]
+		for _, t := range topics {
+			if float64(t.Bytes)-t.AvgBytes > float64(top.Bytes)-top.AvgBytes {
+				top = t
+			}
+		}
+		b.WriteString(WarnStyle.Render(i18n.T("storage.hot",
+			(float64(total)/avg-1)*100, formatBytes(int64(avg)), top.Topic, formatBytes(top.Bytes-int64(top.AvgBytes)))))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// storageLines returns the number of lines storageView renders for the
+// broker, for sizing the modal's replica page.
+func (m Model) storageLines(id int) int {
+	return strings.Count(m.storageView(id), "\n")
+}
+
+// storageStyle colors the i-th segment of the stacked bar. The DC palette
+// is reused, as the modal shows a single broker in a single DC.
+func storageStyle(i int) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(dcPalette[i%len(dcPalette)])
+}
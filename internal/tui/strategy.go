@@ -0,0 +1,287 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// strategyDescriptions are the catalog keys of the descriptions shown under
+// the strategy selector.
+var strategyDescriptions = map[config.Strategy]string{
+	config.StrategyRandom:         "strategy.random",
+	config.StrategySizeAware:      "strategy.sizeAware",
+	config.StrategyGoals:          "strategy.goals",
+	config.StrategyClientAffinity: "strategy.clientAffinity",
+	config.StrategyKafkaDefault:   "strategy.kafkaDefault",
+	config.StrategyRackAware:      "strategy.rackAware",
+}
+
+// strategyParam is one tunable of the strategy parameters form. The zero
+// value of every session option is its default.
+type strategyParam struct {
+	label      string            // Catalog key
+	strategies []config.Strategy // Strategies the parameter applies to
+	numeric    bool
+	def        string
+	value      func(m Model) string
+	apply      func(m *Model, value string) error
+}
+
+var strategyParams = []strategyParam{
+	{
+		label:      "strategy.param.rackStrictness",
+		strategies: []config.Strategy{config.StrategyRandom, config.StrategySizeAware, config.StrategyClientAffinity},
+		numeric:    true,
+		def:        "1",
+		value:      func(m Model) string { return boolDigit(!m.looseRacks) },
+		apply: func(m *Model, value string) error {
+			strict, err := parseBoolDigit(value)
+			m.looseRacks = !strict
+			return err
+		},
+	},
+	{
+		label:      "strategy.param.leaderSpread",
+		strategies: []config.Strategy{config.StrategySizeAware},
+		numeric:    true,
+		def:        "1",
+		value:      func(m Model) string { return boolDigit(!m.noLeaderSpread) },
+		apply: func(m *Model, value string) error {
+			spread, err := parseBoolDigit(value)
+			m.noLeaderSpread = !spread
+			return err
+		},
+	},
+	{
+		label:      "strategy.param.goals",
+		strategies: []config.Strategy{config.StrategyGoals},
+		value:      func(m Model) string { return strings.Join(m.goals, ",") },
+		apply: func(m *Model, value string) error {
+			var goals []string
+			for _, g := range strings.Split(value, ",") {
+				if g = strings.TrimSpace(g); g != "" {
+					goals = append(goals, g)
+				}
+			}
+			if _, err := placement.ResolveScorers(goals); err != nil {
+				return err
+			}
+			m.goals = goals
+			return nil
+		},
+	},
+	{
+		label:      "strategy.param.maxMoves",
+		strategies: []config.Strategy{config.StrategyGoals},
+		numeric:    true,
+		def:        "0",
+		value:      func(m Model) string { return strconv.Itoa(m.maxMoves) },
+		apply: func(m *Model, value string) error {
+			moves, err := strconv.Atoi(value)
+			if err != nil || moves < 0 {
+				return errors.New(i18n.T("strategy.param.maxMoves.invalid"))
+			}
+			m.maxMoves = moves
+			return nil
+		},
+	},
+}
+
+// paramsFor returns the parameters of a strategy.
+func paramsFor(s config.Strategy) []strategyParam {
+	var params []strategyParam
+	for _, p := range strategyParams {
+		for _, ps := range p.strategies {
+			if ps == s {
+				params = append(params, p)
+			}
+		}
+	}
+	return params
+}
+
+func boolDigit(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func parseBoolDigit(value string) (bool, error) {
+	switch value {
+	case "0":
+		return false, nil
+	case "1":
+		return true, nil
+	}
+	return false, errors.New(i18n.T("strategy.param.notDigit", value))
+}
+
+// openStrategyForm switches to the strategy parameters form for the current
+// strategy.
+func (m *Model) openStrategyForm() tea.Cmd {
+	m.paramStrategy = m.strategy
+	m.setupParamInputs(false)
+	m.paramFocus = 0
+	m.err = nil
+	m.stage = AskStrategy
+	return m.focusParam()
+}
+
+// setupParamInputs creates the inputs for the selected strategy's
+// parameters, filled with the current values or the defaults.
+func (m *Model) setupParamInputs(defaults bool) {
+	params := paramsFor(m.paramStrategy)
+	m.paramInputs = make([]textinput.Model, len(params))
+	for i, p := range params {
+		m.paramInputs[i] = textinput.New()
+		m.paramInputs[i].Cursor.Style = CursorStyle
+		m.paramInputs[i].Width = 60
+		if p.numeric {
+			m.paramInputs[i].CharLimit = 5
+			m.paramInputs[i].Validate = isNumber
+		}
+		value := p.value(*m)
+		if defaults {
+			value = p.def
+		}
+		m.paramInputs[i].SetValue(value)
+	}
+}
+
+// focusParam focuses the current row of the form. Row 0 is the strategy
+// selector, the inputs follow.
+func (m *Model) focusParam() tea.Cmd {
+	var cmd tea.Cmd
+	for i := range m.paramInputs {
+		if i == m.paramFocus-1 {
+			cmd = m.paramInputs[i].Focus()
+			m.paramInputs[i].PromptStyle = FocusedStyle
+			m.paramInputs[i].TextStyle = FocusedStyle
+		} else {
+			m.paramInputs[i].Blur()
+			m.paramInputs[i].PromptStyle = NoStyle
+			m.paramInputs[i].TextStyle = NoStyle
+		}
+	}
+	return cmd
+}
+
+// updateStrategyForm handles keys in the strategy parameters form.
+func (m Model) updateStrategyForm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows := len(m.paramInputs) + 1
+	switch msg.String() {
+	case "ctrl+c":
+		return m.quit()
+	case "esc":
+		m.stage = ShowPlacement
+		m.err = nil
+		return m, nil
+	case "enter":
+		nm := m // Apply to a copy so a bad value changes nothing
+		nm.strategy = m.paramStrategy
+		for i, p := range paramsFor(m.paramStrategy) {
+			if err := p.apply(&nm, strings.TrimSpace(m.paramInputs[i].Value())); err != nil {
+				m.err = err
+				return m, nil
+			}
+		}
+		nm.err = nil
+		nm.replaceWithOptions()
+		return nm, nil
+	case "ctrl+r":
+		m.setupParamInputs(true)
+	case "tab", "down":
+		m.paramFocus = (m.paramFocus + 1) % rows
+	case "shift+tab", "up":
+		m.paramFocus = (m.paramFocus - 1 + rows) % rows
+	case "left", "right":
+		if m.paramFocus != 0 {
+			break // Moves the cursor of the focused input
+		}
+		strategies := config.Strategies()
+		delta := 1
+		if msg.String() == "left" {
+			delta = len(strategies) - 1
+		}
+		for i, s := range strategies {
+			if s == m.paramStrategy {
+				m.paramStrategy = strategies[(i+delta)%len(strategies)]
+				break
+			}
+		}
+		m.setupParamInputs(false)
+		return m, nil
+	}
+	if m.paramFocus == 0 {
+		return m, m.focusParam()
+	}
+	cmd := m.focusParam()
+	var inputCmd tea.Cmd
+	m.paramInputs[m.paramFocus-1], inputCmd = m.paramInputs[m.paramFocus-1].Update(msg)
+	return m, tea.Batch(cmd, inputCmd)
+}
+
+// replaceWithOptions places the current topics again on the same brokers
+// with the session options, e.g. after the strategy changed.
+func (m *Model) replaceWithOptions() {
+	if len(m.topics) > 0 {
+		m.saveActiveTopic()
+		specs := make([]config.TopicSpec, len(m.topics))
+		for i, t := range m.topics {
+			specs[i] = t.cfg.TopicSpec()
+		}
+		if err := m.placeTopics(m.placementCfg, specs); err != nil {
+			m.err = err
+			m.stage = ShowError
+		}
+		return
+	}
+	m.setDiffBase(m.dcs, i18n.T("strategy.previous", m.placementCfg.Strategy))
+	cfg := m.withSessionOptions(m.placementCfg)
+	dcs, recommendation := m.placements.CalculatePlacement(cfg)
+	m.showPlacement(cfg, dcs, recommendation)
+}
+
+// strategyFormView renders the strategy parameters form.
+func (m Model) strategyFormView() string {
+	var b strings.Builder
+	b.WriteString(i18n.T("strategy.title") + "\n\n")
+	b.WriteString(i18n.T("strategy.selector") + "\n")
+	selector := fmt.Sprintf("< %s >", m.paramStrategy)
+	if m.paramFocus == 0 {
+		selector = FocusedStyle.Render("> " + selector)
+	} else {
+		selector = "  " + selector
+	}
+	b.WriteString(selector + "\n")
+	b.WriteString(HelpStyle.Render("  "+i18n.T(strategyDescriptions[m.paramStrategy])) + "\n")
+
+	params := paramsFor(m.paramStrategy)
+	for i, input := range m.paramInputs {
+		b.WriteString("\n" + i18n.T(params[i].label) + "\n")
+		b.WriteString(input.View() + "\n")
+	}
+	if len(params) == 0 {
+		b.WriteString("\n" + HelpStyle.Render(i18n.T("strategy.noParams")) + "\n")
+	}
+
+	if m.err != nil {
+		b.WriteString("\n")
+		b.WriteString(ErrorStyle.Render(i18n.T("error.title", m.err)))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(HelpStyle.Render(i18n.T("strategy.help")))
+	return b.String()
+}
@@ -1,6 +1,10 @@
 package tui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 // Package tui contains all the Bubble Tea related code for the
 // terminal user interface, including the model, update logic, view rendering,
@@ -9,37 +13,142 @@ import "github.com/charmbracelet/lipgloss"
 // --- Styles ---
 // Define lipgloss styles for the TUI elements. Exported so they can be used
 // by the view logic (potentially in view.go).
+//
+// Colors are adaptive: lipgloss picks the Light or Dark variant based on the
+// detected terminal background (see SetTheme to override the detection).
 
 var (
-	TitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FAFAFA")).
-			Background(lipgloss.Color("#7D56F4")).
-			Padding(0, 1)
-
-	FocusedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
-	BlurredStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
-	CursorStyle  = FocusedStyle.Copy()
+	titleColor   = lipgloss.AdaptiveColor{Light: "#5A3FC0", Dark: "#7D56F4"}
+	focusColor   = lipgloss.AdaptiveColor{Light: "162", Dark: "205"}
+	blurredColor = lipgloss.AdaptiveColor{Light: "245", Dark: "240"}
+	borderColor  = lipgloss.AdaptiveColor{Light: "56", Dark: "63"} // Purple border
+
+	// Replica Colors (darker shades on light backgrounds, where bright
+	// yellow in particular is unreadable)
+	leaderColor   = lipgloss.AdaptiveColor{Light: "#008700", Dark: "#00FF00"} // Green
+	followerColor = lipgloss.AdaptiveColor{Light: "#AF8700", Dark: "#FFFF00"} // Yellow
+	observerColor = lipgloss.AdaptiveColor{Light: "#D70000", Dark: "#FF0000"} // Red
+
+	errorColor = lipgloss.AdaptiveColor{Light: "#D70000", Dark: "#FF5555"}
+
+	// Data center colors for the color-by-DC mode, chosen to stay apart
+	// from each other on both backgrounds
+	dcPalette = []lipgloss.AdaptiveColor{
+		{Light: "#005FD7", Dark: "#5FAFFF"}, // Blue
+		{Light: "#D75F00", Dark: "#FFAF5F"}, // Orange
+		{Light: "#8700AF", Dark: "#D787FF"}, // Purple
+		{Light: "#008787", Dark: "#5FD7D7"}, // Teal
+		{Light: "#AF005F", Dark: "#FF87AF"}, // Pink
+		{Light: "#5F8700", Dark: "#AFD75F"}, // Olive
+	}
+
+	// Heatmap backgrounds from well below to well above the cluster average
+	heatPalette = []lipgloss.AdaptiveColor{
+		{Light: "#FFFFFF", Dark: "#1C1C1C"},
+		{Light: "#FDE2E2", Dark: "#3A1F1F"},
+		{Light: "#F9BDBD", Dark: "#5C2626"},
+		{Light: "#F29191", Dark: "#872D2D"},
+		{Light: "#E86464", Dark: "#B33636"},
+	}
+
+	// Broker box borders: normal, with a replica out of sync, and selected
+	boxBorder       = lipgloss.RoundedBorder()
+	outOfSyncBorder = lipgloss.DoubleBorder()
+	selectedBorder  = lipgloss.ThickBorder()
+)
+
+// The styles are derived from the colors and borders above by buildStyles,
+// so a terminal with fewer colors can swap those and rebuild them.
+var (
+	TitleStyle   lipgloss.Style
+	FocusedStyle lipgloss.Style
+	BlurredStyle lipgloss.Style
+	CursorStyle  lipgloss.Style
 	NoStyle      = lipgloss.NewStyle()
 
-	HelpStyle = BlurredStyle.Copy()
+	HelpStyle lipgloss.Style
+
+	LeaderStyle   lipgloss.Style
+	FollowerStyle lipgloss.Style
+	ObserverStyle lipgloss.Style
+
+	// Consumer lag overlay, layered on top of the replica role colors
+	LagHotStyle   = lipgloss.NewStyle().Bold(true).Underline(true)
+	LagStuckStyle = lipgloss.NewStyle().Bold(true).Reverse(true)
 
-	// Replica Colors
-	leaderColor   = lipgloss.Color("#00FF00") // Green
-	followerColor = lipgloss.Color("#FFFF00") // Yellow
-	observerColor = lipgloss.Color("#FF0000") // Red
+	TopicTabStyle = lipgloss.NewStyle().Bold(true).Reverse(true) // The shown topic in the tab bar
 
-	LeaderStyle   = lipgloss.NewStyle().Foreground(leaderColor)
+	// Validation rule results
+	PassStyle lipgloss.Style
+	FailStyle lipgloss.Style
+
+	ErrorStyle lipgloss.Style // Red for errors
+	WarnStyle  lipgloss.Style // Yellow for live form warnings
+
+	DCHeaderStyle  = lipgloss.NewStyle().Bold(true).MarginBottom(1)
+	BrokerBoxStyle lipgloss.Style
+)
+
+func init() {
+	buildStyles()
+}
+
+// buildStyles (re)creates the styles from the current colors and borders.
+func buildStyles() {
+	TitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("#FAFAFA")).
+		Background(titleColor).
+		Padding(0, 1)
+	FocusedStyle = lipgloss.NewStyle().Foreground(focusColor)
+	BlurredStyle = lipgloss.NewStyle().Foreground(blurredColor)
+	CursorStyle = FocusedStyle.Copy()
+	HelpStyle = BlurredStyle.Copy()
+
+	LeaderStyle = lipgloss.NewStyle().Foreground(leaderColor)
 	FollowerStyle = lipgloss.NewStyle().Foreground(followerColor)
 	ObserverStyle = lipgloss.NewStyle().Foreground(observerColor)
 
-	ErrorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5555")) // Red for errors
+	PassStyle = lipgloss.NewStyle().Foreground(leaderColor)
+	FailStyle = lipgloss.NewStyle().Foreground(errorColor).Bold(true)
+	ErrorStyle = lipgloss.NewStyle().Foreground(errorColor)
+	WarnStyle = lipgloss.NewStyle().Foreground(followerColor)
 
-	DCHeaderStyle  = lipgloss.NewStyle().Bold(true).MarginBottom(1)
 	BrokerBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("63")). // Purple border
-			Padding(0, 1).
-			MarginRight(2).
-			MarginBottom(1)
-)
+		Border(boxBorder).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		MarginRight(2).
+		MarginBottom(1)
+
+	diffAddedStyle = lipgloss.NewStyle().Foreground(leaderColor)
+	diffRemovedStyle = lipgloss.NewStyle().Foreground(errorColor).Strikethrough(true)
+	diffChangedStyle = lipgloss.NewStyle().Foreground(followerColor)
+}
+
+// DCColor returns the color of a data center in the color-by-DC mode.
+// Colors repeat once the palette is exhausted.
+func DCColor(dcID int) lipgloss.AdaptiveColor {
+	if dcID < 0 {
+		dcID = -dcID
+	}
+	return dcPalette[dcID%len(dcPalette)]
+}
+
+// SetTheme chooses which variant of the adaptive colors is used. "auto"
+// queries the terminal background now, before Bubble Tea takes over the
+// terminal and the query could interfere with input handling.
+func SetTheme(theme string) error {
+	switch theme {
+	case "", "auto":
+		lipgloss.SetHasDarkBackground(lipgloss.HasDarkBackground())
+	case "light":
+		lipgloss.SetHasDarkBackground(false)
+	case "dark":
+		lipgloss.SetHasDarkBackground(true)
+	default:
+		// Flag errors are not translated, like the rest of main's
+		return fmt.Errorf("unknown theme %q (expected auto, light or dark)", theme)
+	}
+	return nil
+}
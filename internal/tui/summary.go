@@ -0,0 +1,211 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// accessibleView renders the placement as structured prose for screen
+// readers: no box drawing, no color-only information, and every role
+// spelled out. It is shown instead of the broker boxes in accessible mode.
+func (m Model) accessibleView() string {
+	var b strings.Builder
+	cfg := m.placementCfg
+
+	dcIDs := sortedDCIDs(m.dcs)
+	totalBrokers := 0
+	for _, dc := range m.dcs {
+		totalBrokers += len(dc.Brokers)
+	}
+
+	// --- Overview ---
+	b.WriteString(i18n.T("summary.title") + "\n")
+	if m.source != "" {
+		b.WriteString(m.source + ".\n")
+	}
+	if meta := m.clusterMeta; m.liveAssignment && meta != nil {
+		b.WriteString(i18n.T("summary.cluster", meta.Name, orUnknown(meta.ID), orUnknown(meta.KafkaVersion), orUnknown(meta.Controller)) + "\n")
+		if racks := meta.racks(); racks != "" {
+			b.WriteString(i18n.T("summary.racks", strings.ReplaceAll(racks, " · ", "; ")) + "\n")
+		}
+	}
+	if m.scenario != nil {
+		b.WriteString(i18n.T("summary.scenario", m.scenario.Name, m.scenario.Notes) + "\n")
+	}
+	for _, note := range m.annotations {
+		switch {
+		case note.Partition != nil:
+			if note.Topic == cfg.TopicName {
+				b.WriteString(i18n.T("summary.notePartition", m.placementCfg.PartitionNumber(*note.Partition), note.Text) + "\n")
+			}
+		case note.Broker != nil:
+			b.WriteString(i18n.T("summary.noteBroker", *note.Broker, note.Text) + "\n")
+		default:
+			b.WriteString(i18n.T("summary.note", note.Text) + "\n")
+		}
+	}
+	topic := i18n.T("summary.theTopic")
+	if cfg.TopicName != "" {
+		topic = i18n.T("summary.topic", cfg.TopicName)
+	}
+	groups := i18n.Plural(len(dcIDs), "data center")
+	if cfg.RackAware() {
+		groups = i18n.Plural(len(dcIDs), "rack")
+	}
+	b.WriteString(i18n.T("summary.placement", topic, i18n.Plural(totalBrokers, "broker"), groups,
+		i18n.Plural(cfg.NumPartitions, "partition"), cfg.ReplicationFactor, cfg.MinInSyncReplicas, cfg.Strategy) + "\n")
+	if m.clusterType == config.MRC && m.mrcRecommendation != "" {
+		b.WriteString(i18n.T("summary.recommendation", m.mrcRecommendation) + "\n")
+	}
+	if !m.liveAssignment {
+		for _, issue := range placement.ObserverIssues(cfg) {
+			b.WriteString(i18n.T("summary.warning", issue.Problem, issue.Fix) + "\n")
+		}
+	}
+
+	var brokerBytes map[int]int64
+	if len(cfg.PartitionLoads) > 0 {
+		brokerBytes = placement.BrokerBytes(m.dcs, cfg.PartitionLoads)
+	}
+
+	// --- Brokers, grouped by data center ---
+	b.WriteString("\n" + i18n.T("summary.brokers") + "\n")
+	for _, dcID := range dcIDs {
+		dc := m.dcs[dcID]
+		name := i18n.T("summary.dc", dcID)
+		if cfg.RackAware() {
+			name = i18n.T("summary.rack", dc.Name)
+		} else if dc.Name != "" {
+			name += " (" + dc.Name + ")"
+		}
+		brokerIDs := sortedBrokerIDs(dc)
+		b.WriteString(i18n.T("summary.dcBrokers", name, i18n.Plural(len(brokerIDs), "broker"), joinInts(brokerIDs)) + "\n")
+		for _, brokerID := range brokerIDs {
+			broker := dc.Brokers[brokerID]
+			byRole := m.partitionsByRole(broker)
+			line := i18n.T("summary.broker", brokerID, i18n.Plural(len(broker.Replicas), "replica"))
+			if brokerBytes != nil {
+				line += i18n.T("summary.brokerBytes", formatBytes(brokerBytes[brokerID]))
+			}
+			if tags := m.brokerTagsText(brokerID); tags != "" {
+				line += i18n.T("summary.brokerTags", tags)
+			}
+			line += ". "
+			for _, role := range []config.ReplicaRole{config.Leader, config.Follower, config.Observer} {
+				if ids := byRole[role]; len(ids) > 0 {
+					line += i18n.T("summary.role", roleName(role), joinInts(ids)) + " "
+				}
+			}
+			b.WriteString(strings.TrimSpace(line) + "\n")
+		}
+	}
+
+	// --- Partition table ---
+	b.WriteString("\n" + i18n.T("summary.partitions") + "\n")
+	partitionLag := map[int]int64{}
+	if m.showLag && m.lagData != nil {
+		for id, pl := range m.displayedLag() {
+			partitionLag[id] = pl.Lag
+		}
+	}
+	for _, p := range placement.Partitions(m.dcs) {
+		line := i18n.T("summary.partition", m.placementCfg.PartitionNumber(p.ID), joinInts(p.Brokers[config.Leader]))
+		if ids := p.Brokers[config.Follower]; len(ids) > 0 {
+			line += i18n.T("summary.followers", joinInts(ids))
+		}
+		if ids := p.Brokers[config.Observer]; len(ids) > 0 {
+			line += i18n.T("summary.observers", joinInts(ids))
+		}
+		if lagValue, ok := partitionLag[p.ID]; ok {
+			line += i18n.T("summary.lag", lagValue)
+		}
+		b.WriteString(line + ".\n")
+	}
+
+	// --- Rules ---
+	if len(m.ruleResults) > 0 {
+		b.WriteString("\n" + i18n.T("summary.rules") + "\n")
+		for _, r := range m.ruleResults {
+			line := i18n.T("summary.rulePassed", r.Rule)
+			if !r.Passed {
+				line = i18n.T("summary.ruleFailed", r.Rule)
+			}
+			if r.Detail != "" {
+				line += " (" + r.Detail + ")"
+			}
+			b.WriteString(line + ".\n")
+		}
+	}
+
+	// --- Topic naming ---
+	if r := m.namingReport; r != nil {
+		b.WriteString("\n" + i18n.T("naming.summary") + "\n")
+		b.WriteString(i18n.T("naming.passCount", r.Checked-r.Failing(), i18n.Plural(r.Checked, "topic")) + "\n")
+		for _, v := range r.Violations {
+			b.WriteString(i18n.T("naming.failed", v.Topic, v.Rule, v.Detail) + "\n")
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// partitionsByRole groups a broker's partitions, as numbered in the views,
+// by replica role.
+func (m Model) partitionsByRole(broker *config.BrokerInfo) map[config.ReplicaRole][]int {
+	byRole := make(map[config.ReplicaRole][]int)
+	for _, r := range broker.Replicas {
+		byRole[r.Role] = append(byRole[r.Role], m.placementCfg.PartitionNumber(r.PartitionID))
+	}
+	for _, ids := range byRole {
+		sort.Ints(ids)
+	}
+	return byRole
+}
+
+func sortedDCIDs(dcs map[int]*config.DCInfo) []int {
+	ids := make([]int, 0, len(dcs))
+	for id := range dcs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func sortedBrokerIDs(dc *config.DCInfo) []int {
+	ids := make([]int, 0, len(dc.Brokers))
+	for id := range dc.Brokers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// roleName returns the name of a replica role in the current language.
+func roleName(role config.ReplicaRole) string {
+	return i18n.T("role." + string(role))
+}
+
+// joinInts renders IDs as "1, 2 and 3".
+func joinInts(ids []int) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprint(id)
+	}
+	return joinList(parts)
+}
+
+// joinList renders items as "a, b and c", or "none" without any.
+func joinList(parts []string) string {
+	switch len(parts) {
+	case 0:
+		return i18n.T("list.none")
+	case 1:
+		return parts[0]
+	}
+	return i18n.T("list.and", strings.Join(parts[:len(parts)-1], ", "), parts[len(parts)-1])
+}
@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// maxSurvivabilityRows caps the DC combinations shown; 6 DCs already have
+// 62 ways to fail.
+const maxSurvivabilityRows = 62
+
+// survivabilityView renders the min ISR feasibility matrix: for every set
+// of data centers failing together, whether each topic still has min ISR
+// in-sync replicas for every partition, and whether the controller quorum
+// survives. It is a Markdown table, to paste into design docs as is.
+func (m Model) survivabilityView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("survivability.title")))
+	b.WriteString("\n")
+	dcIDs := sortedDCIDs(m.dcs)
+	if len(dcIDs) < 2 {
+		b.WriteString(HelpStyle.Render(i18n.T("survivability.oneDC")))
+		return b.String()
+	}
+
+	q := m.quorum()
+	topics := m.placedTopics()
+	header := []string{i18n.T("survivability.failedDCs")}
+	if len(q.Voters) > 0 {
+		header = append(header, i18n.T("survivability.controllers"))
+	}
+	for _, t := range topics {
+		name := t.cfg.TopicName
+		if name == "" {
+			name = i18n.T("survivability.topic")
+		}
+		header = append(header, i18n.T("survivability.column", name, t.cfg.MinInSyncReplicas))
+	}
+
+	combos := failover.DCCombinations(dcIDs)
+	rows := make([][]string, 0, min(len(combos), maxSurvivabilityRows))
+	for _, combo := range combos[:min(len(combos), maxSurvivabilityRows)] {
+		names := make([]string, len(combo))
+		for i, id := range combo {
+			names[i] = m.dcs[id].Rack()
+		}
+		row := []string{strings.Join(names, " + ")}
+		if len(q.Voters) > 0 {
+			cell := "✓"
+			if !q.Survives(nil, setOf(combo)) {
+				cell = i18n.T("survivability.noMajority")
+			}
+			row = append(row, cell)
+		}
+		for _, t := range topics {
+			o := failover.SimulateDCOutage(t.dcs, q, combo, t.cfg.MinInSyncReplicas)
+			cell := "✓"
+			if n := len(o.NoWrites()); n > 0 {
+				cell = i18n.T("survivability.rejecting", n, t.cfg.NumPartitions)
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, row)
+	}
+
+	// Pad every column to its widest cell, so the table also reads as text
+	widths := make([]int, len(header))
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			widths[i] = max(widths[i], cellWidth.StringWidth(cell))
+		}
+	}
+	line := func(cells []string) string {
+		padded := make([]string, len(cells))
+		for i, cell := range cells {
+			padded[i] = padRight(cell, widths[i])
+		}
+		return "| " + strings.Join(padded, " | ") + " |\n"
+	}
+	b.WriteString(line(header))
+	rules := make([]string, len(header))
+	for i, w := range widths {
+		rules[i] = strings.Repeat("-", w)
+	}
+	b.WriteString(line(rules))
+	for _, row := range rows {
+		b.WriteString(line(row))
+	}
+	if n := len(combos) - len(rows); n > 0 {
+		b.WriteString(i18n.T("survivability.more", i18n.Plural(n, "more combination")) + "\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("survivability.help")))
+	return b.String()
+}
+
+// setOf returns ids as a set.
+func setOf(ids []int) map[int]bool {
+	set := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
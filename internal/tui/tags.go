@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+)
+
+// tagSelectors returns every key=value tag set on a broker of the current
+// placement, sorted, i.e. the tags the view can be filtered by.
+func (m Model) tagSelectors() []string {
+	seen := make(map[string]bool)
+	for _, dc := range m.dcs {
+		for id := range dc.Brokers {
+			for key, value := range m.placementCfg.BrokerTags[id] {
+				seen[config.TagSelector{Key: key, Value: value}.String()] = true
+			}
+		}
+	}
+	selectors := make([]string, 0, len(seen))
+	for s := range seen {
+		selectors = append(selectors, s)
+	}
+	sort.Strings(selectors)
+	return selectors
+}
+
+// cycleTagFilter highlights the brokers with the next tag in turn, and
+// none after the last one.
+func (m *Model) cycleTagFilter() {
+	selectors := m.tagSelectors()
+	next := ""
+	if m.tagFilter == "" && len(selectors) > 0 {
+		next = selectors[0]
+	}
+	for i, s := range selectors {
+		if s == m.tagFilter && i+1 < len(selectors) {
+			next = selectors[i+1]
+		}
+	}
+	m.tagFilter = next
+}
+
+// tagDimmed reports whether a broker is dimmed for not having the tag the
+// view is filtered by.
+func (m Model) tagDimmed(brokerID int) bool {
+	if m.tagFilter == "" {
+		return false
+	}
+	sel, err := config.ParseTagSelector(m.tagFilter)
+	return err == nil && !sel.Matches(m.placementCfg.BrokerTags[brokerID])
+}
+
+// brokerTagsText lists a broker's tags sorted by key, e.g.
+// "disk=gp3, lifecycle=spot", or "" without tags.
+func (m Model) brokerTagsText(brokerID int) string {
+	tags := m.placementCfg.BrokerTags[brokerID]
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + tags[key]
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// tagStatus renders the broker tag filter and whether the leaders keep off
+// the avoided tags, for the placement header.
+func (m Model) tagStatus() string {
+	var b strings.Builder
+	if m.tagFilter != "" {
+		var ids []int
+		for _, dc := range m.dcs {
+			for id := range dc.Brokers {
+				if !m.tagDimmed(id) {
+					ids = append(ids, id)
+				}
+			}
+		}
+		sort.Ints(ids)
+		b.WriteString(i18n.T("tags.filter", FocusedStyle.Render(m.tagFilter), i18n.Plural(len(ids), "broker"), joinInts(ids)) + "\n")
+	}
+	cfg := m.placementCfg
+	if len(cfg.AvoidLeaders) == 0 {
+		return b.String()
+	}
+	avoided := make([]string, len(cfg.AvoidLeaders))
+	for i, sel := range cfg.AvoidLeaders {
+		avoided[i] = sel.String()
+	}
+	what := strings.Join(avoided, i18n.T("tags.or"))
+	ids := placement.AvoidedLeaders(cfg, m.dcs)
+	if len(ids) == 0 {
+		b.WriteString(PassStyle.Render(i18n.T("tags.avoided", what)) + "\n")
+		return b.String()
+	}
+	list := make([]string, 0, 10)
+	for _, id := range ids[:min(len(ids), 10)] {
+		list = append(list, fmt.Sprintf("p%d", m.placementCfg.PartitionNumber(id)))
+	}
+	if len(ids) > len(list) {
+		list = append(list, "...")
+	}
+	b.WriteString(FailStyle.Render(i18n.T("tags.notAvoided", i18n.Plural(len(ids), "partition"), what, strings.Join(list, ", "))) + "\n")
+	return b.String()
+}
@@ -0,0 +1,213 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+)
+
+// tenantMatrixBrokers is the most brokers the tenant pane lists one by one;
+// larger clusters are shown per DC only.
+const tenantMatrixBrokers = 12
+
+// footprint is the replicas and leaders a tenant has somewhere.
+type footprint struct {
+	Replicas int
+	Leaders  int
+	Bytes    int64
+}
+
+// tenantFootprint is what one tenant's topics place on the cluster.
+type tenantFootprint struct {
+	Tenant     string
+	Topics     []string
+	Partitions int
+	Total      footprint
+	ByBroker   map[int]footprint
+	ByDC       map[int]footprint
+}
+
+// placedTopics returns the placement of every topic: all topics in
+// multi-topic mode, with the shown one as currently edited, else the shown
+// placement alone.
+func (m Model) placedTopics() []topicPlacement {
+	if len(m.topics) == 0 {
+		return []topicPlacement{{cfg: m.placementCfg, dcs: m.dcs}}
+	}
+	placed := append([]topicPlacement(nil), m.topics...)
+	if m.activeTopic < len(placed) {
+		placed[m.activeTopic] = topicPlacement{cfg: m.placementCfg, dcs: m.dcs}
+	}
+	return placed
+}
+
+// tenantFootprints sums the replicas, leaders and bytes of the topics of
+// every tenant per broker and DC, sorted by tenant with untagged topics
+// last.
+func (m Model) tenantFootprints() []tenantFootprint {
+	byTenant := make(map[string]*tenantFootprint)
+	for _, t := range m.placedTopics() {
+		tenant := t.cfg.Tenant
+		tf := byTenant[tenant]
+		if tf == nil {
+			tf = &tenantFootprint{Tenant: tenant, ByBroker: make(map[int]footprint), ByDC: make(map[int]footprint)}
+			byTenant[tenant] = tf
+		}
+		tf.Topics = append(tf.Topics, t.cfg.TopicName)
+		tf.Partitions += t.cfg.NumPartitions
+		for _, dc := range t.dcs {
+			for _, broker := range dc.Brokers {
+				for _, r := range broker.Replicas {
+					var f footprint
+					f.Replicas = 1
+					if r.Role == config.Leader {
+						f.Leaders = 1
+					}
+					f.Bytes = t.cfg.PartitionLoads[r.PartitionID].SizeBytes
+					tf.Total = tf.Total.add(f)
+					tf.ByBroker[broker.ID] = tf.ByBroker[broker.ID].add(f)
+					tf.ByDC[dc.ID] = tf.ByDC[dc.ID].add(f)
+				}
+			}
+		}
+	}
+	result := make([]tenantFootprint, 0, len(byTenant))
+	for _, tf := range byTenant {
+		result = append(result, *tf)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if (result[i].Tenant == "") != (result[j].Tenant == "") {
+			return result[j].Tenant == ""
+		}
+		return result[i].Tenant < result[j].Tenant
+	})
+	return result
+}
+
+func (f footprint) add(o footprint) footprint {
+	return footprint{Replicas: f.Replicas + o.Replicas, Leaders: f.Leaders + o.Leaders, Bytes: f.Bytes + o.Bytes}
+}
+
+// name returns the tenant, or a label for the topics without one.
+func (tf tenantFootprint) name() string {
+	if tf.Tenant == "" {
+		return i18n.T("tenants.none")
+	}
+	return tf.Tenant
+}
+
+// String formats the footprint as replicas/leaders.
+func (f footprint) String() string {
+	return fmt.Sprintf("%d/%d", f.Replicas, f.Leaders)
+}
+
+// tenantView renders the tenant pane: every tenant's share of the replicas,
+// leaders and bytes, its footprint per DC and per broker, and the brokers
+// it shares with other tenants, for chargeback and isolation reviews.
+func (m Model) tenantView() string {
+	tenants := m.tenantFootprints()
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("tenants.title", i18n.Plural(len(tenants), "tenant"), i18n.Plural(len(m.placedTopics()), "topic"))))
+	b.WriteString("\n")
+
+	var replicas, leaders int
+	var size int64
+	for _, tf := range tenants {
+		replicas += tf.Total.Replicas
+		leaders += tf.Total.Leaders
+		size += tf.Total.Bytes
+	}
+	b.WriteString(fmt.Sprintf("%-16s %-7s %-11s %-16s %-16s", i18n.T("tenants.tenant"), i18n.T("tenants.topics"), i18n.T("tenants.partitions"), i18n.T("trend.replicas"), i18n.T("column.leaders")))
+	if size > 0 {
+		b.WriteString(" " + i18n.T("tenants.size"))
+	}
+	b.WriteString("\n")
+	for _, tf := range tenants {
+		b.WriteString(fmt.Sprintf("%s %-7d %-11d %-16s %-16s", padRight(tf.name(), 16), len(tf.Topics), tf.Partitions,
+			share(tf.Total.Replicas, replicas), share(tf.Total.Leaders, leaders)))
+		if size > 0 {
+			b.WriteString(" " + formatBytes(tf.Total.Bytes))
+		}
+		b.WriteString("\n")
+	}
+
+	dcIDs := sortedDCIDs(m.dcs)
+	if len(dcIDs) > 1 {
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("%-16s", i18n.T("tenants.replicasLeaders")))
+		for _, id := range dcIDs {
+			b.WriteString(" " + padRight(m.dcs[id].Rack(), 10))
+		}
+		b.WriteString("\n")
+		for _, tf := range tenants {
+			b.WriteString(padRight(tf.name(), 16))
+			for _, id := range dcIDs {
+				b.WriteString(fmt.Sprintf(" %-10s", tf.ByDC[id]))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	var brokerIDs []int
+	for _, dc := range m.dcs {
+		for id := range dc.Brokers {
+			brokerIDs = append(brokerIDs, id)
+		}
+	}
+	sort.Ints(brokerIDs)
+	if len(brokerIDs) <= tenantMatrixBrokers {
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("%-16s", i18n.T("tenants.replicasLeaders")))
+		for _, id := range brokerIDs {
+			b.WriteString(fmt.Sprintf(" %-6s", fmt.Sprintf("B%d", id)))
+		}
+		b.WriteString("\n")
+		for _, tf := range tenants {
+			b.WriteString(padRight(tf.name(), 16))
+			for _, id := range brokerIDs {
+				cell := "-"
+				if f, ok := tf.ByBroker[id]; ok {
+					cell = f.String()
+				}
+				b.WriteString(fmt.Sprintf(" %-6s", cell))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(tenants) > 1 {
+		b.WriteString("\n")
+		for _, tf := range tenants {
+			var shared []int
+			for id := range tf.ByBroker {
+				for _, other := range tenants {
+					if other.Tenant != tf.Tenant && other.ByBroker[id].Replicas > 0 {
+						shared = append(shared, id)
+						break
+					}
+				}
+			}
+			sort.Ints(shared)
+			if len(shared) == 0 {
+				b.WriteString(PassStyle.Render(i18n.T("tenants.isolated", tf.name(), i18n.Plural(len(tf.ByBroker), "broker"))))
+			} else {
+				b.WriteString(i18n.T("tenants.shares", tf.name(), len(shared), i18n.Plural(len(tf.ByBroker), "broker"), joinInts(shared)))
+			}
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("tenants.help")))
+	return b.String()
+}
+
+// share formats n and its percentage of total.
+func share(n, total int) string {
+	if total == 0 {
+		return fmt.Sprint(n)
+	}
+	return fmt.Sprintf("%d (%.0f%%)", n, float64(n)*100/float64(total))
+}
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// asciiTerminals are TERM values of consoles without Unicode glyphs:
+// serial terminals and the Linux virtual console's default font.
+var asciiTerminals = []string{"dumb", "linux", "vt"}
+
+// asciiGlyphs replaces the symbols the views use with ASCII. Every symbol
+// takes one cell and so does its replacement, so boxes and tables sized for
+// the symbols still line up.
+var asciiGlyphs = strings.NewReplacer(
+	"→", ">", "←", "<", "↑", "^", "↓", "v",
+	"✗", "x", "✓", "+", "⚠", "!", "✎", "*", "•", "*", "×", "x",
+	"·", ".", "…", ".", "—", "-", "│", "|",
+	"≪", "<", "≫", ">", "‹", "<", "›", ">", "≤", "<", "≥", ">", "≈", "~",
+	"░", ".", "▒", ":", "▓", "%", "█", "#",
+	"▁", "_", "▂", ".", "▃", ":", "▄", "-", "▅", "=", "▆", "+", "▇", "*",
+)
+
+// asciiOnly is set on terminals without Unicode; the views then pass
+// through asciiGlyphs and the boxes use ASCII borders.
+var asciiOnly bool
+
+// SetTerminal adapts the rendering to what the terminal can show. "full"
+// uses 256/true colors and Unicode symbols and borders; "basic" uses the
+// 16 ANSI colors and ASCII only, for plain SSH sessions and serial
+// consoles; "auto" picks basic colors and ASCII separately from the
+// detected color support, TERM and locale. Call it after SetTheme, before
+// Bubble Tea takes over the terminal.
+func SetTerminal(mode string) error {
+	switch mode {
+	case "", "auto":
+		if profile := lipgloss.ColorProfile(); profile == termenv.ANSI || profile == termenv.Ascii {
+			useBasicColors()
+		}
+		if !unicodeTerminal() {
+			useASCII()
+		}
+	case "full":
+	case "basic":
+		useBasicColors()
+		useASCII()
+	default:
+		// Flag errors are not translated, like the rest of main's
+		return fmt.Errorf("unknown terminal mode %q (expected auto, full or basic)", mode)
+	}
+	buildStyles()
+	return nil
+}
+
+// unicodeTerminal reports whether the terminal likely shows Unicode: its
+// TERM is not a known ASCII console, and the locale, when set, is UTF-8.
+func unicodeTerminal() bool {
+	term := os.Getenv("TERM")
+	for _, t := range asciiTerminals {
+		if strings.HasPrefix(term, t) {
+			return false
+		}
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := strings.ToLower(os.Getenv(name)); locale != "" {
+			return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+		}
+	}
+	return true // No locale set: most terminal emulators are UTF-8
+}
+
+// useBasicColors swaps the palette for the 16 ANSI colors, whose exact
+// shades the terminal chooses. Light backgrounds get the normal colors,
+// dark ones the bright variants.
+func useBasicColors() {
+	basic := func(light, dark string) lipgloss.AdaptiveColor {
+		return lipgloss.AdaptiveColor{Light: light, Dark: dark}
+	}
+	titleColor = basic("5", "5")
+	focusColor = basic("5", "13")
+	blurredColor = basic("8", "8")
+	borderColor = basic("5", "13")
+	leaderColor = basic("2", "10")
+	followerColor = basic("3", "11")
+	observerColor = basic("1", "9")
+	errorColor = basic("1", "9")
+	dcPalette = []lipgloss.AdaptiveColor{
+		basic("4", "12"), basic("3", "11"), basic("5", "13"), basic("6", "14"), basic("1", "9"), basic("2", "10"),
+	}
+	heatPalette = []lipgloss.AdaptiveColor{
+		basic("15", "0"), basic("7", "8"), basic("11", "3"), basic("9", "1"), basic("1", "9"),
+	}
+}
+
+// useASCII draws the broker boxes with ASCII borders, keeping them apart:
+// "=" edges for a replica out of sync and "#" for the selected broker.
+func useASCII() {
+	asciiOnly = true
+	boxBorder = lipgloss.ASCIIBorder()
+	outOfSyncBorder = lipgloss.Border{
+		Top: "=", Bottom: "=", Left: "|", Right: "|",
+		TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+	}
+	selectedBorder = lipgloss.Border{
+		Top: "#", Bottom: "#", Left: "#", Right: "#",
+		TopLeft: "#", TopRight: "#", BottomLeft: "#", BottomRight: "#",
+	}
+}
+
+// terminalText returns a rendered view as the terminal can show it.
+func terminalText(s string) string {
+	if asciiOnly {
+		return asciiGlyphs.Replace(s)
+	}
+	return s
+}
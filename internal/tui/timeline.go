@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/timeline"
+)
+
+const (
+	timelineColumns = 60 // Most columns of the event timeline strips
+	timelineLog     = 20 // Most lines of the event timeline report
+)
+
+// timelineSteps are the column widths the event timeline picks from, the
+// first that fits the timeline into timelineColumns.
+var timelineSteps = []time.Duration{
+	5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute, time.Hour,
+}
+
+// SetTimeline sets the scripted events the event timeline plays, and how
+// fast its reassignment copies: throttle bytes/sec into each broker with
+// partition sizes, else waveTime per wave. Without events the timeline
+// fails a broker, recovers it after 5 minutes and starts the reassignment
+// 5 minutes later.
+func (m *Model) SetTimeline(events []timeline.Event, throttle float64, waveTime time.Duration) {
+	m.timelineEvents = events
+	m.reassignThrottle = throttle
+	m.waveTime = waveTime
+}
+
+// timelineEventList returns the events to play: the configured ones, else
+// the selected broker or the one hosting the most replicas failing and
+// recovering, then the reassignment.
+func (m Model) timelineEventList() []timeline.Event {
+	if len(m.timelineEvents) > 0 {
+		return m.timelineEvents
+	}
+	id := m.busiestBroker()
+	return []timeline.Event{
+		{At: 0, Kind: timeline.BrokerFail, Target: id},
+		{At: 5 * time.Minute, Kind: timeline.BrokerRecover, Target: id},
+		{At: 10 * time.Minute, Kind: timeline.ReassignStart},
+	}
+}
+
+// busiestBroker returns the selected broker, else the one hosting the most
+// replicas (the lowest ID on a tie).
+func (m Model) busiestBroker() int {
+	if m.brokerSelected {
+		return m.selectedBroker
+	}
+	id, most := -1, -1
+	for _, dc := range m.dcs {
+		for _, b := range dc.Brokers {
+			if len(b.Replicas) > most || (len(b.Replicas) == most && b.ID < id) {
+				id, most = b.ID, len(b.Replicas)
+			}
+		}
+	}
+	return id
+}
+
+// timelineView plays the scripted events on a simulated clock and renders
+// what the cluster goes through: strips of the brokers down, partitions
+// without a leader or under min ISR and partitions being moved, and a
+// report of every fencing, election, ISR change and reassignment wave. The
+// cluster starts as the diff base, e.g. the live assignment, and the
+// reassignment moves it to the shown placement.
+func (m Model) timelineView() string {
+	events := m.timelineEventList()
+	start, target := m.dcs, m.dcs
+	base := i18n.T("timeline.noBase")
+	if m.diffDCs != nil {
+		start = m.diffDCs
+		base = i18n.T("timeline.base", m.diffLabel)
+	} else {
+		target = nil
+	}
+	p := timeline.Params{
+		Timing:   m.failoverTiming,
+		CatchUp:  failover.DefaultCatchUp,
+		MinISR:   m.placementCfg.MinInSyncReplicas,
+		Quorum:   m.quorum(),
+		Target:   target,
+		Limits:   m.waveLimits,
+		Loads:    m.placementCfg.PartitionLoads,
+		Throttle: m.reassignThrottle,
+		WaveTime: m.waveTime,
+	}
+	r := timeline.Simulate(start, events, p)
+
+	var b strings.Builder
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.String()
+	}
+	b.WriteString(DCHeaderStyle.Render(i18n.T("timeline.title", strings.Join(names, ", "), base)))
+	b.WriteString("\n")
+
+	step := timelineSteps[len(timelineSteps)-1]
+	for _, s := range timelineSteps {
+		if r.Length <= s*timelineColumns {
+			step = s
+			break
+		}
+	}
+	columns := int((r.Length + step - 1) / step)
+	b.WriteString(fmt.Sprintf("%-12s %s\n", "", HelpStyle.Render(i18n.T("timeline.columns", step))))
+	rows := []struct {
+		label string
+		value func(timeline.Sample) int
+		bad   bool
+	}{
+		{i18n.T("timeline.row.down"), func(s timeline.Sample) int { return s.Down }, false},
+		{i18n.T("timeline.row.leaderless"), func(s timeline.Sample) int { return s.Leaderless }, true},
+		{i18n.T("timeline.row.underMinISR"), func(s timeline.Sample) int { return s.UnderMinISR }, true},
+		{i18n.T("timeline.row.moving"), func(s timeline.Sample) int { return s.Moving }, false},
+	}
+	per := int(step / time.Second)
+	for _, row := range rows {
+		var strip strings.Builder
+		for c := 0; c < columns; c++ {
+			worst := 0
+			for t := c * per; t < (c+1)*per && t < len(r.Samples); t++ {
+				worst = max(worst, row.value(r.Samples[t]))
+			}
+			switch {
+			case worst == 0:
+				strip.WriteString(HelpStyle.Render("·"))
+			case row.bad:
+				strip.WriteString(FailStyle.Render(fmt.Sprint(min(worst, 9))))
+			default:
+				strip.WriteString(fmt.Sprint(min(worst, 9)))
+			}
+		}
+		b.WriteString(fmt.Sprintf("%-12s %s\n", row.label, strip.String()))
+	}
+
+	b.WriteString("\n")
+	for i, e := range r.Log {
+		if i == timelineLog {
+			b.WriteString(HelpStyle.Render(i18n.T("timeline.more", len(r.Log)-i)))
+			b.WriteString("\n")
+			break
+		}
+		line := fmt.Sprintf("t+%-7s %s", e.At, e.What)
+		if e.Alert {
+			line = FailStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	summary := i18n.T("timeline.writesOK")
+	if r.NoWrites > 0 {
+		summary = i18n.T("timeline.writesFail", r.NoWrites)
+	}
+	if r.Done >= 0 {
+		summary += i18n.T("timeline.done", r.Done, i18n.Plural(r.Waves, "wave"))
+	}
+	b.WriteString(summary + "\n")
+	b.WriteString(HelpStyle.Render(i18n.T("timeline.help")))
+	return b.String()
+}
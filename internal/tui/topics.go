@@ -0,0 +1,361 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Columns of the topic table editor, by the catalog key of their title.
+var topicColumns = []struct {
+	title string
+	width int
+}{
+	{"topics.col.name", 24},
+	{"topics.col.partitions", 10},
+	{"topics.col.rf", 4},
+	{"topics.col.minISR", 7},
+	{"topics.col.tenant", 16},
+}
+
+// Numeric columns of the topic table editor, after the name.
+const numericTopicColumns = 3
+
+// topicPlacement is the placement of one topic in multi-topic mode. All
+// topics share the broker pool of the placement the editor was opened from.
+type topicPlacement struct {
+	cfg            config.PlacementConfig
+	dcs            map[int]*config.DCInfo
+	recommendation string
+}
+
+// newTopicRow creates the inputs of one editor row.
+func newTopicRow(spec config.TopicSpec) []textinput.Model {
+	values := []string{spec.Name, strconv.Itoa(spec.NumPartitions), strconv.Itoa(spec.ReplicationFactor), strconv.Itoa(spec.MinInSyncReplicas), spec.Tenant}
+	row := make([]textinput.Model, len(topicColumns))
+	for i, col := range topicColumns {
+		row[i] = textinput.New()
+		row[i].Cursor.Style = CursorStyle
+		row[i].Prompt = ""
+		row[i].Placeholder = i18n.T(col.title)
+		row[i].Width = col.width
+		row[i].SetValue(values[i])
+		if i > 0 && i <= numericTopicColumns {
+			row[i].CharLimit = 5
+			row[i].Validate = isNumber
+		}
+	}
+	return row
+}
+
+// openTopicEditor switches to the topic table editor, seeded with the
+// current topics (or the current single topic).
+func (m *Model) openTopicEditor() tea.Cmd {
+	var specs []config.TopicSpec
+	if len(m.topics) > 0 {
+		m.saveActiveTopic()
+		for _, t := range m.topics {
+			specs = append(specs, t.cfg.TopicSpec())
+		}
+	} else {
+		specs = append(specs, m.placementCfg.TopicSpec())
+	}
+	return m.editTopics(m.placementCfg, specs)
+}
+
+// openTopicEditorFromForm validates the configuration form and switches to
+// the topic table editor, seeded with the loaded topic list or the topic of
+// the form, to place several topics on the brokers of the form.
+func (m *Model) openTopicEditorFromForm() tea.Cmd {
+	err := m.parseAndValidateInputs()
+	var cfg config.PlacementConfig
+	if err == nil {
+		cfg, err = m.placementConfig()
+	}
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	specs := m.topicSpecs
+	if len(specs) == 0 {
+		specs = []config.TopicSpec{cfg.TopicSpec()}
+	}
+	return m.editTopics(cfg, specs)
+}
+
+// editTopics switches to the topic table editor with a row per spec, to
+// place the topics on the brokers of base.
+func (m *Model) editTopics(base config.PlacementConfig, specs []config.TopicSpec) tea.Cmd {
+	m.topicRows = nil
+	for i, spec := range specs {
+		if spec.Name == "" {
+			spec.Name = fmt.Sprintf("topic-%d", i+1)
+		}
+		m.topicRows = append(m.topicRows, newTopicRow(spec))
+	}
+	m.topicRow, m.topicCol = 0, 0
+	m.topicBase, m.topicFrom = base, m.stage
+	m.err = nil
+	m.stage = EditTopics
+	return m.focusTopicCell()
+}
+
+// focusTopicCell focuses the current cell and blurs all others.
+func (m *Model) focusTopicCell() tea.Cmd {
+	var cmd tea.Cmd
+	for r := range m.topicRows {
+		for c := range m.topicRows[r] {
+			if r == m.topicRow && c == m.topicCol {
+				cmd = m.topicRows[r][c].Focus()
+				m.topicRows[r][c].TextStyle = FocusedStyle
+			} else {
+				m.topicRows[r][c].Blur()
+				m.topicRows[r][c].TextStyle = NoStyle
+			}
+		}
+	}
+	return cmd
+}
+
+// updateTopicEditor handles keys in the topic table editor.
+func (m Model) updateTopicEditor(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m.quit()
+	case "esc":
+		// Back to the form or placement the editor was opened from
+		m.stage = m.topicFrom
+		m.err = nil
+		return m, nil
+	case "enter":
+		specs, err := m.parseTopicRows()
+		if err != nil {
+			m.err = err
+			return m, nil
+		}
+		if m.topicFrom != ShowPlacement {
+			// Topics defined with the form are kept, like a --topics list,
+			// when its values are edited and placed again
+			m.topicSpecs = specs
+		}
+		if err := m.placeTopics(m.topicBase, specs); err != nil {
+			m.err = err
+		}
+		return m, nil
+	case "ctrl+n":
+		// New row, copying the sizing of the current one
+		spec := config.TopicSpec{Name: fmt.Sprintf("topic-%d", len(m.topicRows)+1)}
+		cur := m.topicRows[m.topicRow]
+		spec.NumPartitions, _ = strconv.Atoi(cur[1].Value())
+		spec.ReplicationFactor, _ = strconv.Atoi(cur[2].Value())
+		spec.MinInSyncReplicas, _ = strconv.Atoi(cur[3].Value())
+		m.topicRows = append(m.topicRows[:m.topicRow+1], append([][]textinput.Model{newTopicRow(spec)}, m.topicRows[m.topicRow+1:]...)...)
+		m.topicRow++
+		m.topicCol = 0
+	case "ctrl+d":
+		if len(m.topicRows) > 1 {
+			m.topicRows = append(m.topicRows[:m.topicRow], m.topicRows[m.topicRow+1:]...)
+			m.topicRow = min(m.topicRow, len(m.topicRows)-1)
+		}
+	case "tab":
+		m.topicCol++
+		if m.topicCol == len(topicColumns) {
+			m.topicCol = 0
+			m.topicRow = (m.topicRow + 1) % len(m.topicRows)
+		}
+	case "shift+tab":
+		m.topicCol--
+		if m.topicCol < 0 {
+			m.topicCol = len(topicColumns) - 1
+			m.topicRow = (m.topicRow - 1 + len(m.topicRows)) % len(m.topicRows)
+		}
+	case "up":
+		m.topicRow = max(0, m.topicRow-1)
+	case "down":
+		m.topicRow = min(len(m.topicRows)-1, m.topicRow+1)
+	default:
+		// Everything else edits the focused cell
+		var cmd tea.Cmd
+		m.topicRows[m.topicRow][m.topicCol], cmd = m.topicRows[m.topicRow][m.topicCol].Update(msg)
+		return m, cmd
+	}
+	return m, m.focusTopicCell()
+}
+
+// parseTopicRows validates the editor rows against the broker pool they
+// are placed on.
+func (m Model) parseTopicRows() ([]config.TopicSpec, error) {
+	totalBrokers := m.topicBase.TotalBrokers()
+	// Sizes are not editable; keep the estimate of topics that keep their name
+	sizes := map[string]int64{m.topicBase.TopicName: m.topicBase.TopicSpec().SizeBytes}
+	for _, spec := range m.topicSpecs {
+		sizes[spec.Name] = spec.SizeBytes
+	}
+	for _, t := range m.topics {
+		sizes[t.cfg.TopicName] = t.cfg.TopicSpec().SizeBytes
+	}
+	seen := make(map[string]bool)
+	specs := make([]config.TopicSpec, 0, len(m.topicRows))
+	for r, row := range m.topicRows {
+		spec := config.TopicSpec{Name: strings.TrimSpace(row[0].Value())}
+		spec.SizeBytes = sizes[spec.Name]
+		if spec.Name == "" {
+			return nil, errors.New(i18n.T("topics.emptyName", r+1))
+		}
+		if seen[spec.Name] {
+			return nil, errors.New(i18n.T("topics.duplicate", r+1, spec.Name))
+		}
+		seen[spec.Name] = true
+		values := make([]int, numericTopicColumns)
+		for i, input := range row[1 : numericTopicColumns+1] {
+			v, err := strconv.Atoi(input.Value())
+			if err != nil || v <= 0 {
+				return nil, errors.New(i18n.T("topics.notPositive", r+1, i18n.T(topicColumns[i+1].title)))
+			}
+			values[i] = v
+		}
+		spec.NumPartitions, spec.ReplicationFactor, spec.MinInSyncReplicas = values[0], values[1], values[2]
+		spec.Tenant = strings.TrimSpace(row[numericTopicColumns+1].Value())
+		if err := spec.Validate(totalBrokers); err != nil {
+			return nil, fmt.Errorf("%s: %w", i18n.T("topics.row", r+1), err)
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// placeTopics places every topic on the brokers of base and shows the
+// first one.
+func (m *Model) placeTopics(base config.PlacementConfig, specs []config.TopicSpec) error {
+	for _, spec := range specs {
+		if err := spec.Validate(base.TotalBrokers()); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T("topics.topic", spec.Name), err)
+		}
+	}
+	m.topics = make([]topicPlacement, 0, len(specs))
+	for _, spec := range specs {
+		cfg := m.withSessionOptions(base.WithTopic(spec))
+		dcs, recommendation := m.placements.CalculatePlacement(cfg)
+		m.topics = append(m.topics, topicPlacement{cfg: cfg, dcs: dcs, recommendation: recommendation})
+	}
+	m.activateTopic(0)
+	return nil
+}
+
+// saveActiveTopic stores the shown placement back into the topic list.
+func (m *Model) saveActiveTopic() {
+	if m.activeTopic < len(m.topics) {
+		m.topics[m.activeTopic] = topicPlacement{cfg: m.placementCfg, dcs: m.dcs, recommendation: m.mrcRecommendation}
+	}
+}
+
+// activateTopic shows the placement of topic i.
+func (m *Model) activateTopic(i int) {
+	t := m.topics[i]
+	m.activeTopic = i
+	m.numPartitions = t.cfg.NumPartitions
+	m.replicationFactor = t.cfg.ReplicationFactor
+	m.minInSyncReplicas = t.cfg.MinInSyncReplicas
+	m.setDiffBase(nil, "") // Topics are not diffed against each other
+	m.showPlacement(t.cfg, t.dcs, t.recommendation)
+}
+
+// switchTopic shows the next (delta 1) or previous (delta -1) topic.
+func (m *Model) switchTopic(delta int) {
+	if len(m.topics) < 2 {
+		return
+	}
+	m.saveActiveTopic()
+	m.activateTopic((m.activeTopic + delta + len(m.topics)) % len(m.topics))
+}
+
+// topicTabs renders the placed topics as a tab bar with the shown one
+// highlighted. Tabs that do not fit the terminal width are left out around
+// the shown one and counted at the ends.
+func (m Model) topicTabs() string {
+	tabs := make([]string, len(m.topics))
+	for i, t := range m.topics {
+		tabs[i] = " " + t.cfg.TopicName + " "
+	}
+	prefix := i18n.T("placement.topics") + " "
+	suffix := " " + i18n.T("placement.topicsSwitch")
+	lo, hi := 0, len(tabs)
+	if m.width > 0 {
+		// Grow from the shown tab while the tabs, separators and both
+		// counts still fit
+		avail := m.width - cellWidth.StringWidth(prefix+suffix) - 2*cellWidth.StringWidth(" ‹ 99 ")
+		lo, hi = m.activeTopic, m.activeTopic+1
+		used := cellWidth.StringWidth(tabs[lo])
+		for grown := true; grown; {
+			grown = false
+			if hi < len(tabs) && used+1+cellWidth.StringWidth(tabs[hi]) <= avail {
+				used += 1 + cellWidth.StringWidth(tabs[hi])
+				hi++
+				grown = true
+			}
+			if lo > 0 && used+1+cellWidth.StringWidth(tabs[lo-1]) <= avail {
+				lo--
+				used += 1 + cellWidth.StringWidth(tabs[lo])
+				grown = true
+			}
+		}
+	}
+	var b strings.Builder
+	b.WriteString(prefix)
+	if lo > 0 {
+		b.WriteString(HelpStyle.Render(fmt.Sprintf("‹ %d ", lo)))
+	}
+	for i := lo; i < hi; i++ {
+		if i > lo {
+			b.WriteString(HelpStyle.Render("│"))
+		}
+		if i == m.activeTopic {
+			b.WriteString(TopicTabStyle.Render(tabs[i]))
+		} else {
+			b.WriteString(tabs[i])
+		}
+	}
+	if hi < len(tabs) {
+		b.WriteString(HelpStyle.Render(fmt.Sprintf(" %d ›", len(tabs)-hi)))
+	}
+	b.WriteString(HelpStyle.Render(suffix))
+	return b.String()
+}
+
+// topicEditorView renders the topic table editor.
+func (m Model) topicEditorView() string {
+	var b strings.Builder
+	b.WriteString(i18n.T("topics.title") + "\n\n")
+	b.WriteString("  ")
+	for _, col := range topicColumns {
+		b.WriteString(padRight(i18n.T(col.title), col.width+2))
+	}
+	b.WriteString("\n")
+	for r, row := range m.topicRows {
+		marker := "  "
+		if r == m.topicRow {
+			marker = FocusedStyle.Render("> ")
+		}
+		b.WriteString(marker)
+		for c, input := range row {
+			b.WriteString(lipgloss.NewStyle().Width(topicColumns[c].width + 2).Render(input.View()))
+		}
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString("\n")
+		b.WriteString(ErrorStyle.Render(i18n.T("error.title", m.err.Error())))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(HelpStyle.Render(i18n.T("topics.help")))
+	return b.String()
+}
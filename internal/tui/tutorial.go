@@ -0,0 +1,205 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// Use the full module path for your internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/glossary"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tutorialConfig is the example cluster of the tutorial: 3 brokers, one
+// topic with 3 partitions, RF 3 and min ISR 2. The fixed seed keeps the
+// walkthrough the same every time.
+var tutorialConfig = config.PlacementConfig{
+	ClusterType:       config.SingleCluster,
+	NumBrokers:        3,
+	NumDCs:            1,
+	NumPartitions:     3,
+	ReplicationFactor: 3,
+	MinInSyncReplicas: 2,
+	Seed:              1,
+}
+
+// tutorialStep is one page of the tutorial.
+type tutorialStep struct {
+	key      string   // Catalog key of the title and text, tutorial.<key>.*
+	terms    []string // Glossary entries explained on this page
+	failures int      // Brokers (lowest IDs first) shown as failed
+	cluster  bool     // Show the example cluster
+}
+
+// tutorialSteps are the pages in order. Their terms are glossary keys, not
+// translated.
+var tutorialSteps = []tutorialStep{
+	{key: "welcome"},
+	{key: "brokers", terms: []string{"broker"}, cluster: true},
+	{key: "leaders", terms: []string{"partition", "leader"}, cluster: true},
+	{key: "replication", terms: []string{"replication factor", "follower"}, cluster: true},
+	{key: "isr", terms: []string{"isr", "min isr"}, cluster: true},
+	{key: "failure", failures: 1, cluster: true},
+	{key: "secondFailure", failures: 2, cluster: true},
+	{key: "next", terms: []string{"data center", "observer"}},
+}
+
+// StartTutorial switches to the guided walkthrough.
+func (m *Model) StartTutorial() {
+	m.stage = Tutorial
+	m.tutorialStep = 0
+	m.tutorialCfg = tutorialConfig
+	m.tutorialCfg.ZeroBasedPartitions = m.zeroBasedPartitions
+	m.tutorialDCs, _ = placement.CalculatePlacement(m.tutorialCfg)
+}
+
+// updateTutorial pages through the tutorial; Esc, or Enter on the last
+// page, leaves it for the wizard.
+func (m Model) updateTutorial(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m.quit()
+	case "esc", "q":
+		return m.restart(), nil
+	case "right", "l", "n", " ", "enter":
+		if m.tutorialStep == len(tutorialSteps)-1 {
+			return m.restart(), nil
+		}
+		m.tutorialStep++
+	case "left", "h", "p", "backspace":
+		if m.tutorialStep > 0 {
+			m.tutorialStep--
+		}
+	}
+	return m, nil
+}
+
+// tutorialView renders the current tutorial page.
+func (m Model) tutorialView() string {
+	step := tutorialSteps[m.tutorialStep]
+	width := min(max(m.width, 40), 100)
+	wrap := lipgloss.NewStyle().Width(width)
+
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("tutorial.title", m.tutorialStep+1, len(tutorialSteps), i18n.T("tutorial."+step.key+".title"))))
+	b.WriteString("\n")
+	for _, term := range step.terms {
+		if e, ok := glossary.Lookup(term); ok {
+			b.WriteString(wrap.Render(FocusedStyle.Render(e.Term+": ")+e.Text) + "\n\n")
+		}
+	}
+	b.WriteString(wrap.Render(i18n.T("tutorial."+step.key+".text")) + "\n")
+	if step.cluster {
+		b.WriteString("\n")
+		b.WriteString(m.tutorialClusterView(step.failures))
+	}
+	b.WriteString("\n")
+	keys := i18n.T("tutorial.help")
+	if m.tutorialStep == len(tutorialSteps)-1 {
+		keys = i18n.T("tutorial.helpLast")
+	}
+	b.WriteString(HelpStyle.Render(keys))
+	return b.String()
+}
+
+// tutorialClusterView lists the example brokers with their replicas and,
+// once brokers failed, what happens to every partition.
+func (m Model) tutorialClusterView(failures int) string {
+	brokerIDs := allBrokerIDs(m.tutorialDCs)
+	failed := make(map[int]bool)
+	for _, id := range brokerIDs[:min(failures, len(brokerIDs))] {
+		failed[id] = true
+	}
+
+	var b strings.Builder
+	b.WriteString(m.compactClusterView(m.tutorialCfg, m.tutorialDCs, failed))
+	if failures == 0 {
+		return b.String()
+	}
+
+	// What every partition looks like with the failed brokers gone. The
+	// example's controllers run on separate nodes that stay up, so the
+	// quorum is left out.
+	estimates := make(map[int]failover.Estimate)
+	for _, e := range failover.Simulate(m.tutorialDCs, failover.Quorum{}, m.failoverTiming) {
+		estimates[e.BrokerID] = e
+	}
+	b.WriteString("\n")
+	for _, p := range placement.Partitions(m.tutorialDCs) {
+		leader := p.Brokers[config.Leader][0]
+		var alive []int
+		for _, id := range append([]int{leader}, p.Brokers[config.Follower]...) {
+			if !failed[id] {
+				alive = append(alive, id)
+			}
+		}
+		line := fmt.Sprintf("p%d: ", m.tutorialCfg.PartitionNumber(p.ID))
+		switch {
+		case len(alive) == 0:
+			line += FailStyle.Render(i18n.T("tutorial.offline"))
+		case failed[leader]:
+			line += i18n.T("tutorial.leaderMoves", leader, alive[0], formatLeaderless(estimates[leader]))
+		default:
+			line += i18n.T("tutorial.leaderStays", leader)
+		}
+		if len(alive) > 0 {
+			status := PassStyle.Render(i18n.T("tutorial.inSync", len(alive)))
+			if len(alive) < m.tutorialCfg.MinInSyncReplicas {
+				status = FailStyle.Render(i18n.T("tutorial.underMinISR", len(alive), m.tutorialCfg.MinInSyncReplicas))
+			}
+			line += "; " + status
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+// compactClusterView lists every broker on one line with its replicas,
+// grouped by DC when there is more than one; failed brokers are marked.
+// Partitions are numbered as in cfg.
+func (m Model) compactClusterView(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, failed map[int]bool) string {
+	var b strings.Builder
+	for _, dcID := range sortedDCIDs(dcs) {
+		dc := dcs[dcID]
+		indent := ""
+		if len(dcs) > 1 {
+			b.WriteString(i18n.T("tutorial.dc", dcID) + "\n")
+			indent = "  "
+		}
+		for _, id := range sortedBrokerIDs(dc) {
+			broker := dc.Brokers[id]
+			b.WriteString(indent + i18n.T("tutorial.broker", id) + " ")
+			if failed[id] {
+				b.WriteString(FailStyle.Render(i18n.T("tutorial.failed")) + "\n")
+				continue
+			}
+			replicas := append([]config.ReplicaInfo(nil), broker.Replicas...)
+			sort.Slice(replicas, func(i, j int) bool { return replicas[i].PartitionID < replicas[j].PartitionID })
+			chips := make([]string, len(replicas))
+			for i, r := range replicas {
+				chips[i] = m.replicaStyle(r.Role, dcID).Render(fmt.Sprintf("p%d %s", cfg.PartitionNumber(r.PartitionID), roleName(r.Role)))
+			}
+			b.WriteString(strings.Join(chips, "  ") + "\n")
+		}
+	}
+	return b.String()
+}
+
+// allBrokerIDs returns the IDs of the brokers of every DC in ascending
+// order.
+func allBrokerIDs(dcs map[int]*config.DCInfo) []int {
+	var ids []int
+	for _, dc := range dcs {
+		for id := range dc.Brokers {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -4,13 +4,41 @@ import (
 	// Use the full module path for your internal packages
 	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
 	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/rules"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // Update handles messages and updates the TUI model. Required by Bubble Tea.
+// While replaying, a key plays the next recorded step instead, except
+// Ctrl+C and the Ctrl+S screenshot; while recording, the keys are added to
+// the recording.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, isKey := msg.(tea.KeyMsg)
+	// Imports run for as long as they take, so keys pressed meanwhile are
+	// neither recorded nor played
+	importing := m.stage == Importing
+	if isKey && m.replaying != nil && key.Type != tea.KeyCtrlC && key.String() != "ctrl+s" {
+		if importing {
+			return m, nil
+		}
+		if m.replaying.next < len(m.replaying.rec.Steps) {
+			return m.replayStep()
+		}
+		m.replaying = nil // Finished: the viewer's keys drive the session
+	}
+	next, cmd := m.update(msg)
+	// The key that quits is left out, so a replay ends on the last screen,
+	// and so are screenshots, which the replay need not save again
+	if isKey && m.recording != nil && !importing && !next.(Model).quitting && key.String() != "ctrl+s" {
+		m.recordKey(key)
+	}
+	return next, cmd
+}
+
+// update handles a message.
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
@@ -20,36 +48,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 		// Potentially update layout constraints here if needed
 
+	case assignmentTickMsg:
+		m.checkAssignment()
+		m.takeSnapshot()
+		return m, pollAssignment()
+
+	case importProgressMsg, importDoneMsg:
+		return m.updateImport(msg)
+
 	case tea.KeyMsg:
 		switch m.stage {
 		// --- Handling Keys in Input Stages ---
 		case AskSingleConfig, AskMRCConfig:
 			switch msg.Type {
 			case tea.KeyCtrlC, tea.KeyEsc:
-				return m, tea.Quit
+				return m.quit()
+
+			case tea.KeyCtrlT:
+				// Define several topics to place on the brokers of the form
+				return m, m.openTopicEditorFromForm()
 
 			case tea.KeyEnter:
 				// Check if focused on the last input field
 				if m.focused == len(m.inputs)-1 {
 					// Attempt to parse and validate all inputs
 					err := m.parseAndValidateInputs() // This now updates model fields directly
+					var cfg config.PlacementConfig
+					if err == nil {
+						cfg, err = m.placementConfig()
+					}
 					if err != nil {
 						m.err = err // Store error to display in View
 					} else {
 						// Validation successful, calculate placement
 						m.err = nil
-						m.stage = ShowPlacement
-						// Call placement logic from the placement package
-						m.dcs, m.mrcRecommendation = placement.CalculatePlacement(
-							config.PlacementConfig{
-								m.clusterType,
-								m.numPartitions,
-								m.replicationFactor,
-								m.minInSyncReplicas,
-								m.numBrokers, // Pass BrokersPerDC or TotalBrokers based on type
-								m.numDCs,
-							},
-						)
+						m.runPlacement(cfg)
 						// No command needed here, view will update based on new stage
 					}
 				} else {
@@ -67,6 +100,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.inputs[i].TextStyle = NoStyle
 						}
 					}
+					m.prefillDefault()
 				}
 				// Prevent Enter from being processed by the text input itself
 				return m, tea.Batch(cmds...)
@@ -99,6 +133,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.inputs[i].TextStyle = NoStyle
 					}
 				}
+				m.prefillDefault()
 			} // End switch msg.Type for input stages
 
 		// --- Handling Keys in Other Stages ---
@@ -109,23 +144,244 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.stage = AskSingleConfig
 				m.setupInputsForStage()                  // Setup inputs for the new stage
 				cmds = append(cmds, m.inputs[0].Focus()) // Focus first input
+			case "r", "R":
+				if m.lastSession != nil {
+					m.resume(m.lastSession)
+				}
+			case "t", "T":
+				m.StartTutorial()
+			case "q", "Q":
+				cmds = append(cmds, m.StartQuiz())
+			case "l", "L":
+				m.OpenLibrary()
+			case "c", "C":
+				cmds = append(cmds, m.OpenConnectKafka())
 			case "m", "M":
 				m.clusterType = config.MRC
 				m.stage = AskMRCConfig
 				m.setupInputsForStage()                  // Setup inputs for the new stage
 				cmds = append(cmds, m.inputs[0].Focus()) // Focus first input
 			case "ctrl+c": // Explicitly handle Ctrl+C here too
-				return m, tea.Quit
+				return m.quit()
 			}
 
+		case EditTopics:
+			return m.updateTopicEditor(msg)
+
+		case AskStrategy:
+			return m.updateStrategyForm(msg)
+
+		case Tutorial:
+			return m.updateTutorial(msg)
+
+		case Quiz:
+			return m.updateQuiz(msg)
+
+		case Library:
+			return m.updateLibrary(msg)
+
+		case Importing:
+			return m.updateImport(msg)
+
+		case ConnectKafka:
+			return m.updateConnectKafka(msg)
+
 		case ShowPlacement, ShowError:
-			// On Enter, reset to the beginning. On Esc/Ctrl+C, quit.
+			if msg.String() == "ctrl+s" && m.stage == ShowPlacement && !m.editingNote {
+				m.takeScreenshot()
+				return m, nil
+			}
+			m.screenshotSaved = "" // Shown until the next key
+			if m.editingNote {
+				return m.updateNoteInput(msg)
+			}
+			if m.showBrokerModal {
+				// The broker modal takes all keys until it is closed
+				switch msg.String() {
+				case "ctrl+c":
+					return m.quit()
+				case "esc", "enter", "q":
+					m.showBrokerModal = false
+				case "up", "k":
+					m.scrollModal(-1)
+				case "down", "j":
+					m.scrollModal(1)
+				case "pgup":
+					m.scrollModal(-m.modalPageSize(m.height))
+				case "pgdown", " ":
+					m.scrollModal(m.modalPageSize(m.height))
+				case "home", "g":
+					m.modalScroll = 0
+				case "end", "G":
+					m.scrollModal(m.numPartitions * m.replicationFactor)
+				}
+				return m, nil
+			}
+
+			// Arrow keys/Tab select a broker and Enter opens its details.
+			// Without a selection, Enter resets to the beginning and Esc quits.
 			switch msg.Type {
 			case tea.KeyEnter:
+				if m.stage == ShowPlacement && m.brokerSelected {
+					m.showBrokerModal = true
+					m.modalScroll = 0
+					return m, nil
+				}
 				// Reset the model to its initial state
-				return NewModel(), textinput.Blink // Return new model and blink command
-			case tea.KeyEsc, tea.KeyCtrlC:
-				return m, tea.Quit
+				return m.restart(), textinput.Blink // Return new model and blink command
+			case tea.KeyEsc:
+				if m.brokerSelected {
+					m.brokerSelected = false
+					return m, nil
+				}
+				return m.quit()
+			case tea.KeyCtrlC:
+				return m.quit()
+			case tea.KeyLeft, tea.KeyUp, tea.KeyShiftTab:
+				if m.stage == ShowPlacement {
+					m.moveSelection(-1)
+					m.scrollToSelection()
+				}
+			case tea.KeyRight, tea.KeyDown, tea.KeyTab:
+				if m.stage == ShowPlacement {
+					m.moveSelection(1)
+					m.scrollToSelection()
+				}
+			case tea.KeyPgUp:
+				if m.stage == ShowPlacement {
+					m.scrollPlacement(-1)
+				}
+			case tea.KeyPgDown:
+				if m.stage == ShowPlacement {
+					m.scrollPlacement(1)
+				}
+			}
+			switch msg.String() {
+			case "l", "L":
+				// Toggle the consumer lag overlay, if lag data was loaded
+				m.showLag = m.lagData != nil && !m.showLag
+			case "a", "A":
+				// Toggle between broker boxes and the accessible text summary
+				m.accessible = !m.accessible
+			case "h", "H":
+				// Toggle the partitions/leaders per broker histogram screen
+				m.showHistogram = !m.showHistogram
+			case "f", "F":
+				// Toggle the broker failure estimates pane
+				m.showFailover = !m.showFailover
+			case "d", "D":
+				// Pick the lost DC to show observer promotion commands for
+				if m.showFailover && m.clusterType == config.MRC {
+					ids := sortedDCIDs(m.dcs)
+					next := 0
+					for _, id := range ids {
+						if id > m.lostDC {
+							next = id
+							break
+						}
+					}
+					m.lostDC = next
+				}
+			case "g", "G":
+				// Toggle the health trend across earlier runs on this cluster
+				m.showTrend = !m.showTrend
+			case "u", "U":
+				// Toggle the under-replicated/offline partitions pane of a live assignment
+				m.showReplication = !m.showReplication
+			case "v", "V":
+				// Switch the KRaft controllers between combined and dedicated
+				if m.stage == ShowPlacement {
+					m.toggleControllers()
+				}
+			case "k", "K":
+				// Toggle the Kubernetes topology spread recommendation
+				m.showKubernetes = !m.showKubernetes
+			case "?":
+				// Collapse/expand the legend and help footer
+				m.hideFooter = !m.hideFooter
+			case "e", "E":
+				// Clone the scenario back into the input form, pre-filled
+				return m.cloneToInputs()
+			case "p", "P":
+				// Choose the strategy and tune its parameters
+				if m.stage == ShowPlacement {
+					return m, m.openStrategyForm()
+				}
+			case "t", "T":
+				// Edit the topic list, placing every topic on the same brokers
+				if m.stage == ShowPlacement {
+					return m, m.openTopicEditor()
+				}
+			case "[":
+				m.switchTopic(-1)
+			case "]":
+				m.switchTopic(1)
+			case "n", "N":
+				// Annotate the selected broker or the whole placement
+				if m.stage == ShowPlacement {
+					return m, m.openNoteInput()
+				}
+			case "c", "C":
+				// Cycle what replica chips and broker borders are colored by
+				m.colorMode = (m.colorMode + 1) % numColorModes
+				if base, _ := m.diffBase(); m.colorMode == ColorByDiff && base == nil {
+					m.colorMode = (m.colorMode + 1) % numColorModes // Nothing to diff against
+				}
+			case "b", "B":
+				// Toggle auto.leader.rebalance.enable in the failover pane
+				if m.showFailover {
+					m.leaderRebalance.Enabled = !m.leaderRebalance.Enabled
+				}
+			case "x", "X":
+				// Toggle the MirrorMaker 2 topic mapping pane, if an MM2 config was loaded
+				m.showMirror = m.mirrorCfg != nil && !m.showMirror
+			case "+", "=":
+				// What if the replication factor were one higher?
+				m.changeReplicationFactor(1)
+			case "-", "_":
+				m.changeReplicationFactor(-1)
+			case "o", "O":
+				// Toggle the per-tenant footprint of all topics
+				m.showTenants = !m.showTenants
+			case "i", "I":
+				// Toggle the ISR shrink/expand timeline
+				m.showISR = !m.showISR
+			case "w", "W":
+				// Toggle the consumer fetch locality report
+				m.showLocality = !m.showLocality
+			case "r", "R":
+				// Toggle the event timeline replay
+				m.showTimeline = !m.showTimeline
+			case "m", "M":
+				// Toggle the min ISR survivability matrix by failed DCs
+				m.showSurvivability = !m.showSurvivability
+			case "y", "Y":
+				// Toggle the DR comparison with two clusters and MM2
+				m.showDR = !m.showDR
+			case "j", "J":
+				// Toggle the log of warnings and live cluster events
+				m.toggleLog()
+			case "z", "Z":
+				// Toggle the snapshots of a live assignment
+				m.showSnapshots = m.liveAssignment && m.snapshotDir != "" && !m.showSnapshots
+			case "<", ",":
+				// Compare the live assignment with an older snapshot
+				if m.showSnapshots {
+					m.stepSnapshot(-1)
+				}
+			case ">", ".":
+				if m.showSnapshots {
+					m.stepSnapshot(1)
+				}
+			case "/":
+				// Cycle the broker tag highlighted in the placement
+				m.cycleTagFilter()
+			case "s", "S":
+				// Toggle the stats pane; the comparison report is computed on first use
+				m.showStats = !m.showStats
+				if m.showStats && m.comparison == nil {
+					m.comparison = m.compareStrategies()
+				}
 			}
 		} // End switch m.stage
 	} // End switch msg.(type)
@@ -133,12 +389,191 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// --- Handle Input Field Updates ---
 	// This needs to happen regardless of the key pressed if inputs are active
 	if m.stage == AskSingleConfig || m.stage == AskMRCConfig {
+		if key, ok := msg.(tea.KeyMsg); ok {
+			m.editDefault(key)
+		}
 		// Only update the focused input field? No, update all to handle blur/focus cmds.
 		for i := range m.inputs {
 			m.inputs[i], cmd = m.inputs[i].Update(msg)
 			cmds = append(cmds, cmd)
 		}
+		m.refreshDefaults()
+		m.updateFieldWarnings()
 	}
 
 	return m, tea.Batch(cmds...)
 }
+
+// placementConfig builds the placement configuration from the values
+// gathered by the input wizard.
+func (m Model) placementConfig() (config.PlacementConfig, error) {
+	topology := config.WithBrokers(m.numBrokers) // Total brokers
+	if m.clusterType == config.MRC {
+		topology = config.WithRacks(m.numDCs, m.numBrokers) // Brokers per DC
+		if len(m.rackSizes) > 0 {
+			topology = config.WithRackSizes(m.rackSizes...) // Uneven racks
+		}
+	}
+	opts := []config.Option{topology}
+	if m.clusterType == config.SingleCluster {
+		// Racks of a single cluster, after the brokers they are assigned
+		if m.brokerRacks != nil {
+			opts = append(opts, config.WithBrokerRacks(m.brokerRacks))
+		} else if m.rackCount > 0 {
+			opts = append(opts, config.WithAutoRacks(m.rackCount))
+		}
+	}
+	cfg, err := config.NewPlacementConfig(m.numPartitions, m.replicationFactor, m.minInSyncReplicas, opts...)
+	if err != nil {
+		return config.PlacementConfig{}, err
+	}
+	return m.withSessionOptions(cfg), nil
+}
+
+// withSessionOptions applies the session-wide placement options (strategy
+// and its parameters, partition weights, broker tags, partition numbering)
+// to cfg.
+func (m Model) withSessionOptions(cfg config.PlacementConfig) config.PlacementConfig {
+	cfg.Strategy = m.strategy
+	cfg.Goals = m.goals
+	cfg.MaxMoves = m.maxMoves
+	cfg.LooseRacks = m.looseRacks
+	cfg.NoLeaderSpread = m.noLeaderSpread
+	cfg.Seed = m.seed
+	cfg.Controllers = m.controllers
+	cfg.ControllerCount = m.controllerCount
+	cfg.ZeroBasedPartitions = m.zeroBasedPartitions
+	if m.brokerTags != nil {
+		cfg.BrokerTags = m.brokerTags
+	}
+	if len(m.avoidLeaders) > 0 {
+		cfg.AvoidLeaders = m.avoidLeaders
+	}
+	if len(m.clientTraffic) > 0 {
+		cfg.ClientTraffic = m.clientTraffic
+	}
+	if m.weights != nil {
+		// Measured per-partition loads take precedence over a topic's size estimate
+		if loads := m.weights.ForTopic(cfg.TopicName); len(loads) > 0 || cfg.PartitionLoads == nil {
+			cfg.PartitionLoads = loads
+		}
+	}
+	return cfg
+}
+
+// restart returns a fresh model at the first wizard stage, keeping
+// session-wide settings such as loaded lag data.
+func (m Model) restart() Model {
+	nm := NewModel()
+	nm.SetStrategy(m.strategy)
+	nm.SetWeights(m.weights)
+	nm.SetGoals(m.goals, m.maxMoves)
+	nm.looseRacks, nm.noLeaderSpread = m.looseRacks, m.noLeaderSpread
+	nm.SetSeed(m.seed)
+	nm.SetControllers(m.controllers, m.controllerCount)
+	nm.SetActiveController(m.activeController)
+	nm.SetBrokerRacks(m.rackCount, m.brokerRacks)
+	nm.SetBrokerTags(m.brokerTags, m.avoidLeaders)
+	nm.SetClientTraffic(m.clientTraffic)
+	nm.SetRules(m.rules)
+	nm.SetAccessible(m.accessible)
+	nm.SetZeroBasedPartitions(m.zeroBasedPartitions)
+	nm.SetFailoverTiming(m.failoverTiming)
+	nm.SetLeaderRebalance(m.leaderRebalance)
+	nm.SetWaveLimits(m.waveLimits)
+	nm.SetBrokerCapacity(m.capacityDefaults, m.brokerCapacity)
+	nm.placements = m.placements
+	nm.showFailover = m.showFailover
+	nm.showKubernetes = m.showKubernetes
+	nm.showReplication = m.showReplication
+	nm.colorMode = m.colorMode
+	if nm.colorMode == ColorByDiff {
+		nm.colorMode = ColorByRole // The diff base is gone
+	}
+	nm.hideFooter = m.hideFooter
+	nm.SetLastSession(m.lastSession)
+	nm.SetHistory(m.history)
+	nm.showTrend = m.showTrend
+	nm.SetTopics(m.topicSpecs)
+	nm.SetLagData(m.lagData, m.lagThreshold)
+	nm.showLag = m.showLag
+	nm.SetMirrorMapping(m.mirrorCfg, m.mirrorFlows)
+	nm.showMirror = m.showMirror
+	nm.showTenants = m.showTenants
+	nm.showSurvivability = m.showSurvivability
+	nm.SetMirrorLag(m.mirrorLag)
+	nm.showDR = m.showDR
+	nm.SetISRTimeline(m.isrLagTimeMax, m.isrEvents)
+	nm.showISR = m.showISR
+	nm.SetConsumers(m.consumers)
+	nm.showLocality = m.showLocality
+	nm.SetTimeline(m.timelineEvents, m.reassignThrottle, m.waveTime)
+	nm.SetReplicationThrottle(m.replicationRate)
+	nm.showTimeline = m.showTimeline
+	nm.showLog, nm.logSeen = m.showLog, m.logSeen
+	nm.SetKafkaOptions(m.kafkaBootstrap, m.kafkaOpts)
+	nm.recording, nm.replaying = m.recording, m.replaying
+	nm.SetScreenshots(m.screenshotDir, m.screenshotFormat)
+	return nm
+}
+
+// runPlacement calculates the placement for cfg via the placement package
+// and switches to the placement view.
+func (m *Model) runPlacement(cfg config.PlacementConfig) {
+	if len(m.topicSpecs) > 0 {
+		// A loaded topic list replaces the single topic of the wizard/import
+		if err := m.placeTopics(cfg, m.topicSpecs); err != nil {
+			m.err = err
+			m.stage = ShowError
+		}
+		return
+	}
+	m.topics = nil // Back to a single topic
+	dcs, recommendation := m.placements.CalculatePlacement(cfg)
+	m.showPlacement(cfg, dcs, recommendation)
+}
+
+// showPlacement switches to the placement view for an already calculated
+// placement of cfg.
+func (m *Model) showPlacement(cfg config.PlacementConfig, dcs map[int]*config.DCInfo, recommendation string) {
+	m.placementCfg = cfg
+	m.dcs, m.mrcRecommendation = dcs, recommendation
+	m.liveAssignment = false
+	m.rfBase, m.rfErr = nil, nil
+	m.rackViolations = placement.NewAssignment(dcs, nil).RackViolations()
+	m.baselineDCs = nil
+	m.comparison = nil
+	m.brokerSelected = false
+	m.showBrokerModal = false
+	m.placementScroll = 0
+	m.lostDC = 0
+	m.ruleResults = rules.Evaluate(m.rules, cfg, m.dcs)
+	if cfg.Strategy != config.StrategyRandom {
+		// Keep a random-strategy placement around as the "before" picture
+		baseline := cfg
+		baseline.Strategy = config.StrategyRandom
+		m.baselineDCs, _ = m.placements.CalculatePlacement(baseline)
+	}
+	if !m.diffing() && m.colorMode == ColorByDiff {
+		m.colorMode = ColorByRole
+	}
+	m.stage = ShowPlacement
+}
+
+// compareStrategies scores every strategy on the current configuration with
+// the registered scorers (or the configured goal subset).
+func (m Model) compareStrategies() *placement.Comparison {
+	scorers, err := placement.ResolveScorers(m.placementCfg.Goals)
+	if err != nil {
+		scorers = placement.Scorers()
+	}
+	c := placement.Compare(m.placementCfg, scorers, m.dcs)
+	return &c
+}
+
+// quit ends the program. The final frame is left blank so that inline mode
+// can print the complete placement (see PrintView) instead of a clipped frame.
+func (m Model) quit() (tea.Model, tea.Cmd) {
+	m.quitting = true
+	return m, tea.Quit
+}
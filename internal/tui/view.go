@@ -4,44 +4,71 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	// Use the full module path for your internal packages
 	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/deploy"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/health"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/lag"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/runbook"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/session"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
 // View renders the UI based on the current model state. Required by Bubble Tea.
 func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
 	var b strings.Builder
 
 	// --- Title ---
-	b.WriteString(TitleStyle.Render("Kafka Partition Visualizer"))
+	b.WriteString(TitleStyle.Render(i18n.T("app.title")))
 	b.WriteString("\n\n")
+	b.WriteString(m.replayBanner())
 
 	// --- Content Based on Stage ---
 	switch m.stage {
 	case AskClusterType:
-		b.WriteString("Select cluster type:\n\n")
-		b.WriteString("[S] Single Cluster\n")
-		b.WriteString("[M] Multi-Region Cluster (MRC)\n\n")
-		b.WriteString(HelpStyle.Render("(Press S or M. Ctrl+C to quit)"))
+		b.WriteString(i18n.T("menu.title") + "\n\n")
+		for _, key := range []string{"menu.single", "menu.mrc", "menu.tutorial", "menu.quiz", "menu.library", "menu.connect"} {
+			b.WriteString(i18n.T(key) + "\n")
+		}
+		if s := m.lastSession; s != nil {
+			desc := i18n.T("menu.session", s.Config.NumPartitions, s.Config.ReplicationFactor)
+			if s.Config.TopicName != "" {
+				desc = s.Config.TopicName + ", " + desc
+			}
+			b.WriteString(i18n.T("menu.resume", desc, s.SavedAt.Local().Format("2006-01-02 15:04")) + "\n\n")
+			b.WriteString(HelpStyle.Render(i18n.T("menu.help.resume")))
+		} else {
+			b.WriteString("\n")
+			b.WriteString(HelpStyle.Render(i18n.T("menu.help")))
+		}
 
 	case AskSingleConfig, AskMRCConfig:
-		title := "Enter Single Cluster Configuration:"
-		var labels []string
+		title := i18n.T("form.title.single")
 		if m.stage == AskMRCConfig {
-			title = "Enter MRC Configuration:"
-			labels = []string{"Data Centers:", "Brokers per DC:", "Partitions:", "Replication Factor:", "Min ISR:"}
-		} else {
-			labels = []string{"Total Brokers:", "Partitions:", "Replication Factor:", "Min ISR:"}
+			title = i18n.T("form.title.mrc")
 		}
 		b.WriteString(title + "\n\n")
 
 		// Display labels and input fields
+		labels := fieldLabels(m.stage)
 		for i := range m.inputs {
-			b.WriteString(labels[i] + "\n")
+			b.WriteString(i18n.T("form.label", labels[i]) + "\n")
 			b.WriteString(m.inputs[i].View())
+			if i < len(m.defaulted) && m.defaulted[i] {
+				b.WriteString(HelpStyle.Render(i18n.T("form.suggested")))
+			}
+			if i < len(m.fieldWarnings) && m.fieldWarnings[i] != "" {
+				b.WriteString("\n" + WarnStyle.Render("  ⚠ "+m.fieldWarnings[i]))
+			}
 			// Add spacing between input fields, but not after the last one
 			if i < len(m.inputs)-1 {
 				b.WriteString("\n\n") // More spacing
@@ -53,120 +80,1067 @@ func (m Model) View() string {
 		// Display error if present
 		if m.err != nil {
 			b.WriteString("\n") // Add space before error
-			b.WriteString(ErrorStyle.Render("Error: " + m.err.Error()))
+			if lines := errorLines(m.err); len(lines) > 1 {
+				b.WriteString(ErrorStyle.Render(i18n.T("form.problems", len(lines))))
+				for _, line := range lines {
+					b.WriteString("\n" + ErrorStyle.Render("  • "+line))
+				}
+			} else {
+				b.WriteString(ErrorStyle.Render(i18n.T("error.title", m.err.Error())))
+			}
 			b.WriteString("\n\n")
 		} else {
 			b.WriteString("\n\n") // Add spacing even if no error
 		}
 
-		b.WriteString(HelpStyle.Render("Use Tab/Shift+Tab or Up/Down to navigate. Enter to confirm/move next. Ctrl+C to quit."))
+		// Explain the focused field
+		for _, e := range m.focusedFieldHelp() {
+			text := lipgloss.NewStyle().Width(min(max(m.width, 40), 100)).Render(e.Term + ": " + e.Text)
+			b.WriteString(HelpStyle.Render(text) + "\n\n")
+		}
+
+		b.WriteString(HelpStyle.Render(i18n.T("form.help")))
 
 	case ShowPlacement:
-		b.WriteString("Partition Placement Visualization:\n\n")
-		if m.clusterType == config.MRC && m.mrcRecommendation != "" {
-			b.WriteString(fmt.Sprintf("MRC Recommendation: %s\n\n", m.mrcRecommendation))
+		if m.showBrokerModal {
+			b.WriteString(m.brokerModalView())
+			break
+		}
+		if m.accessible {
+			b.WriteString(m.accessibleView())
+			b.WriteString("\n\n")
+			b.WriteString(m.placementHelp())
+			break
+		}
+		if m.tinyTerminal() {
+			b.WriteString(m.condensedView())
+			break
 		}
 
-		// Sort DC IDs for consistent display order
-		dcIDs := make([]int, 0, len(m.dcs))
-		for id := range m.dcs {
-			dcIDs = append(dcIDs, id)
+		partitionLag := m.overlayLag()
+		header := m.placementHeader(partitionLag)
+		b.WriteString(header)
+
+		if m.showHistogram {
+			b.WriteString(m.histogramView())
+			b.WriteString("\n\n")
+			b.WriteString(m.placementHelp())
+			break
 		}
-		sort.Ints(dcIDs)
 
-		var dcViews []string // Store rendered views for each DC
+		footer := m.placementFooter(partitionLag != nil)
+		b.WriteString(m.brokerBoxesView(partitionLag, m.boxesHeight(header, footer)))
+		b.WriteString(footer)
 
-		for _, dcID := range dcIDs {
-			dc := m.dcs[dcID]
-			var dcBuilder strings.Builder
+	case EditTopics:
+		b.WriteString(m.topicEditorView())
+
+	case AskStrategy:
+		b.WriteString(m.strategyFormView())
+
+	case Tutorial:
+		b.WriteString(m.tutorialView())
+
+	case Quiz:
+		b.WriteString(m.quizView())
+
+	case Library:
+		b.WriteString(m.libraryView())
+
+	case Importing:
+		b.WriteString(m.importView())
+
+	case ConnectKafka:
+		b.WriteString(m.connectKafkaView())
+
+	case ShowError:
+		// Display a general error message if we land in this state
+		// Specific validation errors are shown in the input stages
+		errMsg := i18n.T("error.unexpected")
+		if m.err != nil {
+			errMsg = m.err.Error()
+		}
+		b.WriteString(ErrorStyle.Render(i18n.T("error.title", errMsg)))
+		b.WriteString("\n\n")
+		b.WriteString(HelpStyle.Render(i18n.T("error.help")))
+	}
+
+	return terminalText(b.String())
+}
+
+// overlayLag returns the consumer lag keyed by the displayed (1-based)
+// partition ID, or nil when the lag overlay is off.
+func (m Model) overlayLag() map[int]lag.PartitionLag {
+	if !m.showLag || m.lagData == nil {
+		return nil
+	}
+	return m.displayedLag()
+}
+
+// placementHeader renders everything above the broker boxes.
+func (m Model) placementHeader(partitionLag map[int]lag.PartitionLag) string {
+	var b strings.Builder
+	b.WriteString(i18n.T("placement.title") + "\n\n")
+	b.WriteString(m.clusterMetadataView())
+	if m.source != "" {
+		b.WriteString(HelpStyle.Render(m.source) + "\n")
+	}
+	b.WriteString(m.scenarioNotes())
+	b.WriteString(m.annotationsView())
+	if len(m.topics) > 1 {
+		b.WriteString(m.topicTabs() + "\n")
+	} else if m.placementCfg.TopicName != "" {
+		b.WriteString(i18n.T("placement.topic", m.placementCfg.TopicName) + "\n")
+	}
+	if m.liveAssignment {
+		b.WriteString(i18n.T("placement.strategy.live") + "\n")
+	} else {
+		strategy := m.placementCfg.Strategy.String()
+		if m.placementCfg.Seed != 0 {
+			strategy += i18n.T("placement.seed", m.placementCfg.Seed)
+		}
+		b.WriteString(i18n.T("placement.strategy", strategy) + "\n")
+	}
+	b.WriteString(healthLine(m.health()) + "\n")
+	b.WriteString(m.logBadge())
+	if m.screenshotSaved != "" {
+		b.WriteString(PassStyle.Render(i18n.T("screenshot.saved", m.screenshotSaved)) + "\n")
+	}
+	if breaches := m.Breaches(); len(breaches) > 0 {
+		names := make([]string, len(breaches))
+		for i, r := range breaches {
+			names[i] = r.Rule
+		}
+		b.WriteString(FailStyle.Render(i18n.T("rules.breached", i18n.Plural(len(breaches), "rule"), strings.Join(names, "; "))) + "\n")
+	}
+	if status := m.constraintStatus(); status != "" {
+		b.WriteString(status + "\n")
+	}
+	b.WriteString(m.quorumStatus())
+	b.WriteString(m.tagStatus())
+	b.WriteString(m.affinityStatus())
+	b.WriteString(m.mirrorStatus())
+	b.WriteString(m.capacityStatus())
+	b.WriteString(m.rfView())
+	b.WriteString(m.driftBanner())
+	b.WriteString(m.reassignmentView())
+	b.WriteString("\n")
+	if m.clusterType == config.MRC && m.mrcRecommendation != "" {
+		b.WriteString(i18n.T("placement.recommendation", m.mrcRecommendation) + "\n")
+		b.WriteString(m.observerStatus() + "\n")
+	}
+	if partitionLag != nil {
+		b.WriteString(m.lagSummary(partitionLag))
+		b.WriteString("\n\n")
+	}
+	return b.String()
+}
+
+// health scores the placement on screen.
+func (m Model) health() health.Report {
+	return health.Evaluate(m.dcs, m.placementCfg.PartitionLoads)
+}
+
+// healthLine renders the overall health score with its breakdown, green
+// from 80 and red below 50.
+func healthLine(r health.Report) string {
+	line := i18n.T("health.line", r.Score, healthSummary(r))
+	switch {
+	case r.Score >= 80:
+		return PassStyle.Render(line)
+	case r.Score < 50:
+		return FailStyle.Render(line)
+	}
+	return line
+}
+
+// healthSummary renders the breakdown on one line like Report.Summary, with
+// the component names translated.
+func healthSummary(r health.Report) string {
+	parts := make([]string, len(r.Components))
+	for i, c := range r.Components {
+		parts[i] = fmt.Sprintf("%s %d", i18n.T("health.name."+c.Name), c.Score)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// healthView renders the health breakdown with what each component is
+// based on.
+func (m Model) healthView() string {
+	var b strings.Builder
+	r := m.health()
+	b.WriteString(DCHeaderStyle.Render(i18n.T("health.title", r.Score)))
+	b.WriteString("\n")
+	for _, c := range r.Components {
+		b.WriteString(fmt.Sprintf("%-18s %3d  %s %s\n", i18n.T("health.name."+c.Name), c.Score, i18n.T("health.weight", c.Weight), HelpStyle.Render(c.Detail)))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// trendRows caps the snapshots listed in the trend pane; the sparklines
+// chart all of them.
+const trendRows = 8
+
+// trendSeries is one charted metric of the trend pane.
+type trendSeries struct {
+	name  string
+	value func(s session.Snapshot) float64
+	max   float64 // Scale of the sparkline; 0 scales to the largest value
+}
+
+// trendView charts how health and balance evolved across the saved
+// snapshots of this cluster, ending with the current placement.
+func (m Model) trendView() string {
+	var b strings.Builder
+	key := m.clusterKey()
+	b.WriteString(DCHeaderStyle.Render(i18n.T("trend.title", key)))
+	b.WriteString("\n")
+	var snapshots []session.Snapshot
+	for _, s := range m.history {
+		if s.Cluster == key {
+			snapshots = append(snapshots, s)
+		}
+	}
+	if len(snapshots) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("trend.none")))
+		return b.String()
+	}
+	snapshots = append(snapshots, *m.Snapshot())
+
+	series := []trendSeries{
+		{i18n.T("trend.health"), func(s session.Snapshot) float64 { return float64(s.Health.Score) }, 100},
+		{i18n.T("trend.replicaImbalance"), func(s session.Snapshot) float64 { return s.ReplicaImbalance }, 0},
+		{i18n.T("trend.leaderImbalance"), func(s session.Snapshot) float64 { return s.LeaderImbalance }, 0},
+	}
+	for _, c := range snapshots[len(snapshots)-1].Health.Components {
+		name := c.Name
+		series = append(series, trendSeries{i18n.T("health.name." + name), func(s session.Snapshot) float64 { return float64(componentScore(s.Health, name)) }, 100})
+	}
+	for _, sr := range series {
+		values := make([]float64, len(snapshots))
+		for i, s := range snapshots {
+			values[i] = sr.value(s)
+		}
+		first, last := values[0], values[len(values)-1]
+		b.WriteString(fmt.Sprintf("%-18s %s  %s -> %s\n", sr.name, sparkline(values, sr.max), formatTrendValue(first), formatTrendValue(last)))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%-17s %-11s %-7s %-9s %s\n", i18n.T("trend.saved"), i18n.T("trend.strategy"), i18n.T("trend.health"), i18n.T("trend.replicas"), i18n.T("trend.leaders")))
+	shown := snapshots[max(0, len(snapshots)-trendRows):]
+	for i, s := range shown {
+		when := s.SavedAt.Local().Format("2006-01-02 15:04")
+		if i == len(shown)-1 {
+			when = i18n.T("trend.now")
+		}
+		b.WriteString(fmt.Sprintf("%-17s %-11s %-7d %-9.2f %.2f\n", when, s.Strategy, s.Health.Score, s.ReplicaImbalance, s.LeaderImbalance))
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("trend.footer", i18n.Plural(len(snapshots)-1, "earlier run"))))
+	return b.String()
+}
+
+// componentScore returns the score of a named health component, 0 if the
+// report has no such component.
+func componentScore(r health.Report, name string) int {
+	for _, c := range r.Components {
+		if c.Name == name {
+			return c.Score
+		}
+	}
+	return 0
+}
+
+// sparkBlocks are the sparkline levels, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline charts values as one block per value, scaled to 0..scale (or
+// to the largest value when scale is 0).
+func sparkline(values []float64, scale float64) string {
+	if scale == 0 {
+		for _, v := range values {
+			scale = max(scale, v)
+		}
+	}
+	var b strings.Builder
+	for _, v := range values {
+		level := 0
+		if scale > 0 {
+			level = int(v / scale * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[min(max(level, 0), len(sparkBlocks)-1)])
+	}
+	return b.String()
+}
+
+// formatTrendValue prints scores as integers and ratios with two decimals.
+func formatTrendValue(v float64) string {
+	if v == float64(int(v)) {
+		return fmt.Sprintf("%d", int(v))
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+// driftBanner renders the comparison of the live assignment with the
+// approved placement, listing up to 10 drifted partitions.
+func (m Model) driftBanner() string {
+	if !m.driftChecked || !m.liveAssignment {
+		return ""
+	}
+	if len(m.drift) == 0 {
+		return PassStyle.Render(i18n.T("drift.none")) + "\n"
+	}
+	var b strings.Builder
+	b.WriteString(FailStyle.Render(i18n.T("drift.found", i18n.Plural(len(m.drift), "partition"))) + "\n")
+	shown := m.drift[:min(len(m.drift), 10)]
+	for _, d := range shown {
+		b.WriteString(ErrorStyle.Render(fmt.Sprintf("  p%d: %s", m.placementCfg.PartitionNumber(d.Partition+1), d.Reason)) + "\n")
+	}
+	if n := len(m.drift) - len(shown); n > 0 {
+		b.WriteString(ErrorStyle.Render("  "+i18n.T("list.more", n)) + "\n")
+	}
+	return b.String()
+}
 
-			// Add DC header only for MRC setups
-			if m.clusterType == config.MRC {
-				dcBuilder.WriteString(DCHeaderStyle.Render(fmt.Sprintf("Data Center %d:", dcID)))
-				// No newline needed here, header style has margin
+// constraintStatus renders the rack/DC anti-affinity check: shown for
+// imported clusters, and for any placement with violations. Brokers of an
+// imported cluster without a zone have no broker.rack.
+func (m Model) constraintStatus() string {
+	if m.source == "" && len(m.rackViolations) == 0 {
+		return ""
+	}
+	var problems []string
+	if n := len(m.rackViolations); n > 0 {
+		ids := m.rackViolations[:min(n, 10)]
+		list := make([]string, len(ids))
+		for i, id := range ids {
+			list[i] = fmt.Sprintf("p%d", m.placementCfg.PartitionNumber(id))
+		}
+		if n > len(ids) {
+			list = append(list, "...")
+		}
+		problems = append(problems, i18n.T("constraints.violations", i18n.Plural(n, "partition"), strings.Join(list, ", ")))
+	}
+	if m.source != "" {
+		unracked := 0
+		for _, dc := range m.dcs {
+			if dc.Name == "" {
+				unracked += len(dc.Brokers)
 			}
+		}
+		if unracked > 0 {
+			problems = append(problems, i18n.T("constraints.unracked", i18n.Plural(unracked, "broker")))
+		}
+	}
+	if len(problems) == 0 {
+		return PassStyle.Render(i18n.T("constraints.ok"))
+	}
+	return FailStyle.Render(i18n.T("constraints.failed", strings.Join(problems, "; ")))
+}
+
+// placementFooter renders everything below the broker boxes: rule results,
+// the stats pane, the legend and the key help.
+func (m Model) placementFooter(showLag bool) string {
+	var b strings.Builder
+	if len(m.ruleResults) > 0 {
+		b.WriteString("\n\n")
+		b.WriteString(m.rulesView())
+	}
+	if naming := m.namingView(); naming != "" {
+		b.WriteString("\n\n")
+		b.WriteString(naming)
+	}
+
+	if m.showStats {
+		b.WriteString("\n\n")
+		b.WriteString(m.healthView())
+		b.WriteString("\n\n")
+		b.WriteString(m.networkStatsView())
+		b.WriteString("\n\n")
+		b.WriteString(m.headroomView())
+		b.WriteString("\n\n")
+		b.WriteString(m.comparisonView())
+	}
 
-			// Sort Broker IDs within the DC
-			brokerIDs := make([]int, 0, len(dc.Brokers))
-			for id := range dc.Brokers {
-				brokerIDs = append(brokerIDs, id)
+	if m.showFailover {
+		b.WriteString("\n\n")
+		b.WriteString(m.failoverView())
+	}
+
+	if m.showReplication && m.liveAssignment && m.liveData != nil {
+		b.WriteString("\n\n")
+		b.WriteString(m.replicationView())
+	}
+
+	if m.showSnapshots && m.liveAssignment && m.liveData != nil {
+		b.WriteString("\n\n")
+		b.WriteString(m.snapshotsView())
+	}
+
+	if m.showISR {
+		b.WriteString("\n\n")
+		b.WriteString(m.isrView())
+	}
+
+	if m.showLocality {
+		b.WriteString("\n\n")
+		b.WriteString(m.localityView())
+	}
+
+	if m.showTimeline {
+		b.WriteString("\n\n")
+		b.WriteString(m.timelineView())
+	}
+
+	if m.showLog {
+		b.WriteString("\n\n")
+		b.WriteString(m.logView())
+	}
+
+	if m.showTrend {
+		b.WriteString("\n\n")
+		b.WriteString(m.trendView())
+	}
+
+	if m.showMirror && m.mirrorCfg != nil {
+		b.WriteString("\n\n")
+		b.WriteString(m.mirrorView())
+	}
+
+	if m.showTenants {
+		b.WriteString("\n\n")
+		b.WriteString(m.tenantView())
+	}
+
+	if m.showSurvivability {
+		b.WriteString("\n\n")
+		b.WriteString(m.survivabilityView())
+	}
+
+	if m.showDR {
+		b.WriteString("\n\n")
+		b.WriteString(m.drView())
+	}
+
+	if m.showKubernetes {
+		b.WriteString("\n\n")
+		b.WriteString(DCHeaderStyle.Render(i18n.T("placement.kubernetes")))
+		b.WriteString("\n")
+		b.WriteString(strings.TrimSuffix(deploy.KubernetesSpread(m.dcs), "\n"))
+	}
+
+	// --- Legend ---
+	if !m.hideFooter {
+		b.WriteString("\n\n")
+		b.WriteString(m.legendView(sortedDCIDs(m.dcs), showLag))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(m.placementHelp())
+	return b.String()
+}
+
+// renderBroker renders one broker box. heat is the heatmap shading (nil
+// unless a heatmap mode is active) and brokerBytes the per-broker disk usage
+// (nil unless partition sizes are known).
+func (m Model) renderBroker(dcID int, broker *config.BrokerInfo, heat map[int]int, partitionLag map[int]lag.PartitionLag, brokerBytes map[int]int64) string {
+	var brokerBuilder strings.Builder
+	brokerBuilder.WriteString(i18n.T("placement.broker", broker.ID) + "\n") // Add newline after Broker ID
+	if brokerBytes != nil {
+		brokerBuilder.WriteString(HelpStyle.Render(fmt.Sprintf(" %s", formatBytes(brokerBytes[broker.ID]))) + "\n")
+	}
+
+	if m.diffing() {
+		base, _ := m.diffBase()
+		chips := brokerDiffChips(base, broker)
+		if len(chips) == 0 {
+			brokerBuilder.WriteString(HelpStyle.Render("  " + i18n.T("placement.empty")))
+		}
+		brokerBuilder.WriteString(m.renderDiffChips(chips))
+	} else if len(broker.Replicas) == 0 {
+		brokerBuilder.WriteString(HelpStyle.Render("  " + i18n.T("placement.empty")))
+	} else {
+		// Sort replicas by partition ID within the broker for clarity
+		sort.Slice(broker.Replicas, func(i, j int) bool {
+			return broker.Replicas[i].PartitionID < broker.Replicas[j].PartitionID
+		})
+
+		// Render each replica with appropriate style
+		for _, replica := range broker.Replicas {
+			style := m.replicaStyle(replica.Role, dcID)
+			if heat != nil {
+				// Chips reset the terminal colors, so they carry the box background too
+				style = style.Background(heatPalette[heat[broker.ID]])
 			}
-			sort.Ints(brokerIDs)
-
-			var brokerViews []string // Store rendered views for each broker box
-
-			for _, brokerID := range brokerIDs {
-				broker := dc.Brokers[brokerID]
-				var brokerBuilder strings.Builder
-				brokerBuilder.WriteString(fmt.Sprintf("Broker %d:\n", broker.ID)) // Add newline after Broker ID
-
-				if len(broker.Replicas) == 0 {
-					brokerBuilder.WriteString(HelpStyle.Render("  (empty)"))
-				} else {
-					// Sort replicas by partition ID within the broker for clarity
-					sort.Slice(broker.Replicas, func(i, j int) bool {
-						return broker.Replicas[i].PartitionID < broker.Replicas[j].PartitionID
-					})
-
-					// Render each replica with appropriate style
-					for _, replica := range broker.Replicas {
-						pStr := fmt.Sprintf(" p%d", replica.PartitionID) // Add space before pX
-						switch replica.Role {
-						case config.Leader:
-							brokerBuilder.WriteString(LeaderStyle.Render(pStr))
-						case config.Follower:
-							brokerBuilder.WriteString(FollowerStyle.Render(pStr))
-						case config.Observer:
-							// Only show observer style if it's actually MRC
-							if m.clusterType == config.MRC {
-								brokerBuilder.WriteString(ObserverStyle.Render(pStr))
-							} else {
-								// Should not happen based on placement logic, but fallback
-								brokerBuilder.WriteString(FollowerStyle.Render(pStr))
-							}
-						}
-					}
+			// Consumers fetch from the leader, so lag is shown on leader replicas
+			if pl, ok := partitionLag[replica.PartitionID]; ok && replica.Role == config.Leader {
+				if pl.Stuck() {
+					style = style.Inherit(LagStuckStyle)
+				} else if m.lagThreshold > 0 && pl.Lag >= m.lagThreshold {
+					style = style.Inherit(LagHotStyle)
 				}
-				// Apply box style to the individual broker's content
-				brokerViews = append(brokerViews, BrokerBoxStyle.Render(brokerBuilder.String()))
 			}
-
-			// Join broker boxes horizontally for the current DC
-			// Add newline after header if MRC
-			if m.clusterType == config.MRC {
-				dcBuilder.WriteString("\n") // Add space below DC header
+			if m.tagDimmed(broker.ID) {
+				style = HelpStyle
 			}
-			dcBuilder.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, brokerViews...))
-			dcViews = append(dcViews, dcBuilder.String())
+			brokerBuilder.WriteString(style.Render(m.chipText(replica, partitionLag)))
 		}
+	}
+	// Apply box style to the individual broker's content
+	boxStyle := BrokerBoxStyle
+	if m.colorMode == ColorByDC {
+		boxStyle = boxStyle.BorderForeground(DCColor(dcID))
+	}
+	if heat != nil {
+		boxStyle = boxStyle.Background(heatPalette[heat[broker.ID]])
+	}
+	if color, ok := capacityBorder(m.capacityUsage(), broker.ID); ok {
+		boxStyle = boxStyle.BorderForeground(color)
+	}
+	if m.tagDimmed(broker.ID) {
+		boxStyle = boxStyle.BorderForeground(BlurredStyle.GetForeground())
+	}
+	if m.outOfSyncBrokers()[broker.ID] {
+		boxStyle = boxStyle.Border(outOfSyncBorder).BorderForeground(errorColor)
+	}
+	if m.brokerSelected && broker.ID == m.selectedBroker {
+		boxStyle = boxStyle.Border(selectedBorder).BorderForeground(focusColor)
+	}
+	return boxStyle.Render(brokerBuilder.String())
+}
 
-		// Join all DC views vertically
-		b.WriteString(lipgloss.JoinVertical(lipgloss.Left, dcViews...))
+// chipText returns the unstyled text of a replica chip, with the consumer
+// lag appended to leaders when the overlay is on.
+func (m Model) chipText(replica config.ReplicaInfo, partitionLag map[int]lag.PartitionLag) string {
+	pStr := fmt.Sprintf(" p%d", m.placementCfg.PartitionNumber(replica.PartitionID)) // Add space before pX
+	if pl, ok := partitionLag[replica.PartitionID]; ok && replica.Role == config.Leader {
+		pStr += "·" + formatCount(pl.Lag)
+	}
+	return pStr
+}
 
-		// --- Legend ---
-		b.WriteString("\n\nLegend: ")
-		b.WriteString(LeaderStyle.Render("Leader (pX)"))
+// heatMap returns the heatmap shading for the current coloring mode, keyed
+// by broker ID, or nil when no heatmap mode is active.
+func (m Model) heatMap() map[int]int {
+	switch m.colorMode {
+	case ColorHeatReplicas:
+		return heatLevels(m.dcs, nil)
+	case ColorHeatLeaders:
+		leader := config.Leader
+		return heatLevels(m.dcs, &leader)
+	}
+	return nil
+}
+
+// legendView renders the legend for the current coloring mode.
+func (m Model) legendView(dcIDs []int, showLag bool) string {
+	if m.diffing() {
+		return m.diffLegend()
+	}
+	var b strings.Builder
+	if m.colorMode == ColorByRole {
+		b.WriteString(i18n.T("legend.title"))
+	} else {
+		b.WriteString(i18n.T("legend.colored", m.colorMode))
+	}
+	if m.colorMode == ColorByDC {
+		for _, dcID := range dcIDs {
+			b.WriteString(lipgloss.NewStyle().Foreground(DCColor(dcID)).Render(fmt.Sprintf("DC %d", dcID)))
+			b.WriteString("  ")
+		}
+		b.WriteString(m.replicaStyle(config.Leader, -1).Render(i18n.T("legend.leader.bold")))
+		if m.clusterType == config.MRC {
+			b.WriteString("  ")
+			b.WriteString(m.replicaStyle(config.Observer, -1).Render(i18n.T("legend.observer.italic")))
+		}
+	} else {
+		if m.colorMode == ColorHeatReplicas || m.colorMode == ColorHeatLeaders {
+			for level, label := range []string{"≪ avg", "< avg", "≈ avg", "> avg", "≫ avg"} {
+				b.WriteString(lipgloss.NewStyle().Background(heatPalette[level]).Render(" " + label + " "))
+			}
+			b.WriteString("  ")
+		}
+		b.WriteString(LeaderStyle.Render(i18n.T("legend.leader")))
 		b.WriteString("  ")
-		b.WriteString(FollowerStyle.Render("Follower (pX)"))
+		b.WriteString(FollowerStyle.Render(i18n.T("legend.follower")))
 		// Only show Observer in legend if MRC is possible
 		if m.clusterType == config.MRC { // Check the *potential* type, not just current selection
 			b.WriteString("  ")
-			b.WriteString(ObserverStyle.Render("Observer (pX)"))
+			b.WriteString(ObserverStyle.Render(i18n.T("legend.observer")))
 		}
-		b.WriteString("\n\n")
-		b.WriteString(HelpStyle.Render("(Press Enter to restart. Ctrl+C to quit)"))
+	}
+	if showLag {
+		b.WriteString("  ")
+		b.WriteString(LagHotStyle.Render(i18n.T("legend.lagHot")))
+		b.WriteString("  ")
+		b.WriteString(LagStuckStyle.Render(i18n.T("legend.lagStuck")))
+	}
+	return b.String()
+}
 
-	case ShowError:
-		// Display a general error message if we land in this state
-		// Specific validation errors are shown in the input stages
-		errMsg := "An unexpected error occurred."
-		if m.err != nil {
-			errMsg = m.err.Error()
+// replicaStyle returns the style of a replica chip. By role, each role has
+// its own color; by DC, the chip takes its data center's color (dcID < 0
+// means uncolored, for the legend and the diff) and the role is shown as
+// text style.
+func (m Model) replicaStyle(role config.ReplicaRole, dcID int) lipgloss.Style {
+	if m.colorMode == ColorByDC || m.colorMode == ColorByDiff {
+		style := lipgloss.NewStyle()
+		if dcID >= 0 {
+			style = style.Foreground(DCColor(dcID))
 		}
-		b.WriteString(ErrorStyle.Render("Error: " + errMsg))
+		switch role {
+		case config.Leader:
+			style = style.Bold(true)
+		case config.Observer:
+			style = style.Italic(true)
+		}
+		return style
+	}
+	switch role {
+	case config.Leader:
+		return LeaderStyle
+	case config.Observer:
+		// Only show observer style if it's actually MRC
+		if m.clusterType == config.MRC {
+			return ObserverStyle
+		}
+		// Should not happen based on placement logic, but fallback
+		return FollowerStyle
+	default:
+		return FollowerStyle
+	}
+}
+
+// heatLevels buckets every broker's replica count (or count of replicas
+// with the given role) relative to the cluster average into an index of
+// heatPalette: 0 is well below average, 2 about average, 4 well above.
+func heatLevels(dcs map[int]*config.DCInfo, role *config.ReplicaRole) map[int]int {
+	counts := make(map[int]int)
+	total := 0
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			n := 0
+			for _, r := range broker.Replicas {
+				if role == nil || r.Role == *role {
+					n++
+				}
+			}
+			counts[broker.ID] = n
+			total += n
+		}
+	}
+	levels := make(map[int]int, len(counts))
+	if total == 0 {
+		return levels // All brokers empty, nothing to shade
+	}
+	avg := float64(total) / float64(len(counts))
+	for id, n := range counts {
+		ratio := float64(n) / avg
+		switch {
+		case ratio < 0.75:
+			levels[id] = 0
+		case ratio < 0.95:
+			levels[id] = 1
+		case ratio <= 1.05:
+			levels[id] = 2
+		case ratio <= 1.25:
+			levels[id] = 3
+		default:
+			levels[id] = 4
+		}
+	}
+	return levels
+}
+
+// displayedLag maps the loaded lag data onto the displayed partition IDs.
+// The visualizer numbers partitions from 1 while Kafka numbers them from 0.
+func (m Model) displayedLag() map[int]lag.PartitionLag {
+	result := make(map[int]lag.PartitionLag)
+	for kafkaPartition, pl := range m.lagData.ForTopic(m.placementCfg.TopicName) {
+		if kafkaPartition < m.placementCfg.NumPartitions {
+			result[kafkaPartition+1] = pl
+		}
+	}
+	return result
+}
+
+// lagSummary renders a one-line overview of the overlaid consumer lag.
+func (m Model) lagSummary(partitionLag map[int]lag.PartitionLag) string {
+	group := m.lagData.Group
+	if group == "" {
+		group = i18n.T("lag.unknownGroup")
+	}
+	if len(partitionLag) == 0 {
+		return HelpStyle.Render(i18n.T("lag.noData", group))
+	}
+	var total, maxLag int64
+	maxPartition, hot, stuck := 0, 0, 0
+	for id, pl := range partitionLag {
+		total += pl.Lag
+		if pl.Lag > maxLag || (pl.Lag == maxLag && id < maxPartition) {
+			maxLag, maxPartition = pl.Lag, id
+		}
+		if pl.Stuck() {
+			stuck++
+		} else if m.lagThreshold > 0 && pl.Lag >= m.lagThreshold {
+			hot++
+		}
+	}
+	summary := i18n.T("lag.summary", group, formatCount(total), formatCount(maxLag))
+	if maxLag > 0 {
+		summary += i18n.T("lag.maxOn", m.placementCfg.PartitionNumber(maxPartition))
+	}
+	summary += i18n.T("lag.hotStuck", hot, stuck)
+	return summary
+}
+
+// formatCount renders a count compactly (e.g. 950, 12.3k, 4.1M).
+func formatCount(n int64) string {
+	switch {
+	case n >= 1_000_000_000:
+		return fmt.Sprintf("%.1fG", float64(n)/1e9)
+	case n >= 1_000_000:
+		return fmt.Sprintf("%.1fM", float64(n)/1e6)
+	case n >= 1_000:
+		return fmt.Sprintf("%.1fk", float64(n)/1e3)
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
+
+// formatBytes renders a byte count with a binary unit suffix (e.g. 1.5 GiB).
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// networkStatsView renders the per-broker estimated network load of the
+// current placement next to the random-strategy baseline.
+func (m Model) networkStatsView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("network.title")))
+	b.WriteString("\n")
+
+	hasTraffic := false
+	for _, load := range m.placementCfg.PartitionLoads {
+		if load.BytesInPerSec > 0 || load.BytesOutPerSec > 0 {
+			hasTraffic = true
+			break
+		}
+	}
+	if !hasTraffic {
+		b.WriteString(HelpStyle.Render(i18n.T("network.none")))
+		return b.String()
+	}
+
+	after := placement.BrokerNetworkLoad(m.dcs, m.placementCfg.PartitionLoads)
+	before := after
+	beforeLabel := i18n.T("network.current")
+	if m.baselineDCs != nil {
+		before = placement.BrokerNetworkLoad(m.baselineDCs, m.placementCfg.PartitionLoads)
+		beforeLabel = i18n.T("network.random")
+	}
+	afterLeaders := leaderCounts(m.dcs)
+	beforeLeaders := leaderCounts(m.baselineDCs)
+	if m.baselineDCs == nil {
+		beforeLeaders = afterLeaders
+	}
+
+	brokerIDs := make([]int, 0, len(after))
+	for id := range after {
+		brokerIDs = append(brokerIDs, id)
+	}
+	sort.Ints(brokerIDs)
+
+	b.WriteString(HelpStyle.Render(i18n.T("network.legend", beforeLabel, m.placementCfg.Strategy)))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%-8s %-9s %-25s %-25s\n", i18n.T("column.broker"), i18n.T("column.leaders"), i18n.T("network.in"), i18n.T("network.out")))
+	var maxOutBefore, maxOutAfter float64
+	for _, id := range brokerIDs {
+		b.WriteString(fmt.Sprintf("%-8d %-9s %-25s %-25s\n",
+			id,
+			fmt.Sprintf("%d → %d", beforeLeaders[id], afterLeaders[id]),
+			formatRate(before[id].BytesIn)+" → "+formatRate(after[id].BytesIn),
+			formatRate(before[id].BytesOut)+" → "+formatRate(after[id].BytesOut),
+		))
+		if before[id].BytesOut > maxOutBefore {
+			maxOutBefore = before[id].BytesOut
+		}
+		if after[id].BytesOut > maxOutAfter {
+			maxOutAfter = after[id].BytesOut
+		}
+	}
+	b.WriteString(i18n.T("network.busiest", formatRate(maxOutBefore), formatRate(maxOutAfter)))
+	return b.String()
+}
+
+// leaderCounts returns the number of leader replicas per broker.
+func leaderCounts(dcs map[int]*config.DCInfo) map[int]int {
+	counts := make(map[int]int)
+	for _, dc := range dcs {
+		for _, broker := range dc.Brokers {
+			for _, replica := range broker.Replicas {
+				if replica.Role == config.Leader {
+					counts[broker.ID]++
+				}
+			}
+		}
+	}
+	return counts
+}
+
+// formatRate renders a bytes/sec rate.
+func formatRate(bytesPerSec float64) string {
+	return formatBytes(int64(bytesPerSec)) + "/s"
+}
+
+// comparisonView renders the strategy comparison report: every registered
+// scorer (rows) evaluated against a placement from every strategy (columns).
+func (m Model) comparisonView() string {
+	var b strings.Builder
+	b.WriteString(DCHeaderStyle.Render(i18n.T("comparison.title")))
+	b.WriteString("\n")
+	c := m.comparison
+	if c == nil {
+		b.WriteString(HelpStyle.Render(i18n.T("comparison.calculating")))
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf("%-38s", i18n.T("comparison.scorer")))
+	for _, strategy := range c.Strategies {
+		name := strategy.String()
+		if strategy == m.placementCfg.Strategy {
+			name += "*"
+		}
+		b.WriteString(fmt.Sprintf(" %-11s", name))
+	}
+	b.WriteString("\n")
+	for i, scorer := range c.Scorers {
+		b.WriteString(fmt.Sprintf("%-38s", scorer.Name()))
+		for j := range c.Strategies {
+			b.WriteString(fmt.Sprintf(" %-11.3f", c.Scores[j][i]))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("comparison.current")))
+	return b.String()
+}
+
+// failoverViewRows caps the brokers listed in the failover pane; the worst
+// failures come first.
+const failoverViewRows = 10
+
+// failoverView renders the estimated leaderless time for the failure of
+// each broker, worst first.
+func (m Model) failoverView() string {
+	var b strings.Builder
+	t := m.failoverTiming
+	b.WriteString(DCHeaderStyle.Render(i18n.T("failover.title", t.SessionTimeout, t.ElectionTime)))
+	b.WriteString("\n")
+	estimates := failover.Simulate(m.dcs, m.quorum(), t)
+	if len(estimates) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("failover.noBrokers")))
+		return b.String()
+	}
+	b.WriteString(fmt.Sprintf("%-8s %-7s %-11s %-9s %s\n", i18n.T("column.broker"), i18n.T("failover.leads"), i18n.T("failover.reelected"), i18n.T("failover.offline"), i18n.T("failover.leaderless")))
+	for _, e := range estimates[:min(len(estimates), failoverViewRows)] {
+		line := fmt.Sprintf("%-8d %-7d %-11d %-9d %s", e.BrokerID, e.Led, e.Elected, len(e.Offline), formatLeaderless(e))
+		if e.ActiveController && e.WorstCase > 0 {
+			line += i18n.T("failover.controller", t.ControllerFailover)
+		}
+		if len(e.Offline) > 0 {
+			b.WriteString(FailStyle.Render(line) + "\n")
+		} else {
+			b.WriteString(line + "\n")
+		}
+	}
+	if len(estimates) > failoverViewRows {
+		b.WriteString(HelpStyle.Render(i18n.T("failover.more", len(estimates)-failoverViewRows)) + "\n")
+	}
+
+	worst := estimates[0]
+	switch {
+	case worst.QuorumLost:
+		b.WriteString(FailStyle.Render(i18n.T("failover.worst.quorum", worst.BrokerID, i18n.Plural(len(worst.Offline), "partition"))))
+	case len(worst.Offline) > 0:
+		b.WriteString(FailStyle.Render(i18n.T("failover.worst.offline", worst.BrokerID, i18n.Plural(len(worst.Offline), "partition"))))
+	case worst.WorstCase > 0:
+		b.WriteString(i18n.T("failover.worst.leaderless", worst.BrokerID, worst.WorstCase.Round(time.Millisecond)))
+	default:
+		b.WriteString(HelpStyle.Render(i18n.T("failover.noLeaders")))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(m.capabilityView(estimates))
+	if metadata := m.metadataView(); metadata != "" {
+		b.WriteString("\n\n")
+		b.WriteString(metadata)
+	}
+	b.WriteString("\n\n")
+	b.WriteString(m.rebalanceView(estimates))
+	b.WriteString("\n\n")
+	b.WriteString(m.recoveryView(m.rebalanceBroker(estimates)))
+	b.WriteString("\n\n")
+	b.WriteString(m.rollingView())
+	if m.clusterType == config.MRC {
 		b.WriteString("\n\n")
-		b.WriteString(HelpStyle.Render("(Press Enter to restart. Ctrl+C to quit)"))
+		b.WriteString(m.dcLossView())
 	}
+	return b.String()
+}
 
+// dcLossView renders the estimated RPO and RTO of losing each DC under the
+// sync stretch and async + observers designs.
+func (m Model) dcLossView() string {
+	var b strings.Builder
+	t := m.failoverTiming
+	b.WriteString(DCHeaderStyle.Render(i18n.T("dcLoss.title", t.ObserverLag, t.PromotionTime)))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%-19s %-9s %-9s %-9s %-10s %s\n", i18n.T("dcLoss.design"), i18n.T("dcLoss.lostDC"), i18n.T("dcLoss.affected"), i18n.T("dcLoss.promoted"), "RPO", "RTO"))
+	for _, d := range failover.Designs() {
+		for _, e := range failover.SimulateDCLoss(m.dcs, m.quorum(), t, d) {
+			rpo, rto := "0", "-"
+			if e.RPO > 0 {
+				rpo = "≤ " + e.RPO.Round(time.Millisecond).String()
+			}
+			switch {
+			case e.QuorumLost:
+				rto = i18n.T("failover.untilQuorum")
+			case e.RTO > 0:
+				rto = e.RTO.Round(time.Millisecond).String()
+			}
+			line := fmt.Sprintf("%-19s %-9d %-9d %-9d %-10s %s", d, e.DCID, e.Affected, e.Promoted, rpo, rto)
+			if len(e.Lost) > 0 || e.QuorumLost {
+				if len(e.Lost) > 0 {
+					line += i18n.T("dcLoss.lost", i18n.Plural(len(e.Lost), "partition"))
+				}
+				b.WriteString(FailStyle.Render(line) + "\n")
+			} else {
+				b.WriteString(line + "\n")
+			}
+		}
+	}
+	b.WriteString(HelpStyle.Render(i18n.T("dcLoss.note")))
+
+	if m.lostDC == 0 {
+		if !m.printing {
+			b.WriteString("\n" + HelpStyle.Render(i18n.T("dcLoss.hint")))
+		}
+		return b.String()
+	}
+	b.WriteString("\n\n")
+	b.WriteString(DCHeaderStyle.Render(i18n.T("dcLoss.commands", m.lostDC)))
+	b.WriteString("\n")
+	commands := runbook.PromotionCommands(m.placementCfg, m.dcs, m.lostDC)
+	if len(commands) == 0 {
+		b.WriteString(HelpStyle.Render(i18n.T("dcLoss.noPromotion")))
+	} else {
+		b.WriteString(strings.Join(commands, "\n"))
+	}
+	return b.String()
+}
+
+// formatLeaderless renders the worst-case leaderless time of a failure.
+func formatLeaderless(e failover.Estimate) string {
+	switch {
+	case e.QuorumLost:
+		return i18n.T("failover.untilQuorum")
+	case len(e.Offline) > 0:
+		return i18n.T("failover.untilBroker")
+	case e.WorstCase > 0:
+		return e.WorstCase.Round(time.Millisecond).String()
+	}
+	return "-"
+}
+
+// rulesView renders the pass/fail result of every user-defined rule.
+func (m Model) rulesView() string {
+	var b strings.Builder
+	failed := 0
+	for _, r := range m.ruleResults {
+		if !r.Passed {
+			failed++
+		}
+	}
+	b.WriteString(DCHeaderStyle.Render(i18n.T("rules.title", len(m.ruleResults)-failed, len(m.ruleResults))))
+	b.WriteString("\n")
+	for i, r := range m.ruleResults {
+		line := "✓ " + r.Rule
+		style := PassStyle
+		if !r.Passed {
+			line = "✗ " + r.Rule
+			style = FailStyle
+		}
+		b.WriteString(style.Render(line))
+		if r.Detail != "" {
+			b.WriteString(HelpStyle.Render(" — " + r.Detail))
+		}
+		if i < len(m.ruleResults)-1 {
+			b.WriteString("\n")
+		}
+	}
 	return b.String()
 }
+
+// placementHelp renders the key help line of the placement view, or a
+// one-line hint when the footer is collapsed.
+func (m Model) placementHelp() string {
+	if m.printing {
+		return ""
+	}
+	if m.hideFooter {
+		return HelpStyle.Render(i18n.T("keys.footer"))
+	}
+	keys := []string{"keys.restart", "keys.stats", "keys.failover", "keys.trend", "keys.k8s"}
+	if m.brokerSelected {
+		keys = []string{"keys.arrows", "keys.details", "keys.deselect", "keys.stats", "keys.failover", "keys.trend", "keys.k8s"}
+	} else if !m.accessible && !m.showHistogram {
+		keys = append(keys, "keys.select")
+	}
+	if m.lagData != nil {
+		keys = append(keys, "keys.lag")
+	}
+	if m.liveAssignment && m.liveData != nil {
+		keys = append(keys, "keys.urp")
+		if m.snapshotDir != "" {
+			keys = append(keys, "keys.snapshots")
+		}
+	}
+	if m.mirrorCfg != nil {
+		keys = append(keys, "keys.mirror")
+	}
+	keys = append(keys, "keys.tenants")
+	if len(m.dcs) > 1 {
+		keys = append(keys, "keys.matrix", "keys.dr")
+	}
+	keys = append(keys, "keys.rf", "keys.isr", "keys.locality", "keys.timeline", "keys.log")
+	if m.showFailover {
+		keys = append(keys, "keys.controllers", "keys.rebalance")
+	}
+	if m.accessible {
+		keys = append(keys, "keys.graphical")
+	} else {
+		keys = append(keys, "keys.summary")
+	}
+	if m.showHistogram {
+		keys = append(keys, "keys.placement")
+	} else {
+		keys = append(keys, "keys.histograms")
+	}
+	if !m.accessible && !m.showHistogram {
+		keys = append(keys, "keys.coloring")
+	}
+	if len(m.tagSelectors()) > 0 {
+		keys = append(keys, "keys.tag")
+	}
+	keys = append(keys, "keys.edit", "keys.strategy", "keys.topics", "keys.note", "keys.screenshot")
+	keys = append(keys, "keys.hideFooter", "keys.quit")
+	for i, key := range keys {
+		keys[i] = i18n.T(key)
+	}
+	return HelpStyle.Render(i18n.T("keys.press", strings.Join(keys, i18n.T("keys.separator"))))
+}
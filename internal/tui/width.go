@@ -0,0 +1,26 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// cellWidth measures text in terminal cells, so DC, rack, topic and tenant
+// names with CJK characters or emoji (two cells each) still line up in the
+// tables. Ambiguous-width characters such as "·" and "→" take one cell even
+// in East Asian locales, as lipgloss measures them for the boxes.
+var cellWidth = func() *runewidth.Condition {
+	c := runewidth.NewCondition()
+	c.EastAsianWidth = false
+	return c
+}()
+
+// padRight pads s with spaces to width terminal cells, like "%-*s" does for
+// ASCII. Text already that wide is returned unchanged.
+func padRight(s string, width int) string {
+	if w := cellWidth.StringWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
@@ -0,0 +1,195 @@
+// Package weights loads per-partition size and throughput estimates used by
+// the load-aware placement strategies. Two sources are supported: a CSV file
+// and the JSON printed by `kafka-log-dirs.sh --describe`.
+package weights
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+)
+
+// Partition is the load of one partition. Partition uses Kafka's 0-based
+// numbering.
+type Partition struct {
+	Topic     string
+	Partition int
+	config.PartitionLoad
+}
+
+// Data holds the loads of all partitions found in the input.
+type Data struct {
+	Partitions []Partition
+}
+
+// logDirsOutput is the subset of `kafka-log-dirs.sh --describe` JSON we need.
+type logDirsOutput struct {
+	Brokers []struct {
+		LogDirs []struct {
+			Partitions []struct {
+				Partition string `json:"partition"` // "<topic>-<partition>"
+				Size      int64  `json:"size"`
+				IsFuture  bool   `json:"isFuture"`
+			} `json:"partitions"`
+		} `json:"logDirs"`
+	} `json:"brokers"`
+}
+
+// LoadFile reads partition weights from a CSV file or kafka-log-dirs JSON
+// output; the format is detected from the content.
+//
+// The CSV columns are topic, partition, size_bytes and optionally
+// bytes_in_per_sec and bytes_out_per_sec. A header row is allowed.
+func LoadFile(path string) (*Data, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading weights file: %w", err)
+	}
+	trimmed := bytes.TrimSpace(content)
+	// kafka-log-dirs prints status lines before the JSON document,
+	// which always starts on a line of its own
+	for _, line := range bytes.Split(trimmed, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("{")) {
+			return parseLogDirs(trimmed[bytes.Index(trimmed, line):])
+		}
+	}
+	return parseCSV(trimmed)
+}
+
+// Topics returns the distinct topics present in the data, sorted.
+func (d *Data) Topics() []string {
+	seen := make(map[string]bool)
+	var topics []string
+	for _, p := range d.Partitions {
+		if !seen[p.Topic] {
+			seen[p.Topic] = true
+			topics = append(topics, p.Topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// ForTopic returns the loads of a topic's partitions keyed by the 1-based
+// partition ID used by the visualizer. If topic is empty and the data covers
+// exactly one topic, that topic is used.
+func (d *Data) ForTopic(topic string) map[int]config.PartitionLoad {
+	if topic == "" {
+		if topics := d.Topics(); len(topics) == 1 {
+			topic = topics[0]
+		}
+	}
+	result := make(map[int]config.PartitionLoad)
+	for _, p := range d.Partitions {
+		if p.Topic == topic {
+			result[p.Partition+1] = p.PartitionLoad
+		}
+	}
+	return result
+}
+
+// parseLogDirs takes the largest replica size of each partition, since
+// replicas that are still catching up report smaller sizes.
+func parseLogDirs(content []byte) (*Data, error) {
+	var out logDirsOutput
+	if err := json.Unmarshal(content, &out); err != nil {
+		return nil, fmt.Errorf("parsing kafka-log-dirs output: %w", err)
+	}
+	sizes := make(map[string]int64)
+	for _, broker := range out.Brokers {
+		for _, dir := range broker.LogDirs {
+			for _, p := range dir.Partitions {
+				if !p.IsFuture && p.Size > sizes[p.Partition] {
+					sizes[p.Partition] = p.Size
+				} else if _, ok := sizes[p.Partition]; !ok {
+					sizes[p.Partition] = 0
+				}
+			}
+		}
+	}
+	data := &Data{}
+	for name, size := range sizes {
+		idx := strings.LastIndex(name, "-")
+		if idx < 0 {
+			continue
+		}
+		partition, err := strconv.Atoi(name[idx+1:])
+		if err != nil {
+			continue
+		}
+		data.Partitions = append(data.Partitions, Partition{
+			Topic:         name[:idx],
+			Partition:     partition,
+			PartitionLoad: config.PartitionLoad{SizeBytes: size},
+		})
+	}
+	if len(data.Partitions) == 0 {
+		return nil, fmt.Errorf("kafka-log-dirs output contains no partitions")
+	}
+	data.sort()
+	return data, nil
+}
+
+func parseCSV(content []byte) (*Data, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1 // Throughput columns are optional
+	reader.TrimLeadingSpace = true
+	data := &Data{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing weights CSV: %w", err)
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("weights CSV line %d: expected topic,partition,size_bytes", line)
+		}
+		partition, err := strconv.Atoi(record[1])
+		if err != nil {
+			if line == 1 {
+				continue // Header row
+			}
+			return nil, fmt.Errorf("weights CSV line %d: invalid partition %q", line, record[1])
+		}
+		p := Partition{Topic: record[0], Partition: partition}
+		if p.SizeBytes, err = strconv.ParseInt(record[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("weights CSV line %d: invalid size %q", line, record[2])
+		}
+		if len(record) > 3 && record[3] != "" {
+			if p.BytesInPerSec, err = strconv.ParseFloat(record[3], 64); err != nil {
+				return nil, fmt.Errorf("weights CSV line %d: invalid bytes_in_per_sec %q", line, record[3])
+			}
+		}
+		if len(record) > 4 && record[4] != "" {
+			if p.BytesOutPerSec, err = strconv.ParseFloat(record[4], 64); err != nil {
+				return nil, fmt.Errorf("weights CSV line %d: invalid bytes_out_per_sec %q", line, record[4])
+			}
+		}
+		data.Partitions = append(data.Partitions, p)
+	}
+	if len(data.Partitions) == 0 {
+		return nil, fmt.Errorf("weights CSV contains no partitions")
+	}
+	data.sort()
+	return data, nil
+}
+
+func (d *Data) sort() {
+	sort.Slice(d.Partitions, func(i, j int) bool {
+		if d.Partitions[i].Topic != d.Partitions[j].Topic {
+			return d.Partitions[i].Topic < d.Partitions[j].Topic
+		}
+		return d.Partitions[i].Partition < d.Partitions[j].Partition
+	})
+}
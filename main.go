@@ -1,23 +1,925 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	// Use the full module path for internal packages
+	"github.com/adtyap26/kafka-partition-visualizer/internal/applog"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/assignment"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/capacity"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/config"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/deploy"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/dr"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/export"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/failover"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/i18n"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/kafka"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/lag"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/locality"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/mirror"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/naming"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/pipeline"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/placement"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/recording"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/rules"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/runbook"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/scenarios"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/screenshot"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/session"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/stress"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/strimzi"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/tags"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/timeline"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/topics"
 	"github.com/adtyap26/kafka-partition-visualizer/internal/tui"
+	"github.com/adtyap26/kafka-partition-visualizer/internal/weights"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 func main() {
+	// Command line flags
+	strimziCluster := flag.String("strimzi", "", "Import topology from the named Strimzi Kafka cluster (via kubectl)")
+	strimziFiles := flag.String("strimzi-file", "", "Import from saved `kubectl get kafka,kafkatopic,pods,nodes -o json` output (comma-separated files)")
+	bootstrap := flag.String("bootstrap", "", "Connect to the live Kafka cluster at these comma-separated host:port servers and show the actual placement of --topic (or pick a topic), also via C on the first screen")
+	kafkaTLS := flag.Bool("kafka-tls", false, "Connect to --bootstrap over TLS")
+	kafkaCA := flag.String("kafka-ca", "", "PEM file of the CA certificates the --bootstrap TLS listener is verified with (default: the system's; implies --kafka-tls)")
+	kafkaSASL := flag.String("kafka-sasl", "", "SASL mechanism the --bootstrap listener authenticates with: PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512")
+	kafkaUser := flag.String("kafka-user", "", "User --kafka-sasl authenticates as")
+	kafkaPassword := flag.String("kafka-password", "", "Password of --kafka-user (default: $KAFKA_PASSWORD)")
+	namespace := flag.String("namespace", "", "Kubernetes namespace of the Strimzi resources")
+	snapshotDir := flag.String("snapshots", "", "Directory the watched --assignment is saved to as snapshots, browsed with Z (default: snapshots/<cluster> in the kafka-viz config directory)")
+	snapshotEvery := flag.Duration("snapshot-every", time.Hour, "How often the watched --assignment is saved as a snapshot if it changed (0 only browses the saved ones)")
+	approvedFile := flag.String("approved", "", "Compare the --assignment with this approved plan (reassignment JSON, e.g. from --export-format reassignment) and flag drifted partitions (exits with status 3 on drift)")
+	assignmentFile := flag.String("assignment", "", "Show the live replica assignment from saved `kafka-topics --describe` output on the imported cluster instead of simulating one")
+	topic := flag.String("topic", "", "Topic to visualize when importing (defaults to the first topic, or a list to pick from with --bootstrap)")
+	strategyName := flag.String("strategy", "random", "Placement strategy: random, size-aware, goals, client-affinity, kafka-default (Kafka's own assignment without racks) or rack-aware (with DCs or --racks as racks)")
+	goalList := flag.String("goals", "", "Comma-separated goals for the goals strategy, highest priority first (default: Cruise Control order)")
+	seed := flag.Int64("seed", 0, "Seed for the random parts of the placement, to reproduce a run (0 = different every time)")
+	maxMoves := flag.Int("max-moves", 0, "Maximum replica moves/leader swaps for the goals strategy (0 = one per replica)")
+	weightsFile := flag.String("weights", "", "Per-partition sizes/throughput from a CSV (topic,partition,size_bytes[,bytes_in,bytes_out]) or kafka-log-dirs --describe JSON")
+	networkCapacity := flag.String("network-capacity", "", "Network throughput of every broker per direction for the headroom view, e.g. 10Gbit or 1250MB/s")
+	diskCapacity := flag.String("disk-capacity", "", "Disk write throughput of every broker for the headroom view, e.g. 500MB/s")
+	capacityFile := flag.String("broker-capacity", "", "Per-broker capacities from a CSV of broker_id,network,disk overriding --network-capacity/--disk-capacity")
+	accessible := flag.Bool("accessible", false, "Describe placements as screen-reader friendly text instead of drawing broker boxes")
+	zeroBased := flag.Bool("zero-based-partitions", false, "Number partitions from 0 as Kafka does, in every view and export, instead of from 1")
+	theme := flag.String("theme", "auto", "Color theme: auto (detect terminal background), light or dark")
+	terminalMode := flag.String("terminal", "auto", "Terminal capabilities: auto (detect colors, TERM and locale), full (256 colors, Unicode) or basic (16 colors, ASCII only)")
+	sessionFile := flag.String("session", defaultSessionPath(), "File the last placement is saved to on exit and resumed from (empty disables)")
+	recordFile := flag.String("record", "", "Record the keys pressed in this session, with its flags and placement seed, to this file on exit for --replay")
+	replayFile := flag.String("replay", "", "Replay a session recorded with --record step by step: every key plays the next recorded one")
+	historyFile := flag.String("history", defaultHistoryPath(), "File a snapshot of the final placement's health is appended to on exit, charted by the trend pane (empty disables)")
+	exportFile := flag.String("export-on-exit", "", "Write the final placement to this file when the visualizer exits")
+	exportFormat := flag.String("export-format", "", "Format for --export-on-exit: "+strings.Join(export.FormatNames(), ", ")+" (default: from the file extension)")
+	screenshotDir := flag.String("screenshots", "", "Directory Ctrl+S on the placement screen saves screenshots of the view to, with a caption of the scenario, date, health and simulated failure (default: the current directory)")
+	screenshotFormat := flag.String("screenshot-format", "svg", "Format of the Ctrl+S screenshots: svg (in the terminal's colors) or text")
+	wavesFile := flag.String("waves", "", "Split the migration from the placement before the last change (e.g. the live assignment) to the final one into waves, writing one reassignment JSON per wave (plan.json gives plan-wave-1.json, ...) on exit")
+	movesPerBroker := flag.Int("max-moves-per-broker", export.DefaultMovesPerBroker, "Concurrent replica moves a broker may take part in per --waves wave (0 = no limit)")
+	movesPerDC := flag.Int("max-moves-per-dc", 0, "New replicas per data center per --waves wave (0 = no limit)")
+	runbookFile := flag.String("runbook", "", "Write a Markdown DR runbook for the final MRC placement to this file when the visualizer exits")
+	propertiesDir := flag.String("broker-properties", "", "Write a server.properties snippet (broker.id, broker.rack) per broker of the final placement to this directory on exit")
+	topicConfigFile := flag.String("topic-config", "", "Write the recommended topic configs (min ISR, unclean election, retention, segments) for the final placement as kafka-configs commands to this file on exit")
+	retention := flag.Duration("retention", deploy.DefaultRetention, "Topic retention the --topic-config segment settings are sized for")
+	composeFile := flag.String("compose", "", "Write a docker-compose.yml that starts the final placement's cluster to this file on exit")
+	composeMode := flag.String("compose-mode", "kraft", "Metadata mode of the --compose cluster: kraft or zookeeper")
+	tutorial := flag.Bool("tutorial", false, "Start with the guided walkthrough of a 3-broker example cluster")
+	quiz := flag.Bool("quiz", false, "Start in quiz mode: questions on which partitions random broker and DC failures break")
+	bundleFile := flag.String("bundle", "", "Open a bundle shared by a teammate: the exact placement with its scenario and notes")
+	loadFile := flag.String("load", "", "Start from an assignment exported earlier with --export-format json or yaml, to edit, compare or fail it again")
+	saveBundle := flag.String("save-bundle", "", "Write the final placement with its scenario and notes to this bundle file on exit, to share for review")
+	scenarioName := flag.String("scenario", "", "Start with a scenario of the bundled library, e.g. \"3-DC stretch\" (see the L option of the first screen)")
+	stressCount := flag.Int("stress", 0, "Generate this many random clusters and topic mixes, place them with every strategy and write the metrics CSV instead of starting the TUI (reproducible with --seed)")
+	stressOut := flag.String("stress-out", "-", "File the --stress metrics CSV is written to (- for stdout)")
+	pipelineName := flag.String("pipeline", "", "Run the named pipeline of the --pipelines file on the --load assignment instead of starting the TUI: re-place it with the pipeline's strategy, print the scores and write its exports")
+	pipelinesFile := flag.String("pipelines", defaultPipelinesPath(), "File the named --pipeline workflows (strategy, goals, scorers, exports) are read from")
+	inline := flag.Bool("inline", false, "Run without the alternate screen and print the final placement to the terminal on exit")
+	topicsFile := flag.String("topics", "", "Place the topics listed in this CSV (name,partitions,replication_factor,min_isr[,size_bytes[,tenant]]) instead of a single topic")
+	namingFile := flag.String("naming-rules", "", "Audit the topic names of the imported cluster (--strimzi or --strimzi-file) against the naming conventions in this file")
+	rulesFile := flag.String("rules", "", "Validate every placement against the rules/thresholds in this file (exits with status 2 if the final placement breaches one)")
+	mm2File := flag.String("mm2", "", "Show how the MirrorMaker 2 flows in this config (connect-mirror-maker properties) name and partition the mirrored topics (X on the placement screen)")
+	mm2Topics := flag.String("mm2-topics", "", "Topics of each MM2 cluster as alias=file pairs of topic CSVs (as for --topics), e.g. A=a.csv,B=b.csv")
+	lagFile := flag.String("lag", "", "Overlay consumer lag from a Burrow JSON export or kafka-consumer-groups --describe output")
+	lagThreshold := flag.Int64("lag-threshold", 1000, "Highlight partitions whose consumer lag is at or above this value")
+	controllerMode := flag.String("controllers", "", "Controller deployment the failure simulations assume: combined (KRaft in the broker processes), dedicated (KRaft on own nodes) or zookeeper (default: combined, or the imported cluster's)")
+	controllerCount := flag.Int("controller-count", 0, "Number of KRaft controller voters (default: 3, or the imported cluster's)")
+	activeController := flag.Int("active-controller", -1, "Node ID of the active controller, which leads the __cluster_metadata log (default: the lowest voter)")
+	racks := flag.String("racks", "", "Assign the brokers of a single cluster to racks, as a number of racks to spread them over (e.g. 3) or broker=rack pairs (e.g. 0=az1,1=az2,2=az3); replicas then land on distinct racks (--strategy rack-aware for Kafka's own rack-aware assignment)")
+	brokerTagsFile := flag.String("broker-tags", "", "Tag brokers from a CSV of broker_id,key=value,... (e.g. instance=m5.xlarge,disk=gp3,lifecycle=spot) to filter the view by")
+	avoidLeaders := flag.String("avoid-leaders", "", "Comma-separated broker tags (key=value) to keep partition leaders off where possible, e.g. lifecycle=spot")
+	timing := failover.DefaultTiming()
+	flag.DurationVar(&timing.SessionTimeout, "session-timeout", timing.SessionTimeout, "Broker session timeout assumed by the failover estimates (broker.session.timeout.ms)")
+	flag.DurationVar(&timing.ElectionTime, "election-time", timing.ElectionTime, "Controller time per partition leader election assumed by the failover estimates")
+	flag.DurationVar(&timing.ObserverLag, "observer-lag", timing.ObserverLag, "Replication lag of asynchronous observers, the RPO of a DC loss that needs observer promotion")
+	flag.DurationVar(&timing.PromotionTime, "promotion-time", timing.PromotionTime, "Time to manually promote observers after a DC loss")
+	flag.DurationVar(&timing.ControllerFailover, "controller-failover", timing.ControllerFailover, "Time for the KRaft quorum to elect a new active controller after losing it")
+	mm2Lag := flag.Duration("mm2-lag", dr.DefaultParams().MirrorLag, "Replication lag of MirrorMaker 2, the RPO of the two-cluster design in the DR comparison")
+	rebalance := failover.DefaultRebalance()
+	flag.BoolVar(&rebalance.Enabled, "auto-leader-rebalance", rebalance.Enabled, "Whether the failover pane's leader rebalance timeline assumes auto.leader.rebalance.enable")
+	flag.DurationVar(&rebalance.CheckInterval, "leader-imbalance-check-interval", rebalance.CheckInterval, "How often the controller checks the leader imbalance (leader.imbalance.check.interval.seconds)")
+	flag.IntVar(&rebalance.ImbalancePercent, "leader-imbalance-percentage", rebalance.ImbalancePercent, "Leader imbalance per broker, in percent, above which leadership moves back (leader.imbalance.per.broker.percentage)")
+	flag.DurationVar(&rebalance.RestartTime, "restart-time", rebalance.RestartTime, "Time until a failed broker runs again, for the leader rebalance timeline")
+	flag.DurationVar(&rebalance.CatchUpTime, "catch-up-time", rebalance.CatchUpTime, "Time a restarted broker's replicas take to rejoin the ISR, for the leader rebalance timeline")
+	lagTimeMax := flag.Duration("replica-lag-time-max", failover.DefaultReplicaLagTimeMax, "Time a follower may go without catching up before it leaves the ISR, for the ISR timeline (replica.lag.time.max.ms)")
+	isrEvents := flag.String("isr-events", "", "Broker slowdowns and restarts the ISR timeline plays, as kind:broker@start+duration with kind slow or restart, e.g. slow:1@10s+45s,restart:2@90s+20s (default: one broker slowed down, then restarted)")
+	clientTraffic := flag.String("client-traffic", "", "Producer and consumer traffic of the topic's clients per DC, as dc=produce[:consume] rates with dc a DC ID or rack, e.g. 1=200MB/s:50MB/s,2=20MB/s; the client-affinity strategy leads partitions from the DCs producing the most")
+	consumerDCs := flag.String("consumer-dcs", "", "Consumer instances of the group per DC for the fetch locality pane, as dc=count pairs with dc a DC ID or rack, e.g. 1=4,2=2 (default: one per DC)")
+	timelineSpec := flag.String("timeline", "", "Events the event timeline plays, as kind:target@time with kind fail, recover, fail-dc or recover-dc, or reassign@time, e.g. fail:1@0s,recover:1@5m,reassign@10m (default: the busiest broker fails and recovers, then the reassignment starts)")
+	reassignThrottle := flag.String("reassign-throttle", "50MiB/s", "Rate a reassignment wave copies into each broker on the event timeline, when partition sizes are known (--weights), and the replication throttle of the scripts --waves writes")
+	replicationThrottle := flag.String("replication-throttle", "", "Rate a recovering broker's replicas fetch at, for the failover pane's catch-up estimate (default: its --network-capacity, else 1Gbit/s)")
+	waveTime := flag.Duration("wave-time", timeline.DefaultWaveTime, "Time a reassignment wave takes on the event timeline without partition sizes")
+	lang := flag.String("lang", "", "Language of the UI: en or id (default: from LC_ALL, LC_MESSAGES or LANG)")
+	flag.Parse()
+
+	// A replay starts from the flags of the recorded session; flags given
+	// now, e.g. --lang, are kept unless the recording sets them too
+	var replayed *recording.Recording
+	if *replayFile != "" {
+		if *recordFile != "" {
+			log.Fatalf("Error: --record and --replay cannot be combined")
+		}
+		rec, err := recording.Load(*replayFile)
+		if err != nil {
+			log.Fatalf("Error: --replay: %v", err)
+		}
+		flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
+		flag.CommandLine.SetOutput(io.Discard) // Reported below, without the usage
+		if err := flag.CommandLine.Parse(rec.Args); err != nil {
+			log.Fatalf("Error: --replay: the recorded flags: %v", err)
+		}
+		replayed = rec
+	}
+
+	if *lang != "" {
+		l, err := i18n.Parse(*lang)
+		if err != nil {
+			log.Fatalf("Error: --lang: %v", err)
+		}
+		i18n.SetLanguage(l)
+	} else {
+		i18n.SetLanguage(i18n.FromEnv())
+	}
+
+	// Headless stress run: no TUI, just the metrics CSV
+	if *stressCount > 0 {
+		if err := runStress(*stressCount, *seed, *stressOut, *goalList, *maxMoves); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	// Headless pipeline run: no TUI, just the review and its exports
+	if *pipelineName != "" {
+		if err := runPipeline(*pipelinesFile, *pipelineName, *loadFile, *weightsFile, *zeroBased); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
+	// Pick light or dark color variants before the TUI owns the terminal
+	if err := tui.SetTheme(*theme); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	if err := tui.SetTerminal(*terminalMode); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
 	// Create the initial TUI model
 	m := tui.NewModel()
 
+	// Session-wide placement options
+	strategy, err := config.ParseStrategy(*strategyName)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	m.SetStrategy(strategy)
+	m.SetSeed(*seed)
+	var recorded *recording.Recording
+	switch {
+	case *recordFile != "":
+		// A fixed seed, so the replay shows the same random placements
+		recordSeed := *seed
+		if recordSeed == 0 {
+			recordSeed = time.Now().UnixNano()
+		}
+		recorded = recording.New(recordedArgs(), recordSeed)
+		m.StartRecording(recorded)
+	case replayed != nil:
+		m.Replay(replayed, *replayFile)
+	}
+	m.SetAccessible(*accessible)
+	m.SetZeroBasedPartitions(*zeroBased)
+	m.SetFailoverTiming(timing)
+	m.SetMirrorLag(*mm2Lag)
+	if rebalance.ImbalancePercent < 0 || rebalance.ImbalancePercent > 100 {
+		log.Fatalf("Error: --leader-imbalance-percentage must be between 0 and 100")
+	}
+	m.SetLeaderRebalance(rebalance)
+	if *movesPerBroker < 0 || *movesPerDC < 0 {
+		log.Fatalf("Error: --max-moves-per-broker and --max-moves-per-dc cannot be negative")
+	}
+	limits := export.WaveLimits{PerBroker: *movesPerBroker, PerDC: *movesPerDC}
+	m.SetWaveLimits(limits)
+	controllers := config.ControllersCombined
+	if *controllerMode != "" {
+		if controllers, err = config.ParseControllerMode(*controllerMode); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+	}
+	if *controllerCount < 0 {
+		log.Fatalf("Error: --controller-count cannot be negative")
+	}
+	m.SetControllers(controllers, *controllerCount)
+	m.SetActiveController(*activeController)
+	if *goalList != "" {
+		if _, err := placement.ResolveScorers(strings.Split(*goalList, ",")); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		m.SetGoals(strings.Split(*goalList, ","), *maxMoves)
+	} else {
+		m.SetGoals(nil, *maxMoves)
+	}
+	if *weightsFile != "" {
+		data, err := weights.LoadFile(*weightsFile)
+		if err != nil {
+			log.Fatalf("Error loading partition weights: %v", err)
+		}
+		m.SetWeights(data)
+	}
+
+	var defaultCapacity capacity.Capacity
+	if *networkCapacity != "" {
+		if defaultCapacity.Network, err = capacity.ParseRate(*networkCapacity); err != nil {
+			log.Fatalf("Error: --network-capacity: %v", err)
+		}
+	}
+	if *diskCapacity != "" {
+		if defaultCapacity.Disk, err = capacity.ParseRate(*diskCapacity); err != nil {
+			log.Fatalf("Error: --disk-capacity: %v", err)
+		}
+	}
+	var brokerCapacity map[int]capacity.Capacity
+	if *capacityFile != "" {
+		if brokerCapacity, err = capacity.LoadFile(*capacityFile); err != nil {
+			log.Fatalf("Error loading broker capacities: %v", err)
+		}
+	}
+	m.SetBrokerCapacity(defaultCapacity, brokerCapacity)
+
+	if *lagTimeMax <= 0 {
+		log.Fatalf("Error: --replica-lag-time-max must be positive")
+	}
+	var brokerEvents []failover.BrokerEvent
+	if *isrEvents != "" {
+		if brokerEvents, err = failover.ParseBrokerEvents(*isrEvents); err != nil {
+			log.Fatalf("Error: --isr-events: %v", err)
+		}
+	}
+	m.SetISRTimeline(*lagTimeMax, brokerEvents)
+	var timelineEvents []timeline.Event
+	if *timelineSpec != "" {
+		if timelineEvents, err = timeline.Parse(*timelineSpec); err != nil {
+			log.Fatalf("Error: --timeline: %v", err)
+		}
+	}
+	throttle, err := capacity.ParseRate(*reassignThrottle)
+	if err != nil || throttle <= 0 {
+		log.Fatalf("Error: --reassign-throttle must be a positive rate such as 50MiB/s")
+	}
+	if *waveTime <= 0 {
+		log.Fatalf("Error: --wave-time must be positive")
+	}
+	m.SetTimeline(timelineEvents, throttle, *waveTime)
+	if *replicationThrottle != "" {
+		rate, err := capacity.ParseRate(*replicationThrottle)
+		if err != nil || rate <= 0 {
+			log.Fatalf("Error: --replication-throttle must be a positive rate such as 100MiB/s")
+		}
+		m.SetReplicationThrottle(rate)
+	}
+	if *clientTraffic != "" {
+		traffic, err := locality.ParseClientTraffic(*clientTraffic)
+		if err != nil {
+			log.Fatalf("Error: --client-traffic: %v", err)
+		}
+		m.SetClientTraffic(traffic)
+	}
+	if *consumerDCs != "" {
+		consumers, err := locality.ParseConsumers(*consumerDCs)
+		if err != nil {
+			log.Fatalf("Error: --consumer-dcs: %v", err)
+		}
+		m.SetConsumers(consumers)
+	}
+
+	if *racks != "" {
+		count, brokerRacks, err := config.ParseBrokerRacks(*racks)
+		if err != nil {
+			log.Fatalf("Error: --racks: %v", err)
+		}
+		m.SetBrokerRacks(count, brokerRacks)
+	}
+
+	var brokerTags map[int]map[string]string
+	if *brokerTagsFile != "" {
+		if brokerTags, err = tags.LoadFile(*brokerTagsFile); err != nil {
+			log.Fatalf("Error loading broker tags: %v", err)
+		}
+	}
+	var avoided []config.TagSelector
+	if *avoidLeaders != "" {
+		for _, s := range strings.Split(*avoidLeaders, ",") {
+			sel, err := config.ParseTagSelector(s)
+			if err != nil {
+				log.Fatalf("Error: --avoid-leaders: %v", err)
+			}
+			avoided = append(avoided, sel)
+		}
+	}
+	m.SetBrokerTags(brokerTags, avoided)
+
+	if *topicsFile != "" {
+		specs, err := topics.LoadFile(*topicsFile)
+		if err != nil {
+			log.Fatalf("Error loading topics: %v", err)
+		}
+		m.SetTopics(specs)
+	}
+
+	if *mm2File != "" {
+		mm2, err := mirror.LoadProperties(*mm2File)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		clusterTopics := make(map[string][]config.TopicSpec)
+		for _, pair := range strings.Split(*mm2Topics, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			alias, path, ok := strings.Cut(pair, "=")
+			if !ok || !slices.Contains(mm2.Clusters, alias) {
+				log.Fatalf("Error: --mm2-topics: expected alias=file with an alias of the MM2 clusters (%s), got %q", strings.Join(mm2.Clusters, ", "), pair)
+			}
+			specs, err := topics.LoadFile(path)
+			if err != nil {
+				log.Fatalf("Error loading topics of %s: %v", alias, err)
+			}
+			clusterTopics[alias] = specs
+		}
+		m.SetMirrorMapping(mm2, mirror.Map(mm2, clusterTopics))
+	} else if *mm2Topics != "" {
+		log.Fatalf("Error: --mm2-topics needs an MM2 config (--mm2)")
+	}
+
+	if *rulesFile != "" {
+		r, err := rules.LoadFile(*rulesFile)
+		if err != nil {
+			log.Fatalf("Error loading rules: %v", err)
+		}
+		m.SetRules(r)
+	}
+
+	// Optionally overlay consumer lag on the placement view
+	if *lagFile != "" {
+		data, err := lag.LoadFile(*lagFile)
+		if err != nil {
+			log.Fatalf("Error loading consumer lag: %v", err)
+		}
+		m.SetLagData(data, *lagThreshold)
+	}
+
+	mode, err := deploy.ParseMode(*composeMode)
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	shotFormat, err := screenshot.ParseFormat(*screenshotFormat)
+	if err != nil {
+		log.Fatalf("Error: --screenshot-format: %v", err)
+	}
+	m.SetScreenshots(*screenshotDir, shotFormat)
+
+	// Validate the export format up front rather than after the session
+	format := export.FormatForPath(*exportFile)
+	if *exportFormat != "" {
+		if format, err = export.ParseFormat(*exportFormat); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+	}
+
+	// Offer the placement saved on the last exit, unless the session is
+	// recorded or replayed: resuming differs from one machine to the next
+	if *sessionFile != "" && *recordFile == "" && replayed == nil {
+		last, err := session.Load(*sessionFile)
+		if err != nil {
+			applog.Warnf("%s", i18n.T("log.session", err))
+		}
+		m.SetLastSession(last)
+	}
+
+	// Earlier runs, for the trend pane
+	if *historyFile != "" {
+		h, err := session.LoadHistory(*historyFile)
+		if err != nil {
+			applog.Warnf("%s", i18n.T("log.history", err))
+		}
+		m.SetHistory(h)
+	}
+
+	if *assignmentFile != "" && *strimziCluster == "" && *strimziFiles == "" {
+		log.Fatalf("Error: --assignment needs an imported cluster (--strimzi or --strimzi-file)")
+	}
+	if *namingFile != "" && *strimziCluster == "" && *strimziFiles == "" {
+		log.Fatalf("Error: --naming-rules needs an imported cluster (--strimzi or --strimzi-file)")
+	}
+	if *bootstrap != "" && (*strimziCluster != "" || *strimziFiles != "" || *loadFile != "" || *bundleFile != "" || *scenarioName != "") {
+		log.Fatalf("Error: --bootstrap cannot be combined with --strimzi, --strimzi-file, --load, --bundle or --scenario")
+	}
+	var kafkaOpts kafka.Options
+	if *kafkaTLS || *kafkaCA != "" {
+		kafkaOpts.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+		if *kafkaCA != "" {
+			pem, err := os.ReadFile(*kafkaCA)
+			if err != nil {
+				log.Fatalf("Error reading --kafka-ca: %v", err)
+			}
+			kafkaOpts.TLS.RootCAs = x509.NewCertPool()
+			if !kafkaOpts.TLS.RootCAs.AppendCertsFromPEM(pem) {
+				log.Fatalf("Error: no PEM certificates in %s", *kafkaCA)
+			}
+		}
+	}
+	if *kafkaSASL != "" {
+		if *kafkaUser == "" {
+			log.Fatalf("Error: --kafka-sasl needs --kafka-user")
+		}
+		if *kafkaPassword == "" {
+			*kafkaPassword = os.Getenv("KAFKA_PASSWORD")
+		}
+		kafkaOpts.SASL = kafka.SASL{Mechanism: *kafkaSASL, User: *kafkaUser, Password: *kafkaPassword}
+		if err := kafkaOpts.Validate(); err != nil {
+			log.Fatalf("Error: --kafka-sasl: %v", err)
+		}
+	}
+	m.SetKafkaOptions(*bootstrap, kafkaOpts)
+	var namingRules *naming.Rules
+	if *namingFile != "" {
+		var err error
+		if namingRules, err = naming.LoadFile(*namingFile); err != nil {
+			log.Fatalf("Error loading naming rules: %v", err)
+		}
+	}
+	if *approvedFile != "" && *assignmentFile == "" {
+		log.Fatalf("Error: --approved needs a live assignment (--assignment)")
+	}
+
+	// Resolve the scenario up front, so an unknown name fails right away
+	var scenario *scenarios.Scenario
+	if *scenarioName != "" {
+		s, err := scenarios.Find(*scenarioName)
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		scenario = &s
+	}
+
+	// Where the TUI starts instead of the wizard, applied in this order. The
+	// imports may read a large cluster or file, so when there is one they run
+	// in the background behind a progress bar.
+	start := func(ctx context.Context, progress tui.ImportProgress) (func(*tui.Model), error) {
+		var steps []func(*tui.Model)
+
+		// Optionally start from an imported Strimzi cluster
+		if *strimziCluster != "" || *strimziFiles != "" {
+			var cluster *strimzi.Cluster
+			var err error
+			if *strimziFiles != "" {
+				cluster, err = strimzi.LoadFilesProgress(*strimziCluster, func(done, total int) {
+					progress(done, total, "files")
+				}, strings.Split(*strimziFiles, ",")...)
+			} else {
+				cluster, err = strimzi.FetchClusterProgress(ctx, *strimziCluster, *namespace, func(done, total int) {
+					progress(done, total, "topics")
+				})
+			}
+			if err != nil {
+				return nil, fmt.Errorf("importing Strimzi cluster: %w", err)
+			}
+			cfg, err := cluster.PlacementConfig(*topic)
+			if err != nil {
+				return nil, fmt.Errorf("importing Strimzi cluster: %w", err)
+			}
+			if mode, count, ok := cluster.ControllerQuorum(); ok {
+				// The imported deployment, unless overridden on the command line
+				if *controllerMode != "" {
+					mode = controllers
+				}
+				if *controllerCount != 0 {
+					count = *controllerCount
+				}
+				steps = append(steps, func(m *tui.Model) { m.SetControllers(mode, count) })
+			}
+			if namingRules != nil {
+				names := make([]string, len(cluster.Topics))
+				for i, t := range cluster.Topics {
+					names[i] = t.Name
+				}
+				report := namingRules.Check(names)
+				steps = append(steps, func(m *tui.Model) { m.SetTopicNaming(report) })
+			}
+			source := fmt.Sprintf("Imported from Strimzi cluster %s (%d brokers, %d topics)", cluster.Name, len(cluster.Brokers), len(cluster.Topics))
+			if *assignmentFile != "" {
+				data, err := assignment.LoadFile(*assignmentFile)
+				if err != nil {
+					return nil, fmt.Errorf("loading assignment: %w", err)
+				}
+				cfg.NumPartitions = len(data.ForTopic(cfg.TopicName)) // The live count wins over the KafkaTopic spec
+				dcs := placement.Topology(cfg)
+				if err := data.Apply(dcs, cfg.TopicName); err != nil {
+					return nil, fmt.Errorf("loading assignment: %w", err)
+				}
+				var approved *assignment.Data
+				if *approvedFile != "" {
+					approved, err = assignment.LoadReassignmentFile(*approvedFile)
+					if err != nil {
+						return nil, err
+					}
+					if len(approved.ForTopic(cfg.TopicName)) == 0 {
+						return nil, fmt.Errorf("the approved placement has no partitions of topic %q", cfg.TopicName)
+					}
+				}
+				meta := clusterMetadata(cluster)
+				snapshots := *snapshotDir
+				if snapshots == "" {
+					if snapshots, err = assignment.DefaultSnapshotDir(cluster.Name); err != nil {
+						applog.Warnf("%s", i18n.T("snapshots.notSaving", err))
+					}
+				}
+				steps = append(steps, func(m *tui.Model) {
+					m.ImportPlacement(cfg, dcs, source)
+					m.WatchAssignment(*assignmentFile, data, approved)
+					m.SetClusterMetadata(meta)
+					if snapshots != "" {
+						m.SetSnapshots(snapshots, *snapshotEvery)
+					}
+				})
+			} else {
+				steps = append(steps, func(m *tui.Model) { m.ImportConfig(cfg, source) })
+			}
+		}
+
+		if scenario != nil {
+			steps = append(steps, func(m *tui.Model) { m.LoadScenario(*scenario) })
+		}
+		if *loadFile != "" {
+			progress(0, 1, "files")
+			cfg, dcs, err := export.LoadFile(*loadFile)
+			if err != nil {
+				return nil, fmt.Errorf("loading assignment: %w", err)
+			}
+			progress(1, 1, "files")
+			steps = append(steps, func(m *tui.Model) { m.LoadPlacement(cfg, dcs, "Loaded from "+*loadFile) })
+		}
+		if *bundleFile != "" {
+			progress(0, 1, "files")
+			b, err := session.LoadBundle(*bundleFile)
+			if err != nil {
+				return nil, err
+			}
+			progress(1, 1, "files")
+			steps = append(steps, func(m *tui.Model) { m.OpenBundle(b) })
+		}
+		if *tutorial {
+			steps = append(steps, func(m *tui.Model) { m.StartTutorial() })
+		}
+		if *quiz {
+			steps = append(steps, func(m *tui.Model) { m.StartQuiz() })
+		}
+		return func(m *tui.Model) {
+			for _, step := range steps {
+				step(m)
+			}
+		}, nil
+	}
+	switch {
+	case *bootstrap != "":
+		m.StartImport(i18n.T("kafka.connecting", *bootstrap), tui.KafkaImport(*bootstrap, *topic, kafkaOpts))
+	case *strimziFiles != "":
+		m.StartImport(i18n.T("import.strimziFiles", *strimziFiles), start)
+	case *strimziCluster != "":
+		m.StartImport(i18n.T("import.strimzi", *strimziCluster), start)
+	case *loadFile != "":
+		m.StartImport(i18n.T("import.loading", *loadFile), start)
+	case *bundleFile != "":
+		m.StartImport(i18n.T("import.bundle", *bundleFile), start)
+	default:
+		apply, err := start(context.Background(), func(int, int, string) {})
+		if err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		apply(&m)
+	}
+
 	// Create and run the Bubble Tea program
-	p := tea.NewProgram(m, tea.WithAltScreen()) // Use AltScreen for cleaner exit
-	if _, err := p.Run(); err != nil {
+	var opts []tea.ProgramOption
+	if !*inline {
+		opts = append(opts, tea.WithAltScreen()) // Use AltScreen for cleaner exit
+	}
+	p := tea.NewProgram(m, opts...)
+	final, err := p.Run()
+	if err != nil {
 		log.Fatalf("Error running program: %v", err)
 		os.Exit(1)
 	}
+
+	if recorded != nil {
+		if err := recording.Save(*recordFile, recorded); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		fmt.Printf("Session recorded to %s (%d steps), replay it with --replay %s\n", *recordFile, len(recorded.Steps), *recordFile)
+	}
+
+	// Leave the result in the scrollback, which the alternate screen would erase
+	if *inline {
+		if view := final.(tui.Model).PrintView(); view != "" {
+			fmt.Println(view)
+		}
+	}
+
+	// Always leave an artifact of the final placement, if requested
+	if *exportFile != "" {
+		if cfg, dcs, ok := final.(tui.Model).Placement(); ok {
+			if err := export.WriteFile(*exportFile, format, cfg, dcs); err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			fmt.Printf("Placement exported to %s (%s)\n", *exportFile, format)
+		}
+	}
+
+	if *wavesFile != "" {
+		if cfg, dcs, ok := final.(tui.Model).Placement(); ok {
+			from, ok := final.(tui.Model).Migration()
+			if !ok {
+				log.Printf("Warning: no migration to split into waves: change the strategy (P) or edit the scenario (E) first")
+			} else {
+				paths, err := export.WriteWaves(*wavesFile, cfg, from, dcs, limits)
+				if err != nil {
+					log.Fatalf("Error: %v", err)
+				}
+				if len(paths) == 0 {
+					fmt.Println("No partition moves, so no waves were written")
+				}
+				for _, path := range paths {
+					fmt.Printf("Migration wave written to %s\n", path)
+				}
+				scripts, err := export.WriteWaveThrottles(*wavesFile, cfg, from, dcs, limits, throttle)
+				if err != nil {
+					log.Fatalf("Error: %v", err)
+				}
+				for _, path := range scripts {
+					fmt.Printf("Replication throttle script written to %s\n", path)
+				}
+			}
+		}
+	}
+
+	if *runbookFile != "" {
+		if cfg, dcs, ok := final.(tui.Model).Placement(); ok {
+			if err := runbook.WriteFile(*runbookFile, cfg, dcs, timing); err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			fmt.Printf("DR runbook written to %s\n", *runbookFile)
+		}
+	}
+
+	if *propertiesDir != "" {
+		if cfg, dcs, ok := final.(tui.Model).Placement(); ok {
+			paths, err := deploy.WriteProperties(*propertiesDir, cfg, dcs)
+			if err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			fmt.Printf("Broker properties for %d brokers written to %s\n", len(paths), *propertiesDir)
+		}
+	}
+
+	if *topicConfigFile != "" {
+		if cfg, dcs, ok := final.(tui.Model).Placement(); ok {
+			if err := deploy.WriteTopicConfigFile(*topicConfigFile, cfg, dcs, *retention); err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			fmt.Printf("Topic config commands written to %s\n", *topicConfigFile)
+		}
+	}
+
+	if *composeFile != "" {
+		if cfg, dcs, ok := final.(tui.Model).Placement(); ok {
+			if err := deploy.WriteComposeFile(*composeFile, mode, cfg, dcs); err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			fmt.Printf("Docker Compose file written to %s\n", *composeFile)
+		}
+	}
+
+	if *saveBundle != "" {
+		if b := final.(tui.Model).Bundle(); b != nil {
+			if err := session.SaveBundle(*saveBundle, b); err != nil {
+				log.Fatalf("Error: %v", err)
+			}
+			fmt.Printf("Bundle written to %s\n", *saveBundle)
+		}
+	}
+
+	// Autosave the final placement so it can be resumed next time
+	if *sessionFile != "" {
+		if s := final.(tui.Model).Session(); s != nil {
+			if err := session.Save(*sessionFile, s); err != nil {
+				log.Printf("Warning: %v", err)
+			}
+		}
+	}
+
+	// Record the final placement's metrics for the trend pane
+	if *historyFile != "" {
+		if s := final.(tui.Model).Snapshot(); s != nil {
+			if err := session.AppendHistory(*historyFile, *s); err != nil {
+				log.Printf("Warning: %v", err)
+			}
+		}
+	}
+
+	// Let scripts notice a final placement that breaches a rule threshold,
+	// or a live assignment that drifted from the approved placement
+	exitCode := 0
+	if breaches := final.(tui.Model).Breaches(); len(breaches) > 0 {
+		for _, r := range breaches {
+			fmt.Fprintf(os.Stderr, "Rule breached: %s", r.Rule)
+			if r.Detail != "" {
+				fmt.Fprintf(os.Stderr, " (%s)", r.Detail)
+			}
+			fmt.Fprintln(os.Stderr)
+		}
+		exitCode = 2
+	}
+	if drift := final.(tui.Model).Drift(); len(drift) > 0 {
+		cfg, _, _ := final.(tui.Model).Placement()
+		for _, d := range drift {
+			fmt.Fprintf(os.Stderr, "Drift: partition %d: %s\n", cfg.PartitionNumber(d.Partition+1), d.Reason)
+		}
+		if exitCode == 0 {
+			exitCode = 3
+		}
+	}
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+}
+
+// defaultSessionPath returns the default session file, or "" (autosave
+// disabled) if the config directory cannot be determined.
+func defaultSessionPath() string {
+	path, err := session.DefaultPath()
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// defaultHistoryPath returns the default history file, or "" (history
+// disabled) if the config directory cannot be determined.
+func defaultHistoryPath() string {
+	path, err := session.DefaultHistoryPath()
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// defaultPipelinesPath returns the default pipelines file, or "" if the
+// config directory cannot be determined.
+func defaultPipelinesPath() string {
+	path, err := pipeline.DefaultPath()
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// clusterMetadata describes an imported Strimzi cluster for the header of
+// its live assignment. Only the controller quorum is known from the custom
+// resources, not which controller is active.
+func clusterMetadata(cluster *strimzi.Cluster) *tui.ClusterMetadata {
+	meta := &tui.ClusterMetadata{
+		Name:         cluster.Name,
+		ID:           cluster.ID,
+		KafkaVersion: cluster.KafkaVersion,
+		Controller:   cluster.MetadataMode,
+		Racks:        make(map[int]string, len(cluster.Brokers)),
+	}
+	if len(cluster.Controllers) > 0 {
+		ids := make([]string, len(cluster.Controllers))
+		for i, n := range cluster.Controllers {
+			ids[i] = strconv.Itoa(n.ID)
+		}
+		quorum := "KRaft quorum"
+		if cluster.MetadataMode == "ZooKeeper" {
+			quorum = "ZooKeeper ensemble"
+		}
+		meta.Controller = fmt.Sprintf("%s of nodes %s", quorum, strings.Join(ids, ","))
+	}
+	for _, b := range cluster.Brokers {
+		meta.Racks[b.ID] = b.Zone
+	}
+	return meta
+}
+
+// runStress generates random clusters from the seed (a random one, printed
+// for reproducing the run, when 0), places them with every strategy and
+// writes the metrics CSV to path.
+func runStress(count int, seed int64, path, goalList string, maxMoves int) error {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+		fmt.Fprintf(os.Stderr, "Stress seed: %d (pass --seed %d to reproduce)\n", seed, seed)
+	}
+	var goals []string
+	if goalList != "" {
+		goals = strings.Split(goalList, ",")
+		if _, err := placement.ResolveScorers(goals); err != nil {
+			return err
+		}
+	}
+	scorers := placement.Scorers()
+	results := stress.Run(stress.Generate(seed, count), config.Strategies(), scorers, goals, maxMoves)
+
+	out := os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("writing stress metrics: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := stress.WriteCSV(out, results, scorers); err != nil {
+		return fmt.Errorf("writing stress metrics: %w", err)
+	}
+	if path != "-" {
+		fmt.Printf("Stress metrics for %d clusters (%d placements) written to %s\n", count, len(results), path)
+	}
+	return nil
+}
+
+// runPipeline runs a named pipeline of the pipelines file on the assignment
+// in loadPath, with the partition weights of weightsPath when given and
+// partitions numbered from 0 with zeroBased.
+func runPipeline(path, name, loadPath, weightsPath string, zeroBased bool) error {
+	if path == "" {
+		return fmt.Errorf("--pipeline needs a --pipelines file")
+	}
+	pipelines, err := pipeline.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	p, ok := pipelines[name]
+	if !ok {
+		return fmt.Errorf("no pipeline %q in %s (defined: %s)", name, path, strings.Join(pipeline.Names(pipelines), ", "))
+	}
+	if loadPath == "" {
+		return fmt.Errorf("--pipeline needs the assignment to review (--load)")
+	}
+	cfg, dcs, err := export.LoadFile(loadPath)
+	if err != nil {
+		return fmt.Errorf("loading assignment: %w", err)
+	}
+	cfg.ZeroBasedPartitions = cfg.ZeroBasedPartitions || zeroBased
+	if weightsPath != "" {
+		data, err := weights.LoadFile(weightsPath)
+		if err != nil {
+			return fmt.Errorf("loading partition weights: %w", err)
+		}
+		cfg.PartitionLoads = data.ForTopic(cfg.TopicName)
+	}
+	return pipeline.Run(p, cfg, dcs, os.Stdout)
+}
+
+// recordedArgs returns the flags the session was started with, as a
+// recording replays them: every flag that was set except --record and
+// --replay.
+func recordedArgs() []string {
+	var args []string
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name != "record" && f.Name != "replay" {
+			args = append(args, "--"+f.Name+"="+f.Value.String())
+		}
+	})
+	return args
 }